@@ -0,0 +1,78 @@
+package bus_api
+
+import (
+	"context"
+
+	configset_store "github.com/aperturerobotics/controllerbus/controller/configset/store"
+)
+
+// GetConfigSetHistory returns the revision history of a stored configset.
+func (a *API) GetConfigSetHistory(
+	ctx context.Context,
+	req *GetConfigSetHistoryRequest,
+) (*GetConfigSetHistoryResponse, error) {
+	storeCtrl, err := LookupConfigSetStore(a.bus)
+	if err != nil {
+		return nil, err
+	}
+	store := storeCtrl.GetStore()
+	key := req.GetStoreKey()
+	if key == "" {
+		keys, err := store.ListKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &GetConfigSetHistoryResponse{StoreKeys: keys}, nil
+	}
+	revs, err := store.GetHistory(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &GetConfigSetHistoryResponse{Revisions: revs}, nil
+}
+
+// PutConfigSet stores a configset as a new revision of a key and applies it.
+func (a *API) PutConfigSet(
+	ctx context.Context,
+	req *PutConfigSetRequest,
+) (*PutConfigSetResponse, error) {
+	if !a.conf.GetEnableConfigsetStore() {
+		return nil, ErrConfigSetStoreDisabled
+	}
+	if req.GetStoreKey() == "" {
+		return nil, configset_store.ErrStoreKeyEmpty
+	}
+	storeCtrl, err := LookupConfigSetStore(a.bus)
+	if err != nil {
+		return nil, err
+	}
+	cs, err := ResolveConfigSetYAML(ctx, a.bus, []byte(req.GetConfigSetYaml()))
+	if err != nil {
+		return nil, err
+	}
+	rev, err := storeCtrl.PutConfigSet(ctx, req.GetStoreKey(), cs, req.GetSource())
+	if err != nil {
+		return nil, err
+	}
+	return &PutConfigSetResponse{Revision: rev}, nil
+}
+
+// RollbackConfigSet stores a copy of an earlier revision of a stored
+// configset as a new revision and applies it.
+func (a *API) RollbackConfigSet(
+	ctx context.Context,
+	req *RollbackConfigSetRequest,
+) (*RollbackConfigSetResponse, error) {
+	if !a.conf.GetEnableConfigsetStore() {
+		return nil, ErrConfigSetStoreDisabled
+	}
+	storeCtrl, err := LookupConfigSetStore(a.bus)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := storeCtrl.RollbackConfigSet(ctx, req.GetStoreKey(), req.GetRev(), req.GetSource())
+	if err != nil {
+		return nil, err
+	}
+	return &RollbackConfigSetResponse{Revision: rev}, nil
+}
@@ -0,0 +1,54 @@
+package bus_api
+
+import (
+	"context"
+
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+)
+
+// StopController stops a configset controller and releases the configset
+// references to it.
+func (a *API) StopController(
+	ctx context.Context,
+	req *StopControllerRequest,
+) (*StopControllerResponse, error) {
+	if !a.conf.GetEnableControlControllers() {
+		return nil, ErrControlControllersDisabled
+	}
+	keys, err := ControlConfigSetControllers(a.bus, req.GetTarget(), configset.Controller.StopController)
+	if err != nil {
+		return nil, err
+	}
+	return &StopControllerResponse{ConfigKeys: keys}, nil
+}
+
+// RestartController restarts a configset controller with the current config.
+func (a *API) RestartController(
+	ctx context.Context,
+	req *RestartControllerRequest,
+) (*RestartControllerResponse, error) {
+	if !a.conf.GetEnableControlControllers() {
+		return nil, ErrControlControllersDisabled
+	}
+	keys, err := ControlConfigSetControllers(a.bus, req.GetTarget(), configset.Controller.RestartController)
+	if err != nil {
+		return nil, err
+	}
+	return &RestartControllerResponse{ConfigKeys: keys}, nil
+}
+
+// RemoveController stops a configset controller and releases all configset
+// references to it, including persistent references.
+func (a *API) RemoveController(
+	ctx context.Context,
+	req *RemoveControllerRequest,
+) (*RemoveControllerResponse, error) {
+	if !a.conf.GetEnableControlControllers() {
+		return nil, ErrControlControllersDisabled
+	}
+	keys, err := ControlConfigSetControllers(a.bus, req.GetTarget(), configset.Controller.RemoveController)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoveControllerResponse{ConfigKeys: keys}, nil
+}
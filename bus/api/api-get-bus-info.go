@@ -3,6 +3,8 @@ package bus_api
 import (
 	"context"
 
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	controller_exec "github.com/aperturerobotics/controllerbus/controller/exec"
 	"github.com/aperturerobotics/controllerbus/directive"
 )
 
@@ -22,6 +24,11 @@ func (a *API) GetBusInfo(
 	for _, ctrl := range controllers {
 		cinfo := ctrl.GetControllerInfo()
 		resp.RunningControllers = append(resp.RunningControllers, cinfo)
+		if csCtrl, ok := ctrl.(configset.Controller); ok {
+			for _, st := range csCtrl.GetControllerStates() {
+				resp.ConfigSetControllers = append(resp.ConfigSetControllers, controller_exec.NewExecControllerResponse(st))
+			}
+		}
 	}
 	for _, dir := range directives {
 		resp.RunningDirectives = append(resp.RunningDirectives, directive.NewDirectiveState(dir))
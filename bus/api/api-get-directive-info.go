@@ -0,0 +1,51 @@
+package bus_api
+
+import (
+	"context"
+
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// GetDirectiveInfo requests the state and values of a directive.
+func (a *API) GetDirectiveInfo(
+	ctx context.Context,
+	req *GetDirectiveInfoRequest,
+) (*GetDirectiveInfoResponse, error) {
+	instanceID := req.GetInstanceId()
+	for _, di := range a.bus.GetDirectives() {
+		if di.GetInstanceID() == instanceID {
+			return NewGetDirectiveInfoResponse(ctx, di)
+		}
+	}
+	return &GetDirectiveInfoResponse{}, nil
+}
+
+// NewGetDirectiveInfoResponse builds the state and values of a directive instance.
+func NewGetDirectiveInfoResponse(ctx context.Context, di directive.Instance) (*GetDirectiveInfoResponse, error) {
+	// the state callback is called immediately with the current state
+	stateCh := make(chan *GetDirectiveInfoResponse, 1)
+	relState := di.AddStateCallback(func(isIdle bool, errs []error, vals []directive.AttachedValue) {
+		resp := &GetDirectiveInfoResponse{Found: true, Idle: isIdle}
+		for _, err := range errs {
+			if err != nil {
+				resp.ResolverErrors = append(resp.ResolverErrors, err.Error())
+			}
+		}
+		for _, val := range vals {
+			resp.Values = append(resp.Values, directive.NewValueInfo(val))
+		}
+		select {
+		case stateCh <- resp:
+		default:
+		}
+	})
+	defer relState()
+
+	select {
+	case <-ctx.Done():
+		return nil, context.Canceled
+	case resp := <-stateCh:
+		resp.DirectiveState = directive.NewDirectiveState(di)
+		return resp, nil
+	}
+}
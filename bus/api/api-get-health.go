@@ -0,0 +1,13 @@
+package bus_api
+
+import (
+	"context"
+)
+
+// GetHealth returns the liveness and readiness of the bus.
+func (a *API) GetHealth(
+	ctx context.Context,
+	req *GetHealthRequest,
+) (*GetHealthResponse, error) {
+	return GetBusHealth(ctx, a.bus)
+}
@@ -0,0 +1,17 @@
+package bus_api
+
+import (
+	"context"
+)
+
+// ListFactories lists the controller factories available to the bus.
+func (a *API) ListFactories(
+	ctx context.Context,
+	req *ListFactoriesRequest,
+) (*ListFactoriesResponse, error) {
+	factories, err := ListBusFactories(ctx, a.bus)
+	if err != nil {
+		return nil, err
+	}
+	return &ListFactoriesResponse{Factories: factories}, nil
+}
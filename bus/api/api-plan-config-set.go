@@ -0,0 +1,14 @@
+package bus_api
+
+import (
+	"context"
+)
+
+// PlanConfigSet computes the changes to the configset controllers if the
+// configset was applied without applying it.
+func (a *API) PlanConfigSet(
+	ctx context.Context,
+	req *PlanConfigSetRequest,
+) (*PlanConfigSetResponse, error) {
+	return PlanBusConfigSet(ctx, a.bus, []byte(req.GetConfigSetYaml()), req.GetReplace())
+}
@@ -0,0 +1,21 @@
+package bus_api
+
+import (
+	"context"
+)
+
+// WatchBusInfo streams a snapshot of the controller bus followed by
+// controller and directive events.
+func (a *API) WatchBusInfo(
+	req *WatchBusInfoRequest,
+	strm SRPCControllerBusService_WatchBusInfoStream,
+) error {
+	ctx, ctxCancel := context.WithCancel(strm.Context())
+	defer ctxCancel()
+
+	var queue sendQueue[*WatchBusInfoResponse]
+	go func() {
+		queue.fail(WatchBusInfoEvents(ctx, a.bus, queue.push))
+	}()
+	return queue.drain(ctx, strm.Send)
+}
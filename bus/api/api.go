@@ -2,18 +2,35 @@ package bus_api
 
 import (
 	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/directive"
 	srpc "github.com/aperturerobotics/starpc/srpc"
 )
 
 // API implements the rpc API.
 type API struct {
-	bus  bus.Bus
-	conf *Config
+	bus   bus.Bus
+	conf  *Config
+	types directive.NetworkedTypeSet
+
+	// served contains directives added with ExecDirective.
+	// Used if NoServeExecDirectives is set.
+	served servedDirectives
 }
 
 // NewAPI constructs a new instance of the API.
-func NewAPI(bus bus.Bus, conf *Config) *API {
-	return &API{bus: bus, conf: conf}
+//
+// types are the networked directive types accepted by ExecDirective.
+func NewAPI(bus bus.Bus, conf *Config, types ...directive.NetworkedType) *API {
+	return &API{bus: bus, conf: conf, types: directive.NewNetworkedTypeSet(types...)}
+}
+
+// getServed returns the set of directives to skip in ServeDirectives handlers.
+// Returns nil if NoServeExecDirectives is not set.
+func (a *API) getServed() *servedDirectives {
+	if !a.conf.GetNoServeExecDirectives() {
+		return nil
+	}
+	return &a.served
 }
 
 // RegisterAsSRPCServer registers the API to the SRPC mux.
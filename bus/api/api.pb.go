@@ -5,6 +5,7 @@
 package bus_api
 
 import (
+	base64 "encoding/base64"
 	fmt "fmt"
 	io "io"
 	slices "slices"
@@ -12,17 +13,189 @@ import (
 	strings "strings"
 
 	controller "github.com/aperturerobotics/controllerbus/controller"
-	_ "github.com/aperturerobotics/controllerbus/controller/exec"
+	store "github.com/aperturerobotics/controllerbus/controller/configset/store"
+	exec "github.com/aperturerobotics/controllerbus/controller/exec"
 	directive "github.com/aperturerobotics/controllerbus/directive"
 	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
 	json "github.com/aperturerobotics/protobuf-go-lite/json"
 )
 
+// WatchBusInfoEventType is the type of event in a WatchBusInfo stream.
+type WatchBusInfoEventType int32
+
+const (
+	// WatchBusInfoEventType_UNKNOWN is unrecognized.
+	WatchBusInfoEventType_WatchBusInfoEventType_UNKNOWN WatchBusInfoEventType = 0
+	// WatchBusInfoEventType_SNAPSHOT is the initial snapshot of the bus.
+	// Contains the state of each directive.
+	WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT WatchBusInfoEventType = 1
+	// WatchBusInfoEventType_CONTROLLER_ADDED indicates a controller was added.
+	WatchBusInfoEventType_WatchBusInfoEventType_CONTROLLER_ADDED WatchBusInfoEventType = 2
+	// WatchBusInfoEventType_CONTROLLER_REMOVED indicates a controller was removed.
+	WatchBusInfoEventType_WatchBusInfoEventType_CONTROLLER_REMOVED WatchBusInfoEventType = 3
+	// WatchBusInfoEventType_DIRECTIVE_ADDED indicates a directive was added.
+	WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_ADDED WatchBusInfoEventType = 4
+	// WatchBusInfoEventType_DIRECTIVE_REMOVED indicates a directive was removed.
+	WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_REMOVED WatchBusInfoEventType = 5
+	// WatchBusInfoEventType_DIRECTIVE_STATE indicates the state of a directive changed.
+	WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_STATE WatchBusInfoEventType = 6
+)
+
+// Enum value maps for WatchBusInfoEventType.
+var (
+	WatchBusInfoEventType_name = map[int32]string{
+		0: "WatchBusInfoEventType_UNKNOWN",
+		1: "WatchBusInfoEventType_SNAPSHOT",
+		2: "WatchBusInfoEventType_CONTROLLER_ADDED",
+		3: "WatchBusInfoEventType_CONTROLLER_REMOVED",
+		4: "WatchBusInfoEventType_DIRECTIVE_ADDED",
+		5: "WatchBusInfoEventType_DIRECTIVE_REMOVED",
+		6: "WatchBusInfoEventType_DIRECTIVE_STATE",
+	}
+	WatchBusInfoEventType_value = map[string]int32{
+		"WatchBusInfoEventType_UNKNOWN":            0,
+		"WatchBusInfoEventType_SNAPSHOT":           1,
+		"WatchBusInfoEventType_CONTROLLER_ADDED":   2,
+		"WatchBusInfoEventType_CONTROLLER_REMOVED": 3,
+		"WatchBusInfoEventType_DIRECTIVE_ADDED":    4,
+		"WatchBusInfoEventType_DIRECTIVE_REMOVED":  5,
+		"WatchBusInfoEventType_DIRECTIVE_STATE":    6,
+	}
+)
+
+func (x WatchBusInfoEventType) Enum() *WatchBusInfoEventType {
+	p := new(WatchBusInfoEventType)
+	*p = x
+	return p
+}
+
+func (x WatchBusInfoEventType) String() string {
+	name, valid := WatchBusInfoEventType_name[int32(x)]
+	if valid {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+// ExecDirectiveEventType is the type of event in an ExecDirective stream.
+type ExecDirectiveEventType int32
+
+const (
+	// ExecDirectiveEventType_UNKNOWN is unrecognized.
+	ExecDirectiveEventType_ExecDirectiveEventType_UNKNOWN ExecDirectiveEventType = 0
+	// ExecDirectiveEventType_VALUE_ADDED indicates a value was added.
+	ExecDirectiveEventType_ExecDirectiveEventType_VALUE_ADDED ExecDirectiveEventType = 1
+	// ExecDirectiveEventType_VALUE_REMOVED indicates a value was removed.
+	ExecDirectiveEventType_ExecDirectiveEventType_VALUE_REMOVED ExecDirectiveEventType = 2
+	// ExecDirectiveEventType_IDLE indicates the idle state changed.
+	ExecDirectiveEventType_ExecDirectiveEventType_IDLE ExecDirectiveEventType = 3
+)
+
+// Enum value maps for ExecDirectiveEventType.
+var (
+	ExecDirectiveEventType_name = map[int32]string{
+		0: "ExecDirectiveEventType_UNKNOWN",
+		1: "ExecDirectiveEventType_VALUE_ADDED",
+		2: "ExecDirectiveEventType_VALUE_REMOVED",
+		3: "ExecDirectiveEventType_IDLE",
+	}
+	ExecDirectiveEventType_value = map[string]int32{
+		"ExecDirectiveEventType_UNKNOWN":       0,
+		"ExecDirectiveEventType_VALUE_ADDED":   1,
+		"ExecDirectiveEventType_VALUE_REMOVED": 2,
+		"ExecDirectiveEventType_IDLE":          3,
+	}
+)
+
+func (x ExecDirectiveEventType) Enum() *ExecDirectiveEventType {
+	p := new(ExecDirectiveEventType)
+	*p = x
+	return p
+}
+
+func (x ExecDirectiveEventType) String() string {
+	name, valid := ExecDirectiveEventType_name[int32(x)]
+	if valid {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+// PlanAction is the planned change to a configset key.
+type PlanAction int32
+
+const (
+	// PlanAction_UNCHANGED indicates the applied config is left alone.
+	PlanAction_PlanAction_UNCHANGED PlanAction = 0
+	// PlanAction_START indicates the key is not applied and would be started.
+	PlanAction_PlanAction_START PlanAction = 1
+	// PlanAction_STOP indicates the key is applied and not in the configset
+	// and the configset replaces the applied configs.
+	PlanAction_PlanAction_STOP PlanAction = 2
+	// PlanAction_RESTART indicates the revision is newer than the applied
+	// revision and the controller would be restarted, unless it applies the
+	// config in place.
+	PlanAction_PlanAction_RESTART PlanAction = 3
+	// PlanAction_IGNORED indicates the config differs from the applied config
+	// but the revision is not newer, so ApplyConfigSet would not apply it.
+	// The daemon config file and PutConfigSet apply changed configs with a
+	// revision newer than the applied one, so they would restart the key.
+	PlanAction_PlanAction_IGNORED PlanAction = 4
+	// PlanAction_INVALID indicates the config is invalid and cannot be applied.
+	PlanAction_PlanAction_INVALID PlanAction = 5
+)
+
+// Enum value maps for PlanAction.
+var (
+	PlanAction_name = map[int32]string{
+		0: "PlanAction_UNCHANGED",
+		1: "PlanAction_START",
+		2: "PlanAction_STOP",
+		3: "PlanAction_RESTART",
+		4: "PlanAction_IGNORED",
+		5: "PlanAction_INVALID",
+	}
+	PlanAction_value = map[string]int32{
+		"PlanAction_UNCHANGED": 0,
+		"PlanAction_START":     1,
+		"PlanAction_STOP":      2,
+		"PlanAction_RESTART":   3,
+		"PlanAction_IGNORED":   4,
+		"PlanAction_INVALID":   5,
+	}
+)
+
+func (x PlanAction) Enum() *PlanAction {
+	p := new(PlanAction)
+	*p = x
+	return p
+}
+
+func (x PlanAction) String() string {
+	name, valid := PlanAction_name[int32(x)]
+	if valid {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
 // Config are configuration arguments.
 type Config struct {
 	unknownFields []byte
 	// EnableExecController enables the exec controller API.
 	EnableExecController bool `protobuf:"varint,1,opt,name=enable_exec_controller,json=enableExecController,proto3" json:"enableExecController,omitempty"`
+	// EnableExecDirective enables the exec directive API.
+	EnableExecDirective bool `protobuf:"varint,2,opt,name=enable_exec_directive,json=enableExecDirective,proto3" json:"enableExecDirective,omitempty"`
+	// EnableServeDirectives enables the serve directives API.
+	EnableServeDirectives bool `protobuf:"varint,3,opt,name=enable_serve_directives,json=enableServeDirectives,proto3" json:"enableServeDirectives,omitempty"`
+	// EnableControlControllers enables the stop, restart, and remove controller API.
+	EnableControlControllers bool `protobuf:"varint,4,opt,name=enable_control_controllers,json=enableControlControllers,proto3" json:"enableControlControllers,omitempty"`
+	// EnableConfigSetStore enables the put and rollback configset store API.
+	EnableConfigsetStore bool `protobuf:"varint,5,opt,name=enable_configset_store,json=enableConfigsetStore,proto3" json:"enableConfigsetStore,omitempty"`
+	// NoServeExecDirectives skips forwarding directives added with ExecDirective
+	// to ServeDirectives handlers. Set if the api has a single remote which uses
+	// both to avoid forwarding its directives back to it in a loop.
+	NoServeExecDirectives bool `protobuf:"varint,6,opt,name=no_serve_exec_directives,json=noServeExecDirectives,proto3" json:"noServeExecDirectives,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -38,6 +211,41 @@ func (x *Config) GetEnableExecController() bool {
 	return false
 }
 
+func (x *Config) GetEnableExecDirective() bool {
+	if x != nil {
+		return x.EnableExecDirective
+	}
+	return false
+}
+
+func (x *Config) GetEnableServeDirectives() bool {
+	if x != nil {
+		return x.EnableServeDirectives
+	}
+	return false
+}
+
+func (x *Config) GetEnableControlControllers() bool {
+	if x != nil {
+		return x.EnableControlControllers
+	}
+	return false
+}
+
+func (x *Config) GetEnableConfigsetStore() bool {
+	if x != nil {
+		return x.EnableConfigsetStore
+	}
+	return false
+}
+
+func (x *Config) GetNoServeExecDirectives() bool {
+	if x != nil {
+		return x.NoServeExecDirectives
+	}
+	return false
+}
+
 // GetBusInfoRequest is the request type for GetBusInfo.
 type GetBusInfoRequest struct {
 	unknownFields []byte
@@ -56,6 +264,9 @@ type GetBusInfoResponse struct {
 	RunningControllers []*controller.Info `protobuf:"bytes,1,rep,name=running_controllers,json=runningControllers,proto3" json:"runningControllers,omitempty"`
 	// RunningDirectives is the list of running directives.
 	RunningDirectives []*directive.DirectiveState `protobuf:"bytes,2,rep,name=running_directives,json=runningDirectives,proto3" json:"runningDirectives,omitempty"`
+	// ConfigSetControllers is the state of the controllers managed by the
+	// configset controller, by configset key.
+	ConfigSetControllers []*exec.ExecControllerResponse `protobuf:"bytes,3,rep,name=config_set_controllers,json=configSetControllers,proto3" json:"configSetControllers,omitempty"`
 }
 
 func (x *GetBusInfoResponse) Reset() {
@@ -78,569 +289,11299 @@ func (x *GetBusInfoResponse) GetRunningDirectives() []*directive.DirectiveState
 	return nil
 }
 
-func (m *Config) CloneVT() *Config {
-	if m == nil {
-		return (*Config)(nil)
-	}
-	r := new(Config)
-	r.EnableExecController = m.EnableExecController
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = slices.Clone(m.unknownFields)
+func (x *GetBusInfoResponse) GetConfigSetControllers() []*exec.ExecControllerResponse {
+	if x != nil {
+		return x.ConfigSetControllers
 	}
-	return r
+	return nil
 }
 
-func (m *Config) CloneMessageVT() protobuf_go_lite.CloneMessage {
-	return m.CloneVT()
+// GetDirectiveInfoRequest is the request type for GetDirectiveInfo.
+type GetDirectiveInfoRequest struct {
+	unknownFields []byte
+	// InstanceId is the id of the directive instance.
+	// Matches the instance_id field of the directive state.
+	InstanceId uint32 `protobuf:"varint,1,opt,name=instance_id,json=instanceId,proto3" json:"instanceId,omitempty"`
 }
 
-func (m *GetBusInfoRequest) CloneVT() *GetBusInfoRequest {
-	if m == nil {
-		return (*GetBusInfoRequest)(nil)
-	}
-	r := new(GetBusInfoRequest)
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = slices.Clone(m.unknownFields)
-	}
-	return r
+func (x *GetDirectiveInfoRequest) Reset() {
+	*x = GetDirectiveInfoRequest{}
 }
 
-func (m *GetBusInfoRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
-	return m.CloneVT()
-}
+func (*GetDirectiveInfoRequest) ProtoMessage() {}
 
-func (m *GetBusInfoResponse) CloneVT() *GetBusInfoResponse {
-	if m == nil {
-		return (*GetBusInfoResponse)(nil)
-	}
-	r := new(GetBusInfoResponse)
-	if rhs := m.RunningControllers; rhs != nil {
-		r.RunningControllers = make([]*controller.Info, len(rhs))
-		for k, v := range rhs {
-			r.RunningControllers[k] = v.CloneVT()
-		}
-	}
-	if rhs := m.RunningDirectives; rhs != nil {
-		r.RunningDirectives = make([]*directive.DirectiveState, len(rhs))
-		for k, v := range rhs {
-			r.RunningDirectives[k] = v.CloneVT()
-		}
-	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = slices.Clone(m.unknownFields)
+func (x *GetDirectiveInfoRequest) GetInstanceId() uint32 {
+	if x != nil {
+		return x.InstanceId
 	}
-	return r
+	return 0
 }
 
-func (m *GetBusInfoResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
-	return m.CloneVT()
+// GetDirectiveInfoResponse is the response type for GetDirectiveInfo.
+type GetDirectiveInfoResponse struct {
+	unknownFields []byte
+	// Found indicates the directive was found.
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// DirectiveState is the directive info.
+	DirectiveState *directive.DirectiveState `protobuf:"bytes,2,opt,name=directive_state,json=directiveState,proto3" json:"directiveState,omitempty"`
+	// Idle indicates if the directive is idle.
+	Idle bool `protobuf:"varint,3,opt,name=idle,proto3" json:"idle,omitempty"`
+	// ResolverErrors contains any resolver errors.
+	ResolverErrors []string `protobuf:"bytes,4,rep,name=resolver_errors,json=resolverErrors,proto3" json:"resolverErrors,omitempty"`
+	// Values contains the directive values.
+	Values []*directive.ValueInfo `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (this *Config) EqualVT(that *Config) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
-	}
-	if this.EnableExecController != that.EnableExecController {
-		return false
-	}
-	return string(this.unknownFields) == string(that.unknownFields)
+func (x *GetDirectiveInfoResponse) Reset() {
+	*x = GetDirectiveInfoResponse{}
 }
 
-func (this *Config) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Config)
-	if !ok {
-		return false
+func (*GetDirectiveInfoResponse) ProtoMessage() {}
+
+func (x *GetDirectiveInfoResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
 	}
-	return this.EqualVT(that)
+	return false
 }
 
-func (this *GetBusInfoRequest) EqualVT(that *GetBusInfoRequest) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+func (x *GetDirectiveInfoResponse) GetDirectiveState() *directive.DirectiveState {
+	if x != nil {
+		return x.DirectiveState
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return nil
 }
 
-func (this *GetBusInfoRequest) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*GetBusInfoRequest)
-	if !ok {
-		return false
+func (x *GetDirectiveInfoResponse) GetIdle() bool {
+	if x != nil {
+		return x.Idle
 	}
-	return this.EqualVT(that)
+	return false
 }
 
-func (this *GetBusInfoResponse) EqualVT(that *GetBusInfoResponse) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
-	}
-	if len(this.RunningControllers) != len(that.RunningControllers) {
-		return false
-	}
-	for i, vx := range this.RunningControllers {
-		vy := that.RunningControllers[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &controller.Info{}
-			}
-			if q == nil {
-				q = &controller.Info{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
-	}
-	if len(this.RunningDirectives) != len(that.RunningDirectives) {
-		return false
-	}
-	for i, vx := range this.RunningDirectives {
-		vy := that.RunningDirectives[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &directive.DirectiveState{}
-			}
-			if q == nil {
-				q = &directive.DirectiveState{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+func (x *GetDirectiveInfoResponse) GetResolverErrors() []string {
+	if x != nil {
+		return x.ResolverErrors
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return nil
 }
 
-func (this *GetBusInfoResponse) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*GetBusInfoResponse)
-	if !ok {
-		return false
+func (x *GetDirectiveInfoResponse) GetValues() []*directive.ValueInfo {
+	if x != nil {
+		return x.Values
 	}
-	return this.EqualVT(that)
+	return nil
 }
 
-// MarshalProtoJSON marshals the Config message to JSON.
-func (x *Config) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
-	}
-	s.WriteObjectStart()
-	var wroteField bool
-	if x.EnableExecController || s.HasField("enableExecController") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("enableExecController")
-		s.WriteBool(x.EnableExecController)
-	}
-	s.WriteObjectEnd()
+// ListFactoriesRequest is the request type for ListFactories.
+type ListFactoriesRequest struct {
+	unknownFields []byte
 }
 
-// MarshalJSON marshals the Config to JSON.
-func (x *Config) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+func (x *ListFactoriesRequest) Reset() {
+	*x = ListFactoriesRequest{}
 }
 
-// UnmarshalProtoJSON unmarshals the Config message from JSON.
-func (x *Config) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	if s.ReadNil() {
-		return
-	}
-	s.ReadObject(func(key string) {
-		switch key {
-		default:
-			s.Skip() // ignore unknown field
-		case "enable_exec_controller", "enableExecController":
-			s.AddField("enable_exec_controller")
-			x.EnableExecController = s.ReadBool()
-		}
-	})
+func (*ListFactoriesRequest) ProtoMessage() {}
+
+// ListFactoriesResponse is the response type for ListFactories.
+type ListFactoriesResponse struct {
+	unknownFields []byte
+	// Factories contains the factories available to the bus.
+	// Sorted by config id and resolver id.
+	Factories []*FactoryInfo `protobuf:"bytes,1,rep,name=factories,proto3" json:"factories,omitempty"`
 }
 
-// UnmarshalJSON unmarshals the Config from JSON.
-func (x *Config) UnmarshalJSON(b []byte) error {
-	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+func (x *ListFactoriesResponse) Reset() {
+	*x = ListFactoriesResponse{}
 }
 
-// MarshalProtoJSON marshals the GetBusInfoRequest message to JSON.
-func (x *GetBusInfoRequest) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
+func (*ListFactoriesResponse) ProtoMessage() {}
+
+func (x *ListFactoriesResponse) GetFactories() []*FactoryInfo {
+	if x != nil {
+		return x.Factories
 	}
-	s.WriteObjectStart()
-	s.WriteObjectEnd()
+	return nil
 }
 
-// MarshalJSON marshals the GetBusInfoRequest to JSON.
-func (x *GetBusInfoRequest) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+// FactoryInfo is information about a controller factory.
+type FactoryInfo struct {
+	unknownFields []byte
+	// ConfigId is the config ID the factory constructs controllers for.
+	ConfigId string `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"configId,omitempty"`
+	// Version is the version of the factory.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// ResolverId is the id of the resolver providing the factory.
+	ResolverId string `protobuf:"bytes,3,opt,name=resolver_id,json=resolverId,proto3" json:"resolverId,omitempty"`
+	// ConfigSchema is the JSON schema of the factory config.
+	ConfigSchema string `protobuf:"bytes,4,opt,name=config_schema,json=configSchema,proto3" json:"configSchema,omitempty"`
 }
 
-// UnmarshalProtoJSON unmarshals the GetBusInfoRequest message from JSON.
-func (x *GetBusInfoRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	if s.ReadNil() {
-		return
-	}
-	s.ReadObject(func(key string) {
-		// no fields
-	})
+func (x *FactoryInfo) Reset() {
+	*x = FactoryInfo{}
 }
 
-// UnmarshalJSON unmarshals the GetBusInfoRequest from JSON.
-func (x *GetBusInfoRequest) UnmarshalJSON(b []byte) error {
-	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
-}
+func (*FactoryInfo) ProtoMessage() {}
 
-// MarshalProtoJSON marshals the GetBusInfoResponse message to JSON.
-func (x *GetBusInfoResponse) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
-	}
-	s.WriteObjectStart()
-	var wroteField bool
-	if len(x.RunningControllers) > 0 || s.HasField("runningControllers") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("runningControllers")
-		s.WriteArrayStart()
-		var wroteElement bool
-		for _, element := range x.RunningControllers {
-			s.WriteMoreIf(&wroteElement)
-			element.MarshalProtoJSON(s.WithField("runningControllers"))
-		}
-		s.WriteArrayEnd()
+func (x *FactoryInfo) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
 	}
-	if len(x.RunningDirectives) > 0 || s.HasField("runningDirectives") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("runningDirectives")
-		s.WriteArrayStart()
-		var wroteElement bool
-		for _, element := range x.RunningDirectives {
-			s.WriteMoreIf(&wroteElement)
-			element.MarshalProtoJSON(s.WithField("runningDirectives"))
-		}
-		s.WriteArrayEnd()
+	return ""
+}
+
+func (x *FactoryInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
-	s.WriteObjectEnd()
+	return ""
 }
 
-// MarshalJSON marshals the GetBusInfoResponse to JSON.
-func (x *GetBusInfoResponse) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+func (x *FactoryInfo) GetResolverId() string {
+	if x != nil {
+		return x.ResolverId
+	}
+	return ""
 }
 
-// UnmarshalProtoJSON unmarshals the GetBusInfoResponse message from JSON.
-func (x *GetBusInfoResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	if s.ReadNil() {
-		return
+func (x *FactoryInfo) GetConfigSchema() string {
+	if x != nil {
+		return x.ConfigSchema
 	}
-	s.ReadObject(func(key string) {
-		switch key {
-		default:
-			s.Skip() // ignore unknown field
-		case "running_controllers", "runningControllers":
-			s.AddField("running_controllers")
-			if s.ReadNil() {
-				x.RunningControllers = nil
-				return
-			}
-			s.ReadArray(func() {
-				if s.ReadNil() {
-					x.RunningControllers = append(x.RunningControllers, nil)
-					return
-				}
-				v := &controller.Info{}
-				v.UnmarshalProtoJSON(s.WithField("running_controllers", false))
-				if s.Err() != nil {
-					return
-				}
-				x.RunningControllers = append(x.RunningControllers, v)
-			})
-		case "running_directives", "runningDirectives":
-			s.AddField("running_directives")
-			if s.ReadNil() {
-				x.RunningDirectives = nil
-				return
-			}
-			s.ReadArray(func() {
-				if s.ReadNil() {
-					x.RunningDirectives = append(x.RunningDirectives, nil)
-					return
-				}
-				v := &directive.DirectiveState{}
-				v.UnmarshalProtoJSON(s.WithField("running_directives", false))
-				if s.Err() != nil {
-					return
-				}
-				x.RunningDirectives = append(x.RunningDirectives, v)
-			})
-		}
-	})
+	return ""
 }
 
-// UnmarshalJSON unmarshals the GetBusInfoResponse from JSON.
-func (x *GetBusInfoResponse) UnmarshalJSON(b []byte) error {
-	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+// ControllerTarget selects configset controllers.
+//
+// If both fields are set both must match.
+type ControllerTarget struct {
+	unknownFields []byte
+	// ConfigKey is the configset key of the controller.
+	ConfigKey string `protobuf:"bytes,1,opt,name=config_key,json=configKey,proto3" json:"configKey,omitempty"`
+	// ControllerId is the controller id of the running controller.
+	ControllerId string `protobuf:"bytes,2,opt,name=controller_id,json=controllerId,proto3" json:"controllerId,omitempty"`
 }
 
-func (m *Config) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+func (x *ControllerTarget) Reset() {
+	*x = ControllerTarget{}
+}
+
+func (*ControllerTarget) ProtoMessage() {}
+
+func (x *ControllerTarget) GetConfigKey() string {
+	if x != nil {
+		return x.ConfigKey
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return ""
+}
+
+func (x *ControllerTarget) GetControllerId() string {
+	if x != nil {
+		return x.ControllerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Config) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// StopControllerRequest is the request type for StopController.
+type StopControllerRequest struct {
+	unknownFields []byte
+	// Target selects the controllers to stop.
+	Target *ControllerTarget `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (m *Config) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if m.EnableExecController {
-		i--
-		if m.EnableExecController {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (x *StopControllerRequest) Reset() {
+	*x = StopControllerRequest{}
 }
 
-func (m *GetBusInfoRequest) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (*StopControllerRequest) ProtoMessage() {}
+
+func (x *StopControllerRequest) GetTarget() *ControllerTarget {
+	if x != nil {
+		return x.Target
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetBusInfoRequest) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// StopControllerResponse is the response type for StopController.
+type StopControllerResponse struct {
+	unknownFields []byte
+	// ConfigKeys contains the configset keys of the stopped controllers.
+	ConfigKeys []string `protobuf:"bytes,1,rep,name=config_keys,json=configKeys,proto3" json:"configKeys,omitempty"`
 }
 
-func (m *GetBusInfoRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	return len(dAtA) - i, nil
+func (x *StopControllerResponse) Reset() {
+	*x = StopControllerResponse{}
 }
 
-func (m *GetBusInfoResponse) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (*StopControllerResponse) ProtoMessage() {}
+
+func (x *StopControllerResponse) GetConfigKeys() []string {
+	if x != nil {
+		return x.ConfigKeys
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetBusInfoResponse) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// RestartControllerRequest is the request type for RestartController.
+type RestartControllerRequest struct {
+	unknownFields []byte
+	// Target selects the controllers to restart.
+	Target *ControllerTarget `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (m *GetBusInfoResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if len(m.RunningDirectives) > 0 {
-		for iNdEx := len(m.RunningDirectives) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RunningDirectives[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.RunningControllers) > 0 {
-		for iNdEx := len(m.RunningControllers) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RunningControllers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (x *RestartControllerRequest) Reset() {
+	*x = RestartControllerRequest{}
 }
 
-func (m *Config) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EnableExecController {
-		n += 2
+func (*RestartControllerRequest) ProtoMessage() {}
+
+func (x *RestartControllerRequest) GetTarget() *ControllerTarget {
+	if x != nil {
+		return x.Target
 	}
-	n += len(m.unknownFields)
-	return n
+	return nil
 }
 
-func (m *GetBusInfoRequest) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// RestartControllerResponse is the response type for RestartController.
+type RestartControllerResponse struct {
+	unknownFields []byte
+	// ConfigKeys contains the configset keys of the restarted controllers.
+	ConfigKeys []string `protobuf:"bytes,1,rep,name=config_keys,json=configKeys,proto3" json:"configKeys,omitempty"`
+}
+
+func (x *RestartControllerResponse) Reset() {
+	*x = RestartControllerResponse{}
+}
+
+func (*RestartControllerResponse) ProtoMessage() {}
+
+func (x *RestartControllerResponse) GetConfigKeys() []string {
+	if x != nil {
+		return x.ConfigKeys
 	}
-	var l int
-	_ = l
-	n += len(m.unknownFields)
-	return n
+	return nil
 }
 
-func (m *GetBusInfoResponse) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// RemoveControllerRequest is the request type for RemoveController.
+type RemoveControllerRequest struct {
+	unknownFields []byte
+	// Target selects the controllers to remove.
+	Target *ControllerTarget `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *RemoveControllerRequest) Reset() {
+	*x = RemoveControllerRequest{}
+}
+
+func (*RemoveControllerRequest) ProtoMessage() {}
+
+func (x *RemoveControllerRequest) GetTarget() *ControllerTarget {
+	if x != nil {
+		return x.Target
 	}
-	var l int
-	_ = l
-	if len(m.RunningControllers) > 0 {
-		for _, e := range m.RunningControllers {
-			l = e.SizeVT()
-			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
-		}
+	return nil
+}
+
+// RemoveControllerResponse is the response type for RemoveController.
+type RemoveControllerResponse struct {
+	unknownFields []byte
+	// ConfigKeys contains the configset keys of the removed controllers.
+	ConfigKeys []string `protobuf:"bytes,1,rep,name=config_keys,json=configKeys,proto3" json:"configKeys,omitempty"`
+}
+
+func (x *RemoveControllerResponse) Reset() {
+	*x = RemoveControllerResponse{}
+}
+
+func (*RemoveControllerResponse) ProtoMessage() {}
+
+func (x *RemoveControllerResponse) GetConfigKeys() []string {
+	if x != nil {
+		return x.ConfigKeys
 	}
-	if len(m.RunningDirectives) > 0 {
-		for _, e := range m.RunningDirectives {
-			l = e.SizeVT()
-			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
-		}
+	return nil
+}
+
+// WatchBusInfoRequest is the request type for WatchBusInfo.
+type WatchBusInfoRequest struct {
+	unknownFields []byte
+}
+
+func (x *WatchBusInfoRequest) Reset() {
+	*x = WatchBusInfoRequest{}
+}
+
+func (*WatchBusInfoRequest) ProtoMessage() {}
+
+// WatchBusInfoController is a controller in a WatchBusInfo stream.
+type WatchBusInfoController struct {
+	unknownFields []byte
+	// Handle identifies the controller within the stream.
+	Handle uint32 `protobuf:"varint,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	// Info is the controller info.
+	Info *controller.Info `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *WatchBusInfoController) Reset() {
+	*x = WatchBusInfoController{}
+}
+
+func (*WatchBusInfoController) ProtoMessage() {}
+
+func (x *WatchBusInfoController) GetHandle() uint32 {
+	if x != nil {
+		return x.Handle
 	}
-	n += len(m.unknownFields)
-	return n
+	return 0
 }
 
-func (x *Config) MarshalProtoText() string {
-	var sb strings.Builder
-	sb.WriteString("Config {")
-	if x.EnableExecController != false {
-		if sb.Len() > 8 {
-			sb.WriteString(" ")
-		}
-		sb.WriteString("enable_exec_controller: ")
-		sb.WriteString(strconv.FormatBool(x.EnableExecController))
+func (x *WatchBusInfoController) GetInfo() *controller.Info {
+	if x != nil {
+		return x.Info
 	}
-	sb.WriteString("}")
-	return sb.String()
+	return nil
 }
 
-func (x *Config) String() string {
-	return x.MarshalProtoText()
+// WatchBusInfoDirective is a directive in a WatchBusInfo stream.
+type WatchBusInfoDirective struct {
+	unknownFields []byte
+	// Handle identifies the directive instance within the stream.
+	Handle uint32 `protobuf:"varint,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	// State is the directive info.
+	// Set for SNAPSHOT, DIRECTIVE_ADDED and DIRECTIVE_REMOVED events.
+	State *directive.DirectiveState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Idle indicates if the directive is idle.
+	// Set for SNAPSHOT and DIRECTIVE_STATE events.
+	Idle bool `protobuf:"varint,3,opt,name=idle,proto3" json:"idle,omitempty"`
+	// ValueCount is the number of directive values.
+	// Set for SNAPSHOT and DIRECTIVE_STATE events.
+	ValueCount uint32 `protobuf:"varint,4,opt,name=value_count,json=valueCount,proto3" json:"valueCount,omitempty"`
+	// ResolverErrors contains any resolver errors.
+	// Set for SNAPSHOT and DIRECTIVE_STATE events.
+	ResolverErrors []string `protobuf:"bytes,5,rep,name=resolver_errors,json=resolverErrors,proto3" json:"resolverErrors,omitempty"`
 }
 
-func (x *GetBusInfoRequest) MarshalProtoText() string {
-	var sb strings.Builder
-	sb.WriteString("GetBusInfoRequest {")
-	sb.WriteString("}")
-	return sb.String()
+func (x *WatchBusInfoDirective) Reset() {
+	*x = WatchBusInfoDirective{}
 }
 
-func (x *GetBusInfoRequest) String() string {
-	return x.MarshalProtoText()
+func (*WatchBusInfoDirective) ProtoMessage() {}
+
+func (x *WatchBusInfoDirective) GetHandle() uint32 {
+	if x != nil {
+		return x.Handle
+	}
+	return 0
 }
 
-func (x *GetBusInfoResponse) MarshalProtoText() string {
-	var sb strings.Builder
-	sb.WriteString("GetBusInfoResponse {")
-	if len(x.RunningControllers) > 0 {
-		if sb.Len() > 20 {
-			sb.WriteString(" ")
-		}
-		sb.WriteString("running_controllers: [")
-		for i, v := range x.RunningControllers {
-			if i > 0 {
-				sb.WriteString(", ")
+func (x *WatchBusInfoDirective) GetState() *directive.DirectiveState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *WatchBusInfoDirective) GetIdle() bool {
+	if x != nil {
+		return x.Idle
+	}
+	return false
+}
+
+func (x *WatchBusInfoDirective) GetValueCount() uint32 {
+	if x != nil {
+		return x.ValueCount
+	}
+	return 0
+}
+
+func (x *WatchBusInfoDirective) GetResolverErrors() []string {
+	if x != nil {
+		return x.ResolverErrors
+	}
+	return nil
+}
+
+// WatchBusInfoResponse is an event in the WatchBusInfo stream.
+type WatchBusInfoResponse struct {
+	unknownFields []byte
+	// EventType is the type of event.
+	EventType WatchBusInfoEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3" json:"eventType,omitempty"`
+	// Controllers contains the controllers for SNAPSHOT and CONTROLLER events.
+	Controllers []*WatchBusInfoController `protobuf:"bytes,2,rep,name=controllers,proto3" json:"controllers,omitempty"`
+	// Directives contains the directives for SNAPSHOT and DIRECTIVE events.
+	Directives []*WatchBusInfoDirective `protobuf:"bytes,3,rep,name=directives,proto3" json:"directives,omitempty"`
+}
+
+func (x *WatchBusInfoResponse) Reset() {
+	*x = WatchBusInfoResponse{}
+}
+
+func (*WatchBusInfoResponse) ProtoMessage() {}
+
+func (x *WatchBusInfoResponse) GetEventType() WatchBusInfoEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return WatchBusInfoEventType_WatchBusInfoEventType_UNKNOWN
+}
+
+func (x *WatchBusInfoResponse) GetControllers() []*WatchBusInfoController {
+	if x != nil {
+		return x.Controllers
+	}
+	return nil
+}
+
+func (x *WatchBusInfoResponse) GetDirectives() []*WatchBusInfoDirective {
+	if x != nil {
+		return x.Directives
+	}
+	return nil
+}
+
+// ExecDirectiveRequest is the request type for ExecDirective.
+type ExecDirectiveRequest struct {
+	unknownFields []byte
+	// DirectiveTypeId is the networked directive type identifier.
+	DirectiveTypeId string `protobuf:"bytes,1,opt,name=directive_type_id,json=directiveTypeId,proto3" json:"directiveTypeId,omitempty"`
+	// DirectiveBody is the directive encoded with the networked codec.
+	DirectiveBody []byte `protobuf:"bytes,2,opt,name=directive_body,json=directiveBody,proto3" json:"directiveBody,omitempty"`
+}
+
+func (x *ExecDirectiveRequest) Reset() {
+	*x = ExecDirectiveRequest{}
+}
+
+func (*ExecDirectiveRequest) ProtoMessage() {}
+
+func (x *ExecDirectiveRequest) GetDirectiveTypeId() string {
+	if x != nil {
+		return x.DirectiveTypeId
+	}
+	return ""
+}
+
+func (x *ExecDirectiveRequest) GetDirectiveBody() []byte {
+	if x != nil {
+		return x.DirectiveBody
+	}
+	return nil
+}
+
+// ExecDirectiveResponse is an event in the ExecDirective stream.
+type ExecDirectiveResponse struct {
+	unknownFields []byte
+	// EventType is the type of event.
+	EventType ExecDirectiveEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3" json:"eventType,omitempty"`
+	// ValueId is the id of the value that was added or removed.
+	ValueId uint32 `protobuf:"varint,2,opt,name=value_id,json=valueId,proto3" json:"valueId,omitempty"`
+	// ValueBody is the encoded value for VALUE_ADDED events.
+	ValueBody []byte `protobuf:"bytes,3,opt,name=value_body,json=valueBody,proto3" json:"valueBody,omitempty"`
+	// Idle indicates if the directive is idle for IDLE events.
+	Idle bool `protobuf:"varint,4,opt,name=idle,proto3" json:"idle,omitempty"`
+	// ResolverErrors contains any resolver errors for IDLE events.
+	ResolverErrors []string `protobuf:"bytes,5,rep,name=resolver_errors,json=resolverErrors,proto3" json:"resolverErrors,omitempty"`
+}
+
+func (x *ExecDirectiveResponse) Reset() {
+	*x = ExecDirectiveResponse{}
+}
+
+func (*ExecDirectiveResponse) ProtoMessage() {}
+
+func (x *ExecDirectiveResponse) GetEventType() ExecDirectiveEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return ExecDirectiveEventType_ExecDirectiveEventType_UNKNOWN
+}
+
+func (x *ExecDirectiveResponse) GetValueId() uint32 {
+	if x != nil {
+		return x.ValueId
+	}
+	return 0
+}
+
+func (x *ExecDirectiveResponse) GetValueBody() []byte {
+	if x != nil {
+		return x.ValueBody
+	}
+	return nil
+}
+
+func (x *ExecDirectiveResponse) GetIdle() bool {
+	if x != nil {
+		return x.Idle
+	}
+	return false
+}
+
+func (x *ExecDirectiveResponse) GetResolverErrors() []string {
+	if x != nil {
+		return x.ResolverErrors
+	}
+	return nil
+}
+
+// ServeDirectivesRequest is a message from a remote directive handler.
+type ServeDirectivesRequest struct {
+	unknownFields []byte
+	// DirectiveTypeIds is the list of networked directive types to handle.
+	// Set in the first message only.
+	DirectiveTypeIds []string `protobuf:"bytes,1,rep,name=directive_type_ids,json=directiveTypeIds,proto3" json:"directiveTypeIds,omitempty"`
+	// ResolverId is the id of the resolver this event is for.
+	ResolverId uint32 `protobuf:"varint,2,opt,name=resolver_id,json=resolverId,proto3" json:"resolverId,omitempty"`
+	// ResolverEvent is a value or idle event for the resolver.
+	ResolverEvent *ExecDirectiveResponse `protobuf:"bytes,3,opt,name=resolver_event,json=resolverEvent,proto3" json:"resolverEvent,omitempty"`
+	// ResolverError is set if the resolver exited with an error.
+	ResolverError string `protobuf:"bytes,4,opt,name=resolver_error,json=resolverError,proto3" json:"resolverError,omitempty"`
+}
+
+func (x *ServeDirectivesRequest) Reset() {
+	*x = ServeDirectivesRequest{}
+}
+
+func (*ServeDirectivesRequest) ProtoMessage() {}
+
+func (x *ServeDirectivesRequest) GetDirectiveTypeIds() []string {
+	if x != nil {
+		return x.DirectiveTypeIds
+	}
+	return nil
+}
+
+func (x *ServeDirectivesRequest) GetResolverId() uint32 {
+	if x != nil {
+		return x.ResolverId
+	}
+	return 0
+}
+
+func (x *ServeDirectivesRequest) GetResolverEvent() *ExecDirectiveResponse {
+	if x != nil {
+		return x.ResolverEvent
+	}
+	return nil
+}
+
+func (x *ServeDirectivesRequest) GetResolverError() string {
+	if x != nil {
+		return x.ResolverError
+	}
+	return ""
+}
+
+// ServeDirectivesResponse is a message to a remote directive handler.
+type ServeDirectivesResponse struct {
+	unknownFields []byte
+	// ResolverId is the id of the resolver.
+	ResolverId uint32 `protobuf:"varint,1,opt,name=resolver_id,json=resolverId,proto3" json:"resolverId,omitempty"`
+	// DirectiveTypeId is the networked directive type identifier.
+	// Set when starting a resolver.
+	DirectiveTypeId string `protobuf:"bytes,2,opt,name=directive_type_id,json=directiveTypeId,proto3" json:"directiveTypeId,omitempty"`
+	// DirectiveBody is the directive encoded with the networked codec.
+	// Set when starting a resolver.
+	DirectiveBody []byte `protobuf:"bytes,3,opt,name=directive_body,json=directiveBody,proto3" json:"directiveBody,omitempty"`
+	// Cancel indicates the resolver was canceled.
+	Cancel bool `protobuf:"varint,4,opt,name=cancel,proto3" json:"cancel,omitempty"`
+}
+
+func (x *ServeDirectivesResponse) Reset() {
+	*x = ServeDirectivesResponse{}
+}
+
+func (*ServeDirectivesResponse) ProtoMessage() {}
+
+func (x *ServeDirectivesResponse) GetResolverId() uint32 {
+	if x != nil {
+		return x.ResolverId
+	}
+	return 0
+}
+
+func (x *ServeDirectivesResponse) GetDirectiveTypeId() string {
+	if x != nil {
+		return x.DirectiveTypeId
+	}
+	return ""
+}
+
+func (x *ServeDirectivesResponse) GetDirectiveBody() []byte {
+	if x != nil {
+		return x.DirectiveBody
+	}
+	return nil
+}
+
+func (x *ServeDirectivesResponse) GetCancel() bool {
+	if x != nil {
+		return x.Cancel
+	}
+	return false
+}
+
+// GetHealthRequest is the request type for GetHealth.
+type GetHealthRequest struct {
+	unknownFields []byte
+}
+
+func (x *GetHealthRequest) Reset() {
+	*x = GetHealthRequest{}
+}
+
+func (*GetHealthRequest) ProtoMessage() {}
+
+// GetHealthResponse is the response type for GetHealth.
+type GetHealthResponse struct {
+	unknownFields []byte
+	// Live indicates the bus responded within the liveness timeout.
+	Live bool `protobuf:"varint,1,opt,name=live,proto3" json:"live,omitempty"`
+	// LiveError is the reason the bus is not live.
+	LiveError string `protobuf:"bytes,2,opt,name=live_error,json=liveError,proto3" json:"liveError,omitempty"`
+	// Ready indicates every configset key has a running controller without errors.
+	Ready bool `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	// FailingKeys contains the configset keys that are not ready.
+	// Sorted by config key.
+	FailingKeys []*ConfigKeyHealth `protobuf:"bytes,4,rep,name=failing_keys,json=failingKeys,proto3" json:"failingKeys,omitempty"`
+}
+
+func (x *GetHealthResponse) Reset() {
+	*x = GetHealthResponse{}
+}
+
+func (*GetHealthResponse) ProtoMessage() {}
+
+func (x *GetHealthResponse) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *GetHealthResponse) GetLiveError() string {
+	if x != nil {
+		return x.LiveError
+	}
+	return ""
+}
+
+func (x *GetHealthResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *GetHealthResponse) GetFailingKeys() []*ConfigKeyHealth {
+	if x != nil {
+		return x.FailingKeys
+	}
+	return nil
+}
+
+// ConfigKeyHealth is the health of a configset key.
+type ConfigKeyHealth struct {
+	unknownFields []byte
+	// ConfigKey is the configset key.
+	ConfigKey string `protobuf:"bytes,1,opt,name=config_key,json=configKey,proto3" json:"configKey,omitempty"`
+	// Error is the reason the key is not ready.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ConfigKeyHealth) Reset() {
+	*x = ConfigKeyHealth{}
+}
+
+func (*ConfigKeyHealth) ProtoMessage() {}
+
+func (x *ConfigKeyHealth) GetConfigKey() string {
+	if x != nil {
+		return x.ConfigKey
+	}
+	return ""
+}
+
+func (x *ConfigKeyHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// PlanConfigSetRequest is the request type for PlanConfigSet.
+type PlanConfigSetRequest struct {
+	unknownFields []byte
+	// ConfigSetYaml is the configset to plan in YAML or JSON format.
+	ConfigSetYaml string `protobuf:"bytes,1,opt,name=config_set_yaml,json=configSetYaml,proto3" json:"configSetYaml,omitempty"`
+	// Replace plans replacing the applied configs with the configset.
+	// Applied keys which are not in the configset are stopped.
+	// If false, plans adding the configset like ApplyConfigSet: applied keys
+	// which are not in the configset are unchanged.
+	Replace bool `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+}
+
+func (x *PlanConfigSetRequest) Reset() {
+	*x = PlanConfigSetRequest{}
+}
+
+func (*PlanConfigSetRequest) ProtoMessage() {}
+
+func (x *PlanConfigSetRequest) GetConfigSetYaml() string {
+	if x != nil {
+		return x.ConfigSetYaml
+	}
+	return ""
+}
+
+func (x *PlanConfigSetRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+// PlanConfigSetResponse is the response type for PlanConfigSet.
+type PlanConfigSetResponse struct {
+	unknownFields []byte
+	// Keys contains the planned change for each configset key.
+	// Sorted by config key.
+	Keys []*ConfigKeyPlan `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *PlanConfigSetResponse) Reset() {
+	*x = PlanConfigSetResponse{}
+}
+
+func (*PlanConfigSetResponse) ProtoMessage() {}
+
+func (x *PlanConfigSetResponse) GetKeys() []*ConfigKeyPlan {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// ConfigKeyPlan is the planned change to a configset key.
+type ConfigKeyPlan struct {
+	unknownFields []byte
+	// ConfigKey is the configset key.
+	ConfigKey string `protobuf:"bytes,1,opt,name=config_key,json=configKey,proto3" json:"configKey,omitempty"`
+	// Action is the planned change.
+	Action PlanAction `protobuf:"varint,2,opt,name=action,proto3" json:"action,omitempty"`
+	// ConfigId is the config id of the planned config.
+	// If the key is stopped, the config id of the applied config.
+	ConfigId string `protobuf:"bytes,3,opt,name=config_id,json=configId,proto3" json:"configId,omitempty"`
+	// CurrentRev is the revision of the applied config, if any.
+	CurrentRev uint64 `protobuf:"varint,4,opt,name=current_rev,json=currentRev,proto3" json:"currentRev,omitempty"`
+	// Rev is the revision of the planned config, if any.
+	Rev uint64 `protobuf:"varint,5,opt,name=rev,proto3" json:"rev,omitempty"`
+	// CurrentConfigYaml is the applied config in YAML format, if any.
+	CurrentConfigYaml string `protobuf:"bytes,6,opt,name=current_config_yaml,json=currentConfigYaml,proto3" json:"currentConfigYaml,omitempty"`
+	// ConfigYaml is the planned config in YAML format, if valid.
+	ConfigYaml string `protobuf:"bytes,7,opt,name=config_yaml,json=configYaml,proto3" json:"configYaml,omitempty"`
+	// Error is the reason the planned config is invalid.
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// UnknownConfigId indicates no factory is available for the config id.
+	UnknownConfigId bool `protobuf:"varint,9,opt,name=unknown_config_id,json=unknownConfigId,proto3" json:"unknownConfigId,omitempty"`
+}
+
+func (x *ConfigKeyPlan) Reset() {
+	*x = ConfigKeyPlan{}
+}
+
+func (*ConfigKeyPlan) ProtoMessage() {}
+
+func (x *ConfigKeyPlan) GetConfigKey() string {
+	if x != nil {
+		return x.ConfigKey
+	}
+	return ""
+}
+
+func (x *ConfigKeyPlan) GetAction() PlanAction {
+	if x != nil {
+		return x.Action
+	}
+	return PlanAction_PlanAction_UNCHANGED
+}
+
+func (x *ConfigKeyPlan) GetConfigId() string {
+	if x != nil {
+		return x.ConfigId
+	}
+	return ""
+}
+
+func (x *ConfigKeyPlan) GetCurrentRev() uint64 {
+	if x != nil {
+		return x.CurrentRev
+	}
+	return 0
+}
+
+func (x *ConfigKeyPlan) GetRev() uint64 {
+	if x != nil {
+		return x.Rev
+	}
+	return 0
+}
+
+func (x *ConfigKeyPlan) GetCurrentConfigYaml() string {
+	if x != nil {
+		return x.CurrentConfigYaml
+	}
+	return ""
+}
+
+func (x *ConfigKeyPlan) GetConfigYaml() string {
+	if x != nil {
+		return x.ConfigYaml
+	}
+	return ""
+}
+
+func (x *ConfigKeyPlan) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ConfigKeyPlan) GetUnknownConfigId() bool {
+	if x != nil {
+		return x.UnknownConfigId
+	}
+	return false
+}
+
+// GetConfigSetHistoryRequest is the request type for GetConfigSetHistory.
+type GetConfigSetHistoryRequest struct {
+	unknownFields []byte
+	// StoreKey is the key of the stored configset.
+	// If empty, lists the stored keys.
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"storeKey,omitempty"`
+}
+
+func (x *GetConfigSetHistoryRequest) Reset() {
+	*x = GetConfigSetHistoryRequest{}
+}
+
+func (*GetConfigSetHistoryRequest) ProtoMessage() {}
+
+func (x *GetConfigSetHistoryRequest) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+// GetConfigSetHistoryResponse is the response type for GetConfigSetHistory.
+type GetConfigSetHistoryResponse struct {
+	unknownFields []byte
+	// Revisions contains the revisions of the key, oldest first.
+	Revisions []*store.ConfigSetRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// StoreKeys contains the stored keys if no key was requested, sorted.
+	StoreKeys []string `protobuf:"bytes,2,rep,name=store_keys,json=storeKeys,proto3" json:"storeKeys,omitempty"`
+}
+
+func (x *GetConfigSetHistoryResponse) Reset() {
+	*x = GetConfigSetHistoryResponse{}
+}
+
+func (*GetConfigSetHistoryResponse) ProtoMessage() {}
+
+func (x *GetConfigSetHistoryResponse) GetRevisions() []*store.ConfigSetRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *GetConfigSetHistoryResponse) GetStoreKeys() []string {
+	if x != nil {
+		return x.StoreKeys
+	}
+	return nil
+}
+
+// PutConfigSetRequest is the request type for PutConfigSet.
+type PutConfigSetRequest struct {
+	unknownFields []byte
+	// StoreKey is the key to store the configset under.
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"storeKey,omitempty"`
+	// ConfigSetYaml is the configset in YAML or JSON format.
+	ConfigSetYaml string `protobuf:"bytes,2,opt,name=config_set_yaml,json=configSetYaml,proto3" json:"configSetYaml,omitempty"`
+	// Source describes who or what stored the revision.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *PutConfigSetRequest) Reset() {
+	*x = PutConfigSetRequest{}
+}
+
+func (*PutConfigSetRequest) ProtoMessage() {}
+
+func (x *PutConfigSetRequest) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+func (x *PutConfigSetRequest) GetConfigSetYaml() string {
+	if x != nil {
+		return x.ConfigSetYaml
+	}
+	return ""
+}
+
+func (x *PutConfigSetRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// PutConfigSetResponse is the response type for PutConfigSet.
+type PutConfigSetResponse struct {
+	unknownFields []byte
+	// Revision is the stored revision.
+	Revision *store.ConfigSetRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *PutConfigSetResponse) Reset() {
+	*x = PutConfigSetResponse{}
+}
+
+func (*PutConfigSetResponse) ProtoMessage() {}
+
+func (x *PutConfigSetResponse) GetRevision() *store.ConfigSetRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// RollbackConfigSetRequest is the request type for RollbackConfigSet.
+type RollbackConfigSetRequest struct {
+	unknownFields []byte
+	// StoreKey is the key of the stored configset.
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"storeKey,omitempty"`
+	// Rev is the revision to roll back to.
+	Rev uint64 `protobuf:"varint,2,opt,name=rev,proto3" json:"rev,omitempty"`
+	// Source describes who or what rolled back the configset.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *RollbackConfigSetRequest) Reset() {
+	*x = RollbackConfigSetRequest{}
+}
+
+func (*RollbackConfigSetRequest) ProtoMessage() {}
+
+func (x *RollbackConfigSetRequest) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+func (x *RollbackConfigSetRequest) GetRev() uint64 {
+	if x != nil {
+		return x.Rev
+	}
+	return 0
+}
+
+func (x *RollbackConfigSetRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// RollbackConfigSetResponse is the response type for RollbackConfigSet.
+type RollbackConfigSetResponse struct {
+	unknownFields []byte
+	// Revision is the new revision with the configset of the earlier revision.
+	Revision *store.ConfigSetRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RollbackConfigSetResponse) Reset() {
+	*x = RollbackConfigSetResponse{}
+}
+
+func (*RollbackConfigSetResponse) ProtoMessage() {}
+
+func (x *RollbackConfigSetResponse) GetRevision() *store.ConfigSetRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (m *Config) CloneVT() *Config {
+	if m == nil {
+		return (*Config)(nil)
+	}
+	r := new(Config)
+	r.EnableExecController = m.EnableExecController
+	r.EnableExecDirective = m.EnableExecDirective
+	r.EnableServeDirectives = m.EnableServeDirectives
+	r.EnableControlControllers = m.EnableControlControllers
+	r.EnableConfigsetStore = m.EnableConfigsetStore
+	r.NoServeExecDirectives = m.NoServeExecDirectives
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *Config) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *GetBusInfoRequest) CloneVT() *GetBusInfoRequest {
+	if m == nil {
+		return (*GetBusInfoRequest)(nil)
+	}
+	r := new(GetBusInfoRequest)
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *GetBusInfoRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *GetBusInfoResponse) CloneVT() *GetBusInfoResponse {
+	if m == nil {
+		return (*GetBusInfoResponse)(nil)
+	}
+	r := new(GetBusInfoResponse)
+	if rhs := m.RunningControllers; rhs != nil {
+		r.RunningControllers = make([]*controller.Info, len(rhs))
+		for k, v := range rhs {
+			r.RunningControllers[k] = v.CloneVT()
+		}
+	}
+	if rhs := m.RunningDirectives; rhs != nil {
+		r.RunningDirectives = make([]*directive.DirectiveState, len(rhs))
+		for k, v := range rhs {
+			r.RunningDirectives[k] = v.CloneVT()
+		}
+	}
+	if rhs := m.ConfigSetControllers; rhs != nil {
+		r.ConfigSetControllers = make([]*exec.ExecControllerResponse, len(rhs))
+		for k, v := range rhs {
+			r.ConfigSetControllers[k] = v.CloneVT()
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *GetBusInfoResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *GetDirectiveInfoRequest) CloneVT() *GetDirectiveInfoRequest {
+	if m == nil {
+		return (*GetDirectiveInfoRequest)(nil)
+	}
+	r := new(GetDirectiveInfoRequest)
+	r.InstanceId = m.InstanceId
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *GetDirectiveInfoRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *GetDirectiveInfoResponse) CloneVT() *GetDirectiveInfoResponse {
+	if m == nil {
+		return (*GetDirectiveInfoResponse)(nil)
+	}
+	r := new(GetDirectiveInfoResponse)
+	r.Found = m.Found
+	r.DirectiveState = m.DirectiveState.CloneVT()
+	r.Idle = m.Idle
+	if rhs := m.ResolverErrors; rhs != nil {
+		r.ResolverErrors = slices.Clone(rhs)
+	}
+	if rhs := m.Values; rhs != nil {
+		r.Values = make([]*directive.ValueInfo, len(rhs))
+		for k, v := range rhs {
+			r.Values[k] = v.CloneVT()
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *GetDirectiveInfoResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *ListFactoriesRequest) CloneVT() *ListFactoriesRequest {
+	if m == nil {
+		return (*ListFactoriesRequest)(nil)
+	}
+	r := new(ListFactoriesRequest)
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ListFactoriesRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *ListFactoriesResponse) CloneVT() *ListFactoriesResponse {
+	if m == nil {
+		return (*ListFactoriesResponse)(nil)
+	}
+	r := new(ListFactoriesResponse)
+	if rhs := m.Factories; rhs != nil {
+		r.Factories = make([]*FactoryInfo, len(rhs))
+		for k, v := range rhs {
+			r.Factories[k] = v.CloneVT()
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ListFactoriesResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *FactoryInfo) CloneVT() *FactoryInfo {
+	if m == nil {
+		return (*FactoryInfo)(nil)
+	}
+	r := new(FactoryInfo)
+	r.ConfigId = m.ConfigId
+	r.Version = m.Version
+	r.ResolverId = m.ResolverId
+	r.ConfigSchema = m.ConfigSchema
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *FactoryInfo) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *ControllerTarget) CloneVT() *ControllerTarget {
+	if m == nil {
+		return (*ControllerTarget)(nil)
+	}
+	r := new(ControllerTarget)
+	r.ConfigKey = m.ConfigKey
+	r.ControllerId = m.ControllerId
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ControllerTarget) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *StopControllerRequest) CloneVT() *StopControllerRequest {
+	if m == nil {
+		return (*StopControllerRequest)(nil)
+	}
+	r := new(StopControllerRequest)
+	r.Target = m.Target.CloneVT()
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *StopControllerRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *StopControllerResponse) CloneVT() *StopControllerResponse {
+	if m == nil {
+		return (*StopControllerResponse)(nil)
+	}
+	r := new(StopControllerResponse)
+	if rhs := m.ConfigKeys; rhs != nil {
+		r.ConfigKeys = slices.Clone(rhs)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *StopControllerResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *RestartControllerRequest) CloneVT() *RestartControllerRequest {
+	if m == nil {
+		return (*RestartControllerRequest)(nil)
+	}
+	r := new(RestartControllerRequest)
+	r.Target = m.Target.CloneVT()
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *RestartControllerRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *RestartControllerResponse) CloneVT() *RestartControllerResponse {
+	if m == nil {
+		return (*RestartControllerResponse)(nil)
+	}
+	r := new(RestartControllerResponse)
+	if rhs := m.ConfigKeys; rhs != nil {
+		r.ConfigKeys = slices.Clone(rhs)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *RestartControllerResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *RemoveControllerRequest) CloneVT() *RemoveControllerRequest {
+	if m == nil {
+		return (*RemoveControllerRequest)(nil)
+	}
+	r := new(RemoveControllerRequest)
+	r.Target = m.Target.CloneVT()
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *RemoveControllerRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *RemoveControllerResponse) CloneVT() *RemoveControllerResponse {
+	if m == nil {
+		return (*RemoveControllerResponse)(nil)
+	}
+	r := new(RemoveControllerResponse)
+	if rhs := m.ConfigKeys; rhs != nil {
+		r.ConfigKeys = slices.Clone(rhs)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *RemoveControllerResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *WatchBusInfoRequest) CloneVT() *WatchBusInfoRequest {
+	if m == nil {
+		return (*WatchBusInfoRequest)(nil)
+	}
+	r := new(WatchBusInfoRequest)
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *WatchBusInfoRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *WatchBusInfoController) CloneVT() *WatchBusInfoController {
+	if m == nil {
+		return (*WatchBusInfoController)(nil)
+	}
+	r := new(WatchBusInfoController)
+	r.Handle = m.Handle
+	r.Info = m.Info.CloneVT()
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *WatchBusInfoController) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *WatchBusInfoDirective) CloneVT() *WatchBusInfoDirective {
+	if m == nil {
+		return (*WatchBusInfoDirective)(nil)
+	}
+	r := new(WatchBusInfoDirective)
+	r.Handle = m.Handle
+	r.State = m.State.CloneVT()
+	r.Idle = m.Idle
+	r.ValueCount = m.ValueCount
+	if rhs := m.ResolverErrors; rhs != nil {
+		r.ResolverErrors = slices.Clone(rhs)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *WatchBusInfoDirective) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *WatchBusInfoResponse) CloneVT() *WatchBusInfoResponse {
+	if m == nil {
+		return (*WatchBusInfoResponse)(nil)
+	}
+	r := new(WatchBusInfoResponse)
+	r.EventType = m.EventType
+	if rhs := m.Controllers; rhs != nil {
+		r.Controllers = make([]*WatchBusInfoController, len(rhs))
+		for k, v := range rhs {
+			r.Controllers[k] = v.CloneVT()
+		}
+	}
+	if rhs := m.Directives; rhs != nil {
+		r.Directives = make([]*WatchBusInfoDirective, len(rhs))
+		for k, v := range rhs {
+			r.Directives[k] = v.CloneVT()
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *WatchBusInfoResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *ExecDirectiveRequest) CloneVT() *ExecDirectiveRequest {
+	if m == nil {
+		return (*ExecDirectiveRequest)(nil)
+	}
+	r := new(ExecDirectiveRequest)
+	r.DirectiveTypeId = m.DirectiveTypeId
+	if rhs := m.DirectiveBody; rhs != nil {
+		r.DirectiveBody = slices.Clone(rhs)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ExecDirectiveRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *ExecDirectiveResponse) CloneVT() *ExecDirectiveResponse {
+	if m == nil {
+		return (*ExecDirectiveResponse)(nil)
+	}
+	r := new(ExecDirectiveResponse)
+	r.EventType = m.EventType
+	r.ValueId = m.ValueId
+	r.Idle = m.Idle
+	if rhs := m.ValueBody; rhs != nil {
+		r.ValueBody = slices.Clone(rhs)
+	}
+	if rhs := m.ResolverErrors; rhs != nil {
+		r.ResolverErrors = slices.Clone(rhs)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ExecDirectiveResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *ServeDirectivesRequest) CloneVT() *ServeDirectivesRequest {
+	if m == nil {
+		return (*ServeDirectivesRequest)(nil)
+	}
+	r := new(ServeDirectivesRequest)
+	r.ResolverId = m.ResolverId
+	r.ResolverEvent = m.ResolverEvent.CloneVT()
+	r.ResolverError = m.ResolverError
+	if rhs := m.DirectiveTypeIds; rhs != nil {
+		r.DirectiveTypeIds = slices.Clone(rhs)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ServeDirectivesRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *ServeDirectivesResponse) CloneVT() *ServeDirectivesResponse {
+	if m == nil {
+		return (*ServeDirectivesResponse)(nil)
+	}
+	r := new(ServeDirectivesResponse)
+	r.ResolverId = m.ResolverId
+	r.DirectiveTypeId = m.DirectiveTypeId
+	r.Cancel = m.Cancel
+	if rhs := m.DirectiveBody; rhs != nil {
+		r.DirectiveBody = slices.Clone(rhs)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ServeDirectivesResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *GetHealthRequest) CloneVT() *GetHealthRequest {
+	if m == nil {
+		return (*GetHealthRequest)(nil)
+	}
+	r := new(GetHealthRequest)
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *GetHealthRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *GetHealthResponse) CloneVT() *GetHealthResponse {
+	if m == nil {
+		return (*GetHealthResponse)(nil)
+	}
+	r := new(GetHealthResponse)
+	r.Live = m.Live
+	r.LiveError = m.LiveError
+	r.Ready = m.Ready
+	if rhs := m.FailingKeys; rhs != nil {
+		r.FailingKeys = make([]*ConfigKeyHealth, len(rhs))
+		for k, v := range rhs {
+			r.FailingKeys[k] = v.CloneVT()
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *GetHealthResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *ConfigKeyHealth) CloneVT() *ConfigKeyHealth {
+	if m == nil {
+		return (*ConfigKeyHealth)(nil)
+	}
+	r := new(ConfigKeyHealth)
+	r.ConfigKey = m.ConfigKey
+	r.Error = m.Error
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ConfigKeyHealth) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *PlanConfigSetRequest) CloneVT() *PlanConfigSetRequest {
+	if m == nil {
+		return (*PlanConfigSetRequest)(nil)
+	}
+	r := new(PlanConfigSetRequest)
+	r.ConfigSetYaml = m.ConfigSetYaml
+	r.Replace = m.Replace
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *PlanConfigSetRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *PlanConfigSetResponse) CloneVT() *PlanConfigSetResponse {
+	if m == nil {
+		return (*PlanConfigSetResponse)(nil)
+	}
+	r := new(PlanConfigSetResponse)
+	if rhs := m.Keys; rhs != nil {
+		r.Keys = make([]*ConfigKeyPlan, len(rhs))
+		for k, v := range rhs {
+			r.Keys[k] = v.CloneVT()
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *PlanConfigSetResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *ConfigKeyPlan) CloneVT() *ConfigKeyPlan {
+	if m == nil {
+		return (*ConfigKeyPlan)(nil)
+	}
+	r := new(ConfigKeyPlan)
+	r.ConfigKey = m.ConfigKey
+	r.Action = m.Action
+	r.ConfigId = m.ConfigId
+	r.CurrentRev = m.CurrentRev
+	r.Rev = m.Rev
+	r.CurrentConfigYaml = m.CurrentConfigYaml
+	r.ConfigYaml = m.ConfigYaml
+	r.Error = m.Error
+	r.UnknownConfigId = m.UnknownConfigId
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ConfigKeyPlan) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *GetConfigSetHistoryRequest) CloneVT() *GetConfigSetHistoryRequest {
+	if m == nil {
+		return (*GetConfigSetHistoryRequest)(nil)
+	}
+	r := new(GetConfigSetHistoryRequest)
+	r.StoreKey = m.StoreKey
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *GetConfigSetHistoryRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *GetConfigSetHistoryResponse) CloneVT() *GetConfigSetHistoryResponse {
+	if m == nil {
+		return (*GetConfigSetHistoryResponse)(nil)
+	}
+	r := new(GetConfigSetHistoryResponse)
+	if rhs := m.Revisions; rhs != nil {
+		r.Revisions = make([]*store.ConfigSetRevision, len(rhs))
+		for k, v := range rhs {
+			r.Revisions[k] = v.CloneVT()
+		}
+	}
+	if rhs := m.StoreKeys; rhs != nil {
+		r.StoreKeys = slices.Clone(rhs)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *GetConfigSetHistoryResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *PutConfigSetRequest) CloneVT() *PutConfigSetRequest {
+	if m == nil {
+		return (*PutConfigSetRequest)(nil)
+	}
+	r := new(PutConfigSetRequest)
+	r.StoreKey = m.StoreKey
+	r.ConfigSetYaml = m.ConfigSetYaml
+	r.Source = m.Source
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *PutConfigSetRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *PutConfigSetResponse) CloneVT() *PutConfigSetResponse {
+	if m == nil {
+		return (*PutConfigSetResponse)(nil)
+	}
+	r := new(PutConfigSetResponse)
+	r.Revision = m.Revision.CloneVT()
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *PutConfigSetResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *RollbackConfigSetRequest) CloneVT() *RollbackConfigSetRequest {
+	if m == nil {
+		return (*RollbackConfigSetRequest)(nil)
+	}
+	r := new(RollbackConfigSetRequest)
+	r.StoreKey = m.StoreKey
+	r.Rev = m.Rev
+	r.Source = m.Source
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *RollbackConfigSetRequest) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *RollbackConfigSetResponse) CloneVT() *RollbackConfigSetResponse {
+	if m == nil {
+		return (*RollbackConfigSetResponse)(nil)
+	}
+	r := new(RollbackConfigSetResponse)
+	r.Revision = m.Revision.CloneVT()
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *RollbackConfigSetResponse) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (this *Config) EqualVT(that *Config) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.EnableExecController != that.EnableExecController {
+		return false
+	}
+	if this.EnableExecDirective != that.EnableExecDirective {
+		return false
+	}
+	if this.EnableServeDirectives != that.EnableServeDirectives {
+		return false
+	}
+	if this.EnableControlControllers != that.EnableControlControllers {
+		return false
+	}
+	if this.EnableConfigsetStore != that.EnableConfigsetStore {
+		return false
+	}
+	if this.NoServeExecDirectives != that.NoServeExecDirectives {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Config) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Config)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *GetBusInfoRequest) EqualVT(that *GetBusInfoRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *GetBusInfoRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*GetBusInfoRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *GetBusInfoResponse) EqualVT(that *GetBusInfoResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.RunningControllers) != len(that.RunningControllers) {
+		return false
+	}
+	for i, vx := range this.RunningControllers {
+		vy := that.RunningControllers[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &controller.Info{}
+			}
+			if q == nil {
+				q = &controller.Info{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.RunningDirectives) != len(that.RunningDirectives) {
+		return false
+	}
+	for i, vx := range this.RunningDirectives {
+		vy := that.RunningDirectives[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &directive.DirectiveState{}
+			}
+			if q == nil {
+				q = &directive.DirectiveState{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.ConfigSetControllers) != len(that.ConfigSetControllers) {
+		return false
+	}
+	for i, vx := range this.ConfigSetControllers {
+		vy := that.ConfigSetControllers[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &exec.ExecControllerResponse{}
+			}
+			if q == nil {
+				q = &exec.ExecControllerResponse{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *GetBusInfoResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*GetBusInfoResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *GetDirectiveInfoRequest) EqualVT(that *GetDirectiveInfoRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.InstanceId != that.InstanceId {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *GetDirectiveInfoRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*GetDirectiveInfoRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *GetDirectiveInfoResponse) EqualVT(that *GetDirectiveInfoResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Found != that.Found {
+		return false
+	}
+	if !this.DirectiveState.EqualVT(that.DirectiveState) {
+		return false
+	}
+	if this.Idle != that.Idle {
+		return false
+	}
+	if len(this.ResolverErrors) != len(that.ResolverErrors) {
+		return false
+	}
+	for i, vx := range this.ResolverErrors {
+		vy := that.ResolverErrors[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Values) != len(that.Values) {
+		return false
+	}
+	for i, vx := range this.Values {
+		vy := that.Values[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &directive.ValueInfo{}
+			}
+			if q == nil {
+				q = &directive.ValueInfo{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *GetDirectiveInfoResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*GetDirectiveInfoResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ListFactoriesRequest) EqualVT(that *ListFactoriesRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ListFactoriesRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ListFactoriesRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ListFactoriesResponse) EqualVT(that *ListFactoriesResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.Factories) != len(that.Factories) {
+		return false
+	}
+	for i, vx := range this.Factories {
+		vy := that.Factories[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &FactoryInfo{}
+			}
+			if q == nil {
+				q = &FactoryInfo{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ListFactoriesResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ListFactoriesResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *FactoryInfo) EqualVT(that *FactoryInfo) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.ConfigId != that.ConfigId {
+		return false
+	}
+	if this.Version != that.Version {
+		return false
+	}
+	if this.ResolverId != that.ResolverId {
+		return false
+	}
+	if this.ConfigSchema != that.ConfigSchema {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *FactoryInfo) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*FactoryInfo)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ControllerTarget) EqualVT(that *ControllerTarget) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.ConfigKey != that.ConfigKey {
+		return false
+	}
+	if this.ControllerId != that.ControllerId {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ControllerTarget) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ControllerTarget)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *StopControllerRequest) EqualVT(that *StopControllerRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !this.Target.EqualVT(that.Target) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *StopControllerRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*StopControllerRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *StopControllerResponse) EqualVT(that *StopControllerResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.ConfigKeys) != len(that.ConfigKeys) {
+		return false
+	}
+	for i, vx := range this.ConfigKeys {
+		vy := that.ConfigKeys[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *StopControllerResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*StopControllerResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *RestartControllerRequest) EqualVT(that *RestartControllerRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !this.Target.EqualVT(that.Target) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *RestartControllerRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*RestartControllerRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *RestartControllerResponse) EqualVT(that *RestartControllerResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.ConfigKeys) != len(that.ConfigKeys) {
+		return false
+	}
+	for i, vx := range this.ConfigKeys {
+		vy := that.ConfigKeys[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *RestartControllerResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*RestartControllerResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *RemoveControllerRequest) EqualVT(that *RemoveControllerRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !this.Target.EqualVT(that.Target) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *RemoveControllerRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*RemoveControllerRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *RemoveControllerResponse) EqualVT(that *RemoveControllerResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.ConfigKeys) != len(that.ConfigKeys) {
+		return false
+	}
+	for i, vx := range this.ConfigKeys {
+		vy := that.ConfigKeys[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *RemoveControllerResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*RemoveControllerResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *WatchBusInfoRequest) EqualVT(that *WatchBusInfoRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *WatchBusInfoRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*WatchBusInfoRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *WatchBusInfoController) EqualVT(that *WatchBusInfoController) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Handle != that.Handle {
+		return false
+	}
+	if !this.Info.EqualVT(that.Info) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *WatchBusInfoController) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*WatchBusInfoController)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *WatchBusInfoDirective) EqualVT(that *WatchBusInfoDirective) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Handle != that.Handle {
+		return false
+	}
+	if !this.State.EqualVT(that.State) {
+		return false
+	}
+	if this.Idle != that.Idle {
+		return false
+	}
+	if this.ValueCount != that.ValueCount {
+		return false
+	}
+	if len(this.ResolverErrors) != len(that.ResolverErrors) {
+		return false
+	}
+	for i, vx := range this.ResolverErrors {
+		vy := that.ResolverErrors[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *WatchBusInfoDirective) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*WatchBusInfoDirective)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *WatchBusInfoResponse) EqualVT(that *WatchBusInfoResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.EventType != that.EventType {
+		return false
+	}
+	if len(this.Controllers) != len(that.Controllers) {
+		return false
+	}
+	for i, vx := range this.Controllers {
+		vy := that.Controllers[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &WatchBusInfoController{}
+			}
+			if q == nil {
+				q = &WatchBusInfoController{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.Directives) != len(that.Directives) {
+		return false
+	}
+	for i, vx := range this.Directives {
+		vy := that.Directives[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &WatchBusInfoDirective{}
+			}
+			if q == nil {
+				q = &WatchBusInfoDirective{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *WatchBusInfoResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*WatchBusInfoResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ExecDirectiveRequest) EqualVT(that *ExecDirectiveRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.DirectiveTypeId != that.DirectiveTypeId {
+		return false
+	}
+	if string(this.DirectiveBody) != string(that.DirectiveBody) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ExecDirectiveRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ExecDirectiveRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ExecDirectiveResponse) EqualVT(that *ExecDirectiveResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.EventType != that.EventType {
+		return false
+	}
+	if this.ValueId != that.ValueId {
+		return false
+	}
+	if string(this.ValueBody) != string(that.ValueBody) {
+		return false
+	}
+	if this.Idle != that.Idle {
+		return false
+	}
+	if len(this.ResolverErrors) != len(that.ResolverErrors) {
+		return false
+	}
+	for i, vx := range this.ResolverErrors {
+		vy := that.ResolverErrors[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ExecDirectiveResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ExecDirectiveResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ServeDirectivesRequest) EqualVT(that *ServeDirectivesRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.DirectiveTypeIds) != len(that.DirectiveTypeIds) {
+		return false
+	}
+	for i, vx := range this.DirectiveTypeIds {
+		vy := that.DirectiveTypeIds[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if this.ResolverId != that.ResolverId {
+		return false
+	}
+	if !this.ResolverEvent.EqualVT(that.ResolverEvent) {
+		return false
+	}
+	if this.ResolverError != that.ResolverError {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ServeDirectivesRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ServeDirectivesRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ServeDirectivesResponse) EqualVT(that *ServeDirectivesResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.ResolverId != that.ResolverId {
+		return false
+	}
+	if this.DirectiveTypeId != that.DirectiveTypeId {
+		return false
+	}
+	if string(this.DirectiveBody) != string(that.DirectiveBody) {
+		return false
+	}
+	if this.Cancel != that.Cancel {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ServeDirectivesResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ServeDirectivesResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *GetHealthRequest) EqualVT(that *GetHealthRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *GetHealthRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*GetHealthRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *GetHealthResponse) EqualVT(that *GetHealthResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Live != that.Live {
+		return false
+	}
+	if this.LiveError != that.LiveError {
+		return false
+	}
+	if this.Ready != that.Ready {
+		return false
+	}
+	if len(this.FailingKeys) != len(that.FailingKeys) {
+		return false
+	}
+	for i, vx := range this.FailingKeys {
+		vy := that.FailingKeys[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ConfigKeyHealth{}
+			}
+			if q == nil {
+				q = &ConfigKeyHealth{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *GetHealthResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*GetHealthResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ConfigKeyHealth) EqualVT(that *ConfigKeyHealth) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.ConfigKey != that.ConfigKey {
+		return false
+	}
+	if this.Error != that.Error {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ConfigKeyHealth) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ConfigKeyHealth)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *PlanConfigSetRequest) EqualVT(that *PlanConfigSetRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.ConfigSetYaml != that.ConfigSetYaml {
+		return false
+	}
+	if this.Replace != that.Replace {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *PlanConfigSetRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*PlanConfigSetRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *PlanConfigSetResponse) EqualVT(that *PlanConfigSetResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.Keys) != len(that.Keys) {
+		return false
+	}
+	for i, vx := range this.Keys {
+		vy := that.Keys[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ConfigKeyPlan{}
+			}
+			if q == nil {
+				q = &ConfigKeyPlan{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *PlanConfigSetResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*PlanConfigSetResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ConfigKeyPlan) EqualVT(that *ConfigKeyPlan) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.ConfigKey != that.ConfigKey {
+		return false
+	}
+	if this.Action != that.Action {
+		return false
+	}
+	if this.ConfigId != that.ConfigId {
+		return false
+	}
+	if this.CurrentRev != that.CurrentRev {
+		return false
+	}
+	if this.Rev != that.Rev {
+		return false
+	}
+	if this.CurrentConfigYaml != that.CurrentConfigYaml {
+		return false
+	}
+	if this.ConfigYaml != that.ConfigYaml {
+		return false
+	}
+	if this.Error != that.Error {
+		return false
+	}
+	if this.UnknownConfigId != that.UnknownConfigId {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ConfigKeyPlan) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ConfigKeyPlan)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *GetConfigSetHistoryRequest) EqualVT(that *GetConfigSetHistoryRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.StoreKey != that.StoreKey {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *GetConfigSetHistoryRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*GetConfigSetHistoryRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *GetConfigSetHistoryResponse) EqualVT(that *GetConfigSetHistoryResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if len(this.Revisions) != len(that.Revisions) {
+		return false
+	}
+	for i, vx := range this.Revisions {
+		vy := that.Revisions[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &store.ConfigSetRevision{}
+			}
+			if q == nil {
+				q = &store.ConfigSetRevision{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.StoreKeys) != len(that.StoreKeys) {
+		return false
+	}
+	for i, vx := range this.StoreKeys {
+		vy := that.StoreKeys[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *GetConfigSetHistoryResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*GetConfigSetHistoryResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *PutConfigSetRequest) EqualVT(that *PutConfigSetRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.StoreKey != that.StoreKey {
+		return false
+	}
+	if this.ConfigSetYaml != that.ConfigSetYaml {
+		return false
+	}
+	if this.Source != that.Source {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *PutConfigSetRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*PutConfigSetRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *PutConfigSetResponse) EqualVT(that *PutConfigSetResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !this.Revision.EqualVT(that.Revision) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *PutConfigSetResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*PutConfigSetResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *RollbackConfigSetRequest) EqualVT(that *RollbackConfigSetRequest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.StoreKey != that.StoreKey {
+		return false
+	}
+	if this.Rev != that.Rev {
+		return false
+	}
+	if this.Source != that.Source {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *RollbackConfigSetRequest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*RollbackConfigSetRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *RollbackConfigSetResponse) EqualVT(that *RollbackConfigSetResponse) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !this.Revision.EqualVT(that.Revision) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *RollbackConfigSetResponse) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*RollbackConfigSetResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+// MarshalProtoJSON marshals the WatchBusInfoEventType to JSON.
+func (x WatchBusInfoEventType) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), WatchBusInfoEventType_name)
+}
+
+// MarshalText marshals the WatchBusInfoEventType to text.
+func (x WatchBusInfoEventType) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), WatchBusInfoEventType_name)), nil
+}
+
+// MarshalJSON marshals the WatchBusInfoEventType to JSON.
+func (x WatchBusInfoEventType) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the WatchBusInfoEventType from JSON.
+func (x *WatchBusInfoEventType) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(WatchBusInfoEventType_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read WatchBusInfoEventType enum: %v", err)
+		return
+	}
+	*x = WatchBusInfoEventType(v)
+}
+
+// UnmarshalText unmarshals the WatchBusInfoEventType from text.
+func (x *WatchBusInfoEventType) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), WatchBusInfoEventType_value)
+	if err != nil {
+		return err
+	}
+	*x = WatchBusInfoEventType(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the WatchBusInfoEventType from JSON.
+func (x *WatchBusInfoEventType) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ExecDirectiveEventType to JSON.
+func (x ExecDirectiveEventType) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), ExecDirectiveEventType_name)
+}
+
+// MarshalText marshals the ExecDirectiveEventType to text.
+func (x ExecDirectiveEventType) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), ExecDirectiveEventType_name)), nil
+}
+
+// MarshalJSON marshals the ExecDirectiveEventType to JSON.
+func (x ExecDirectiveEventType) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ExecDirectiveEventType from JSON.
+func (x *ExecDirectiveEventType) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(ExecDirectiveEventType_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read ExecDirectiveEventType enum: %v", err)
+		return
+	}
+	*x = ExecDirectiveEventType(v)
+}
+
+// UnmarshalText unmarshals the ExecDirectiveEventType from text.
+func (x *ExecDirectiveEventType) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), ExecDirectiveEventType_value)
+	if err != nil {
+		return err
+	}
+	*x = ExecDirectiveEventType(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the ExecDirectiveEventType from JSON.
+func (x *ExecDirectiveEventType) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the PlanAction to JSON.
+func (x PlanAction) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), PlanAction_name)
+}
+
+// MarshalText marshals the PlanAction to text.
+func (x PlanAction) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), PlanAction_name)), nil
+}
+
+// MarshalJSON marshals the PlanAction to JSON.
+func (x PlanAction) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the PlanAction from JSON.
+func (x *PlanAction) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(PlanAction_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read PlanAction enum: %v", err)
+		return
+	}
+	*x = PlanAction(v)
+}
+
+// UnmarshalText unmarshals the PlanAction from text.
+func (x *PlanAction) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), PlanAction_value)
+	if err != nil {
+		return err
+	}
+	*x = PlanAction(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the PlanAction from JSON.
+func (x *PlanAction) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Config message to JSON.
+func (x *Config) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EnableExecController || s.HasField("enableExecController") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("enableExecController")
+		s.WriteBool(x.EnableExecController)
+	}
+	if x.EnableExecDirective || s.HasField("enableExecDirective") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("enableExecDirective")
+		s.WriteBool(x.EnableExecDirective)
+	}
+	if x.EnableServeDirectives || s.HasField("enableServeDirectives") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("enableServeDirectives")
+		s.WriteBool(x.EnableServeDirectives)
+	}
+	if x.EnableControlControllers || s.HasField("enableControlControllers") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("enableControlControllers")
+		s.WriteBool(x.EnableControlControllers)
+	}
+	if x.EnableConfigsetStore || s.HasField("enableConfigsetStore") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("enableConfigsetStore")
+		s.WriteBool(x.EnableConfigsetStore)
+	}
+	if x.NoServeExecDirectives || s.HasField("noServeExecDirectives") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("noServeExecDirectives")
+		s.WriteBool(x.NoServeExecDirectives)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Config to JSON.
+func (x *Config) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Config message from JSON.
+func (x *Config) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "enable_exec_controller", "enableExecController":
+			s.AddField("enable_exec_controller")
+			x.EnableExecController = s.ReadBool()
+		case "enable_exec_directive", "enableExecDirective":
+			s.AddField("enable_exec_directive")
+			x.EnableExecDirective = s.ReadBool()
+		case "enable_serve_directives", "enableServeDirectives":
+			s.AddField("enable_serve_directives")
+			x.EnableServeDirectives = s.ReadBool()
+		case "enable_control_controllers", "enableControlControllers":
+			s.AddField("enable_control_controllers")
+			x.EnableControlControllers = s.ReadBool()
+		case "enable_configset_store", "enableConfigsetStore":
+			s.AddField("enable_configset_store")
+			x.EnableConfigsetStore = s.ReadBool()
+		case "no_serve_exec_directives", "noServeExecDirectives":
+			s.AddField("no_serve_exec_directives")
+			x.NoServeExecDirectives = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Config from JSON.
+func (x *Config) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetBusInfoRequest message to JSON.
+func (x *GetBusInfoRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetBusInfoRequest to JSON.
+func (x *GetBusInfoRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetBusInfoRequest message from JSON.
+func (x *GetBusInfoRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		// no fields
+	})
+}
+
+// UnmarshalJSON unmarshals the GetBusInfoRequest from JSON.
+func (x *GetBusInfoRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetBusInfoResponse message to JSON.
+func (x *GetBusInfoResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.RunningControllers) > 0 || s.HasField("runningControllers") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("runningControllers")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.RunningControllers {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("runningControllers"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.RunningDirectives) > 0 || s.HasField("runningDirectives") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("runningDirectives")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.RunningDirectives {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("runningDirectives"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.ConfigSetControllers) > 0 || s.HasField("configSetControllers") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configSetControllers")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.ConfigSetControllers {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("configSetControllers"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetBusInfoResponse to JSON.
+func (x *GetBusInfoResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetBusInfoResponse message from JSON.
+func (x *GetBusInfoResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "running_controllers", "runningControllers":
+			s.AddField("running_controllers")
+			if s.ReadNil() {
+				x.RunningControllers = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.RunningControllers = append(x.RunningControllers, nil)
+					return
+				}
+				v := &controller.Info{}
+				v.UnmarshalProtoJSON(s.WithField("running_controllers", false))
+				if s.Err() != nil {
+					return
+				}
+				x.RunningControllers = append(x.RunningControllers, v)
+			})
+		case "running_directives", "runningDirectives":
+			s.AddField("running_directives")
+			if s.ReadNil() {
+				x.RunningDirectives = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.RunningDirectives = append(x.RunningDirectives, nil)
+					return
+				}
+				v := &directive.DirectiveState{}
+				v.UnmarshalProtoJSON(s.WithField("running_directives", false))
+				if s.Err() != nil {
+					return
+				}
+				x.RunningDirectives = append(x.RunningDirectives, v)
+			})
+		case "config_set_controllers", "configSetControllers":
+			s.AddField("config_set_controllers")
+			if s.ReadNil() {
+				x.ConfigSetControllers = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.ConfigSetControllers = append(x.ConfigSetControllers, nil)
+					return
+				}
+				v := &exec.ExecControllerResponse{}
+				v.UnmarshalProtoJSON(s.WithField("config_set_controllers", false))
+				if s.Err() != nil {
+					return
+				}
+				x.ConfigSetControllers = append(x.ConfigSetControllers, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetBusInfoResponse from JSON.
+func (x *GetBusInfoResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetDirectiveInfoRequest message to JSON.
+func (x *GetDirectiveInfoRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.InstanceId != 0 || s.HasField("instanceId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("instanceId")
+		s.WriteUint32(x.InstanceId)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetDirectiveInfoRequest to JSON.
+func (x *GetDirectiveInfoRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetDirectiveInfoRequest message from JSON.
+func (x *GetDirectiveInfoRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "instance_id", "instanceId":
+			s.AddField("instance_id")
+			x.InstanceId = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetDirectiveInfoRequest from JSON.
+func (x *GetDirectiveInfoRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetDirectiveInfoResponse message to JSON.
+func (x *GetDirectiveInfoResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Found || s.HasField("found") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("found")
+		s.WriteBool(x.Found)
+	}
+	if x.DirectiveState != nil || s.HasField("directiveState") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("directiveState")
+		x.DirectiveState.MarshalProtoJSON(s.WithField("directiveState"))
+	}
+	if x.Idle || s.HasField("idle") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("idle")
+		s.WriteBool(x.Idle)
+	}
+	if len(x.ResolverErrors) > 0 || s.HasField("resolverErrors") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("resolverErrors")
+		s.WriteStringArray(x.ResolverErrors)
+	}
+	if len(x.Values) > 0 || s.HasField("values") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("values")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Values {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("values"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetDirectiveInfoResponse to JSON.
+func (x *GetDirectiveInfoResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetDirectiveInfoResponse message from JSON.
+func (x *GetDirectiveInfoResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "found":
+			s.AddField("found")
+			x.Found = s.ReadBool()
+		case "directive_state", "directiveState":
+			if s.ReadNil() {
+				x.DirectiveState = nil
+				return
+			}
+			x.DirectiveState = &directive.DirectiveState{}
+			x.DirectiveState.UnmarshalProtoJSON(s.WithField("directive_state", true))
+		case "idle":
+			s.AddField("idle")
+			x.Idle = s.ReadBool()
+		case "resolver_errors", "resolverErrors":
+			s.AddField("resolver_errors")
+			if s.ReadNil() {
+				x.ResolverErrors = nil
+				return
+			}
+			x.ResolverErrors = s.ReadStringArray()
+		case "values":
+			s.AddField("values")
+			if s.ReadNil() {
+				x.Values = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Values = append(x.Values, nil)
+					return
+				}
+				v := &directive.ValueInfo{}
+				v.UnmarshalProtoJSON(s.WithField("values", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Values = append(x.Values, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetDirectiveInfoResponse from JSON.
+func (x *GetDirectiveInfoResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ListFactoriesRequest message to JSON.
+func (x *ListFactoriesRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ListFactoriesRequest to JSON.
+func (x *ListFactoriesRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ListFactoriesRequest message from JSON.
+func (x *ListFactoriesRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		// no fields
+	})
+}
+
+// UnmarshalJSON unmarshals the ListFactoriesRequest from JSON.
+func (x *ListFactoriesRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ListFactoriesResponse message to JSON.
+func (x *ListFactoriesResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Factories) > 0 || s.HasField("factories") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("factories")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Factories {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("factories"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ListFactoriesResponse to JSON.
+func (x *ListFactoriesResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ListFactoriesResponse message from JSON.
+func (x *ListFactoriesResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "factories":
+			s.AddField("factories")
+			if s.ReadNil() {
+				x.Factories = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Factories = append(x.Factories, nil)
+					return
+				}
+				v := &FactoryInfo{}
+				v.UnmarshalProtoJSON(s.WithField("factories", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Factories = append(x.Factories, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ListFactoriesResponse from JSON.
+func (x *ListFactoriesResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the FactoryInfo message to JSON.
+func (x *FactoryInfo) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ConfigId != "" || s.HasField("configId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configId")
+		s.WriteString(x.ConfigId)
+	}
+	if x.Version != "" || s.HasField("version") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("version")
+		s.WriteString(x.Version)
+	}
+	if x.ResolverId != "" || s.HasField("resolverId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("resolverId")
+		s.WriteString(x.ResolverId)
+	}
+	if x.ConfigSchema != "" || s.HasField("configSchema") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configSchema")
+		s.WriteString(x.ConfigSchema)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the FactoryInfo to JSON.
+func (x *FactoryInfo) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the FactoryInfo message from JSON.
+func (x *FactoryInfo) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "config_id", "configId":
+			s.AddField("config_id")
+			x.ConfigId = s.ReadString()
+		case "version":
+			s.AddField("version")
+			x.Version = s.ReadString()
+		case "resolver_id", "resolverId":
+			s.AddField("resolver_id")
+			x.ResolverId = s.ReadString()
+		case "config_schema", "configSchema":
+			s.AddField("config_schema")
+			x.ConfigSchema = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the FactoryInfo from JSON.
+func (x *FactoryInfo) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ControllerTarget message to JSON.
+func (x *ControllerTarget) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ConfigKey != "" || s.HasField("configKey") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configKey")
+		s.WriteString(x.ConfigKey)
+	}
+	if x.ControllerId != "" || s.HasField("controllerId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("controllerId")
+		s.WriteString(x.ControllerId)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ControllerTarget to JSON.
+func (x *ControllerTarget) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ControllerTarget message from JSON.
+func (x *ControllerTarget) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "config_key", "configKey":
+			s.AddField("config_key")
+			x.ConfigKey = s.ReadString()
+		case "controller_id", "controllerId":
+			s.AddField("controller_id")
+			x.ControllerId = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ControllerTarget from JSON.
+func (x *ControllerTarget) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StopControllerRequest message to JSON.
+func (x *StopControllerRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Target != nil || s.HasField("target") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("target")
+		x.Target.MarshalProtoJSON(s.WithField("target"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StopControllerRequest to JSON.
+func (x *StopControllerRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StopControllerRequest message from JSON.
+func (x *StopControllerRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "target":
+			if s.ReadNil() {
+				x.Target = nil
+				return
+			}
+			x.Target = &ControllerTarget{}
+			x.Target.UnmarshalProtoJSON(s.WithField("target", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StopControllerRequest from JSON.
+func (x *StopControllerRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StopControllerResponse message to JSON.
+func (x *StopControllerResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.ConfigKeys) > 0 || s.HasField("configKeys") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configKeys")
+		s.WriteStringArray(x.ConfigKeys)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StopControllerResponse to JSON.
+func (x *StopControllerResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StopControllerResponse message from JSON.
+func (x *StopControllerResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "config_keys", "configKeys":
+			s.AddField("config_keys")
+			if s.ReadNil() {
+				x.ConfigKeys = nil
+				return
+			}
+			x.ConfigKeys = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StopControllerResponse from JSON.
+func (x *StopControllerResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RestartControllerRequest message to JSON.
+func (x *RestartControllerRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Target != nil || s.HasField("target") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("target")
+		x.Target.MarshalProtoJSON(s.WithField("target"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RestartControllerRequest to JSON.
+func (x *RestartControllerRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RestartControllerRequest message from JSON.
+func (x *RestartControllerRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "target":
+			if s.ReadNil() {
+				x.Target = nil
+				return
+			}
+			x.Target = &ControllerTarget{}
+			x.Target.UnmarshalProtoJSON(s.WithField("target", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RestartControllerRequest from JSON.
+func (x *RestartControllerRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RestartControllerResponse message to JSON.
+func (x *RestartControllerResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.ConfigKeys) > 0 || s.HasField("configKeys") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configKeys")
+		s.WriteStringArray(x.ConfigKeys)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RestartControllerResponse to JSON.
+func (x *RestartControllerResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RestartControllerResponse message from JSON.
+func (x *RestartControllerResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "config_keys", "configKeys":
+			s.AddField("config_keys")
+			if s.ReadNil() {
+				x.ConfigKeys = nil
+				return
+			}
+			x.ConfigKeys = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RestartControllerResponse from JSON.
+func (x *RestartControllerResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RemoveControllerRequest message to JSON.
+func (x *RemoveControllerRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Target != nil || s.HasField("target") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("target")
+		x.Target.MarshalProtoJSON(s.WithField("target"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RemoveControllerRequest to JSON.
+func (x *RemoveControllerRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RemoveControllerRequest message from JSON.
+func (x *RemoveControllerRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "target":
+			if s.ReadNil() {
+				x.Target = nil
+				return
+			}
+			x.Target = &ControllerTarget{}
+			x.Target.UnmarshalProtoJSON(s.WithField("target", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RemoveControllerRequest from JSON.
+func (x *RemoveControllerRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RemoveControllerResponse message to JSON.
+func (x *RemoveControllerResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.ConfigKeys) > 0 || s.HasField("configKeys") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configKeys")
+		s.WriteStringArray(x.ConfigKeys)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RemoveControllerResponse to JSON.
+func (x *RemoveControllerResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RemoveControllerResponse message from JSON.
+func (x *RemoveControllerResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "config_keys", "configKeys":
+			s.AddField("config_keys")
+			if s.ReadNil() {
+				x.ConfigKeys = nil
+				return
+			}
+			x.ConfigKeys = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RemoveControllerResponse from JSON.
+func (x *RemoveControllerResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the WatchBusInfoRequest message to JSON.
+func (x *WatchBusInfoRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the WatchBusInfoRequest to JSON.
+func (x *WatchBusInfoRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the WatchBusInfoRequest message from JSON.
+func (x *WatchBusInfoRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		// no fields
+	})
+}
+
+// UnmarshalJSON unmarshals the WatchBusInfoRequest from JSON.
+func (x *WatchBusInfoRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the WatchBusInfoController message to JSON.
+func (x *WatchBusInfoController) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Handle != 0 || s.HasField("handle") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("handle")
+		s.WriteUint32(x.Handle)
+	}
+	if x.Info != nil || s.HasField("info") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("info")
+		x.Info.MarshalProtoJSON(s.WithField("info"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the WatchBusInfoController to JSON.
+func (x *WatchBusInfoController) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the WatchBusInfoController message from JSON.
+func (x *WatchBusInfoController) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "handle":
+			s.AddField("handle")
+			x.Handle = s.ReadUint32()
+		case "info":
+			if s.ReadNil() {
+				x.Info = nil
+				return
+			}
+			x.Info = &controller.Info{}
+			x.Info.UnmarshalProtoJSON(s.WithField("info", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the WatchBusInfoController from JSON.
+func (x *WatchBusInfoController) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the WatchBusInfoDirective message to JSON.
+func (x *WatchBusInfoDirective) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Handle != 0 || s.HasField("handle") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("handle")
+		s.WriteUint32(x.Handle)
+	}
+	if x.State != nil || s.HasField("state") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("state")
+		x.State.MarshalProtoJSON(s.WithField("state"))
+	}
+	if x.Idle || s.HasField("idle") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("idle")
+		s.WriteBool(x.Idle)
+	}
+	if x.ValueCount != 0 || s.HasField("valueCount") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("valueCount")
+		s.WriteUint32(x.ValueCount)
+	}
+	if len(x.ResolverErrors) > 0 || s.HasField("resolverErrors") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("resolverErrors")
+		s.WriteStringArray(x.ResolverErrors)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the WatchBusInfoDirective to JSON.
+func (x *WatchBusInfoDirective) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the WatchBusInfoDirective message from JSON.
+func (x *WatchBusInfoDirective) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "handle":
+			s.AddField("handle")
+			x.Handle = s.ReadUint32()
+		case "state":
+			if s.ReadNil() {
+				x.State = nil
+				return
+			}
+			x.State = &directive.DirectiveState{}
+			x.State.UnmarshalProtoJSON(s.WithField("state", true))
+		case "idle":
+			s.AddField("idle")
+			x.Idle = s.ReadBool()
+		case "value_count", "valueCount":
+			s.AddField("value_count")
+			x.ValueCount = s.ReadUint32()
+		case "resolver_errors", "resolverErrors":
+			s.AddField("resolver_errors")
+			if s.ReadNil() {
+				x.ResolverErrors = nil
+				return
+			}
+			x.ResolverErrors = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the WatchBusInfoDirective from JSON.
+func (x *WatchBusInfoDirective) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the WatchBusInfoResponse message to JSON.
+func (x *WatchBusInfoResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EventType != 0 || s.HasField("eventType") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("eventType")
+		x.EventType.MarshalProtoJSON(s)
+	}
+	if len(x.Controllers) > 0 || s.HasField("controllers") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("controllers")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Controllers {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("controllers"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.Directives) > 0 || s.HasField("directives") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("directives")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Directives {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("directives"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the WatchBusInfoResponse to JSON.
+func (x *WatchBusInfoResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the WatchBusInfoResponse message from JSON.
+func (x *WatchBusInfoResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "event_type", "eventType":
+			s.AddField("event_type")
+			x.EventType.UnmarshalProtoJSON(s)
+		case "controllers":
+			s.AddField("controllers")
+			if s.ReadNil() {
+				x.Controllers = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Controllers = append(x.Controllers, nil)
+					return
+				}
+				v := &WatchBusInfoController{}
+				v.UnmarshalProtoJSON(s.WithField("controllers", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Controllers = append(x.Controllers, v)
+			})
+		case "directives":
+			s.AddField("directives")
+			if s.ReadNil() {
+				x.Directives = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Directives = append(x.Directives, nil)
+					return
+				}
+				v := &WatchBusInfoDirective{}
+				v.UnmarshalProtoJSON(s.WithField("directives", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Directives = append(x.Directives, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the WatchBusInfoResponse from JSON.
+func (x *WatchBusInfoResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ExecDirectiveRequest message to JSON.
+func (x *ExecDirectiveRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.DirectiveTypeId != "" || s.HasField("directiveTypeId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("directiveTypeId")
+		s.WriteString(x.DirectiveTypeId)
+	}
+	if len(x.DirectiveBody) > 0 || s.HasField("directiveBody") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("directiveBody")
+		s.WriteBytes(x.DirectiveBody)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ExecDirectiveRequest to JSON.
+func (x *ExecDirectiveRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ExecDirectiveRequest message from JSON.
+func (x *ExecDirectiveRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "directive_type_id", "directiveTypeId":
+			s.AddField("directive_type_id")
+			x.DirectiveTypeId = s.ReadString()
+		case "directive_body", "directiveBody":
+			s.AddField("directive_body")
+			x.DirectiveBody = s.ReadBytes()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ExecDirectiveRequest from JSON.
+func (x *ExecDirectiveRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ExecDirectiveResponse message to JSON.
+func (x *ExecDirectiveResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EventType != 0 || s.HasField("eventType") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("eventType")
+		x.EventType.MarshalProtoJSON(s)
+	}
+	if x.ValueId != 0 || s.HasField("valueId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("valueId")
+		s.WriteUint32(x.ValueId)
+	}
+	if len(x.ValueBody) > 0 || s.HasField("valueBody") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("valueBody")
+		s.WriteBytes(x.ValueBody)
+	}
+	if x.Idle || s.HasField("idle") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("idle")
+		s.WriteBool(x.Idle)
+	}
+	if len(x.ResolverErrors) > 0 || s.HasField("resolverErrors") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("resolverErrors")
+		s.WriteStringArray(x.ResolverErrors)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ExecDirectiveResponse to JSON.
+func (x *ExecDirectiveResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ExecDirectiveResponse message from JSON.
+func (x *ExecDirectiveResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "event_type", "eventType":
+			s.AddField("event_type")
+			x.EventType.UnmarshalProtoJSON(s)
+		case "value_id", "valueId":
+			s.AddField("value_id")
+			x.ValueId = s.ReadUint32()
+		case "value_body", "valueBody":
+			s.AddField("value_body")
+			x.ValueBody = s.ReadBytes()
+		case "idle":
+			s.AddField("idle")
+			x.Idle = s.ReadBool()
+		case "resolver_errors", "resolverErrors":
+			s.AddField("resolver_errors")
+			if s.ReadNil() {
+				x.ResolverErrors = nil
+				return
+			}
+			x.ResolverErrors = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ExecDirectiveResponse from JSON.
+func (x *ExecDirectiveResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ServeDirectivesRequest message to JSON.
+func (x *ServeDirectivesRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.DirectiveTypeIds) > 0 || s.HasField("directiveTypeIds") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("directiveTypeIds")
+		s.WriteStringArray(x.DirectiveTypeIds)
+	}
+	if x.ResolverId != 0 || s.HasField("resolverId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("resolverId")
+		s.WriteUint32(x.ResolverId)
+	}
+	if x.ResolverEvent != nil || s.HasField("resolverEvent") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("resolverEvent")
+		x.ResolverEvent.MarshalProtoJSON(s.WithField("resolverEvent"))
+	}
+	if x.ResolverError != "" || s.HasField("resolverError") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("resolverError")
+		s.WriteString(x.ResolverError)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ServeDirectivesRequest to JSON.
+func (x *ServeDirectivesRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ServeDirectivesRequest message from JSON.
+func (x *ServeDirectivesRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "directive_type_ids", "directiveTypeIds":
+			s.AddField("directive_type_ids")
+			if s.ReadNil() {
+				x.DirectiveTypeIds = nil
+				return
+			}
+			x.DirectiveTypeIds = s.ReadStringArray()
+		case "resolver_id", "resolverId":
+			s.AddField("resolver_id")
+			x.ResolverId = s.ReadUint32()
+		case "resolver_event", "resolverEvent":
+			if s.ReadNil() {
+				x.ResolverEvent = nil
+				return
+			}
+			x.ResolverEvent = &ExecDirectiveResponse{}
+			x.ResolverEvent.UnmarshalProtoJSON(s.WithField("resolver_event", true))
+		case "resolver_error", "resolverError":
+			s.AddField("resolver_error")
+			x.ResolverError = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ServeDirectivesRequest from JSON.
+func (x *ServeDirectivesRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ServeDirectivesResponse message to JSON.
+func (x *ServeDirectivesResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ResolverId != 0 || s.HasField("resolverId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("resolverId")
+		s.WriteUint32(x.ResolverId)
+	}
+	if x.DirectiveTypeId != "" || s.HasField("directiveTypeId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("directiveTypeId")
+		s.WriteString(x.DirectiveTypeId)
+	}
+	if len(x.DirectiveBody) > 0 || s.HasField("directiveBody") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("directiveBody")
+		s.WriteBytes(x.DirectiveBody)
+	}
+	if x.Cancel || s.HasField("cancel") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("cancel")
+		s.WriteBool(x.Cancel)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ServeDirectivesResponse to JSON.
+func (x *ServeDirectivesResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ServeDirectivesResponse message from JSON.
+func (x *ServeDirectivesResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "resolver_id", "resolverId":
+			s.AddField("resolver_id")
+			x.ResolverId = s.ReadUint32()
+		case "directive_type_id", "directiveTypeId":
+			s.AddField("directive_type_id")
+			x.DirectiveTypeId = s.ReadString()
+		case "directive_body", "directiveBody":
+			s.AddField("directive_body")
+			x.DirectiveBody = s.ReadBytes()
+		case "cancel":
+			s.AddField("cancel")
+			x.Cancel = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ServeDirectivesResponse from JSON.
+func (x *ServeDirectivesResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetHealthRequest message to JSON.
+func (x *GetHealthRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetHealthRequest to JSON.
+func (x *GetHealthRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetHealthRequest message from JSON.
+func (x *GetHealthRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		// no fields
+	})
+}
+
+// UnmarshalJSON unmarshals the GetHealthRequest from JSON.
+func (x *GetHealthRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetHealthResponse message to JSON.
+func (x *GetHealthResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Live || s.HasField("live") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("live")
+		s.WriteBool(x.Live)
+	}
+	if x.LiveError != "" || s.HasField("liveError") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("liveError")
+		s.WriteString(x.LiveError)
+	}
+	if x.Ready || s.HasField("ready") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ready")
+		s.WriteBool(x.Ready)
+	}
+	if len(x.FailingKeys) > 0 || s.HasField("failingKeys") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("failingKeys")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.FailingKeys {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("failingKeys"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetHealthResponse to JSON.
+func (x *GetHealthResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetHealthResponse message from JSON.
+func (x *GetHealthResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "live":
+			s.AddField("live")
+			x.Live = s.ReadBool()
+		case "live_error", "liveError":
+			s.AddField("live_error")
+			x.LiveError = s.ReadString()
+		case "ready":
+			s.AddField("ready")
+			x.Ready = s.ReadBool()
+		case "failing_keys", "failingKeys":
+			s.AddField("failing_keys")
+			if s.ReadNil() {
+				x.FailingKeys = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.FailingKeys = append(x.FailingKeys, nil)
+					return
+				}
+				v := &ConfigKeyHealth{}
+				v.UnmarshalProtoJSON(s.WithField("failing_keys", false))
+				if s.Err() != nil {
+					return
+				}
+				x.FailingKeys = append(x.FailingKeys, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetHealthResponse from JSON.
+func (x *GetHealthResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ConfigKeyHealth message to JSON.
+func (x *ConfigKeyHealth) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ConfigKey != "" || s.HasField("configKey") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configKey")
+		s.WriteString(x.ConfigKey)
+	}
+	if x.Error != "" || s.HasField("error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("error")
+		s.WriteString(x.Error)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ConfigKeyHealth to JSON.
+func (x *ConfigKeyHealth) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ConfigKeyHealth message from JSON.
+func (x *ConfigKeyHealth) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "config_key", "configKey":
+			s.AddField("config_key")
+			x.ConfigKey = s.ReadString()
+		case "error":
+			s.AddField("error")
+			x.Error = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ConfigKeyHealth from JSON.
+func (x *ConfigKeyHealth) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the PlanConfigSetRequest message to JSON.
+func (x *PlanConfigSetRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ConfigSetYaml != "" || s.HasField("configSetYaml") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configSetYaml")
+		s.WriteString(x.ConfigSetYaml)
+	}
+	if x.Replace || s.HasField("replace") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("replace")
+		s.WriteBool(x.Replace)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the PlanConfigSetRequest to JSON.
+func (x *PlanConfigSetRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the PlanConfigSetRequest message from JSON.
+func (x *PlanConfigSetRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "config_set_yaml", "configSetYaml":
+			s.AddField("config_set_yaml")
+			x.ConfigSetYaml = s.ReadString()
+		case "replace":
+			s.AddField("replace")
+			x.Replace = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the PlanConfigSetRequest from JSON.
+func (x *PlanConfigSetRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the PlanConfigSetResponse message to JSON.
+func (x *PlanConfigSetResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Keys) > 0 || s.HasField("keys") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("keys")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Keys {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("keys"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the PlanConfigSetResponse to JSON.
+func (x *PlanConfigSetResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the PlanConfigSetResponse message from JSON.
+func (x *PlanConfigSetResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "keys":
+			s.AddField("keys")
+			if s.ReadNil() {
+				x.Keys = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Keys = append(x.Keys, nil)
+					return
+				}
+				v := &ConfigKeyPlan{}
+				v.UnmarshalProtoJSON(s.WithField("keys", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Keys = append(x.Keys, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the PlanConfigSetResponse from JSON.
+func (x *PlanConfigSetResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ConfigKeyPlan message to JSON.
+func (x *ConfigKeyPlan) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ConfigKey != "" || s.HasField("configKey") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configKey")
+		s.WriteString(x.ConfigKey)
+	}
+	if x.Action != 0 || s.HasField("action") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("action")
+		x.Action.MarshalProtoJSON(s)
+	}
+	if x.ConfigId != "" || s.HasField("configId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configId")
+		s.WriteString(x.ConfigId)
+	}
+	if x.CurrentRev != 0 || s.HasField("currentRev") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("currentRev")
+		s.WriteUint64(x.CurrentRev)
+	}
+	if x.Rev != 0 || s.HasField("rev") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rev")
+		s.WriteUint64(x.Rev)
+	}
+	if x.CurrentConfigYaml != "" || s.HasField("currentConfigYaml") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("currentConfigYaml")
+		s.WriteString(x.CurrentConfigYaml)
+	}
+	if x.ConfigYaml != "" || s.HasField("configYaml") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configYaml")
+		s.WriteString(x.ConfigYaml)
+	}
+	if x.Error != "" || s.HasField("error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("error")
+		s.WriteString(x.Error)
+	}
+	if x.UnknownConfigId || s.HasField("unknownConfigId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("unknownConfigId")
+		s.WriteBool(x.UnknownConfigId)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ConfigKeyPlan to JSON.
+func (x *ConfigKeyPlan) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ConfigKeyPlan message from JSON.
+func (x *ConfigKeyPlan) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "config_key", "configKey":
+			s.AddField("config_key")
+			x.ConfigKey = s.ReadString()
+		case "action":
+			s.AddField("action")
+			x.Action.UnmarshalProtoJSON(s)
+		case "config_id", "configId":
+			s.AddField("config_id")
+			x.ConfigId = s.ReadString()
+		case "current_rev", "currentRev":
+			s.AddField("current_rev")
+			x.CurrentRev = s.ReadUint64()
+		case "rev":
+			s.AddField("rev")
+			x.Rev = s.ReadUint64()
+		case "current_config_yaml", "currentConfigYaml":
+			s.AddField("current_config_yaml")
+			x.CurrentConfigYaml = s.ReadString()
+		case "config_yaml", "configYaml":
+			s.AddField("config_yaml")
+			x.ConfigYaml = s.ReadString()
+		case "error":
+			s.AddField("error")
+			x.Error = s.ReadString()
+		case "unknown_config_id", "unknownConfigId":
+			s.AddField("unknown_config_id")
+			x.UnknownConfigId = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ConfigKeyPlan from JSON.
+func (x *ConfigKeyPlan) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetConfigSetHistoryRequest message to JSON.
+func (x *GetConfigSetHistoryRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.StoreKey != "" || s.HasField("storeKey") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("storeKey")
+		s.WriteString(x.StoreKey)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetConfigSetHistoryRequest to JSON.
+func (x *GetConfigSetHistoryRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetConfigSetHistoryRequest message from JSON.
+func (x *GetConfigSetHistoryRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "store_key", "storeKey":
+			s.AddField("store_key")
+			x.StoreKey = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetConfigSetHistoryRequest from JSON.
+func (x *GetConfigSetHistoryRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetConfigSetHistoryResponse message to JSON.
+func (x *GetConfigSetHistoryResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Revisions) > 0 || s.HasField("revisions") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("revisions")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Revisions {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("revisions"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.StoreKeys) > 0 || s.HasField("storeKeys") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("storeKeys")
+		s.WriteStringArray(x.StoreKeys)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetConfigSetHistoryResponse to JSON.
+func (x *GetConfigSetHistoryResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetConfigSetHistoryResponse message from JSON.
+func (x *GetConfigSetHistoryResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "revisions":
+			s.AddField("revisions")
+			if s.ReadNil() {
+				x.Revisions = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Revisions = append(x.Revisions, nil)
+					return
+				}
+				v := &store.ConfigSetRevision{}
+				v.UnmarshalProtoJSON(s.WithField("revisions", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Revisions = append(x.Revisions, v)
+			})
+		case "store_keys", "storeKeys":
+			s.AddField("store_keys")
+			if s.ReadNil() {
+				x.StoreKeys = nil
+				return
+			}
+			x.StoreKeys = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetConfigSetHistoryResponse from JSON.
+func (x *GetConfigSetHistoryResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the PutConfigSetRequest message to JSON.
+func (x *PutConfigSetRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.StoreKey != "" || s.HasField("storeKey") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("storeKey")
+		s.WriteString(x.StoreKey)
+	}
+	if x.ConfigSetYaml != "" || s.HasField("configSetYaml") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configSetYaml")
+		s.WriteString(x.ConfigSetYaml)
+	}
+	if x.Source != "" || s.HasField("source") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("source")
+		s.WriteString(x.Source)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the PutConfigSetRequest to JSON.
+func (x *PutConfigSetRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the PutConfigSetRequest message from JSON.
+func (x *PutConfigSetRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "store_key", "storeKey":
+			s.AddField("store_key")
+			x.StoreKey = s.ReadString()
+		case "config_set_yaml", "configSetYaml":
+			s.AddField("config_set_yaml")
+			x.ConfigSetYaml = s.ReadString()
+		case "source":
+			s.AddField("source")
+			x.Source = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the PutConfigSetRequest from JSON.
+func (x *PutConfigSetRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the PutConfigSetResponse message to JSON.
+func (x *PutConfigSetResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Revision != nil || s.HasField("revision") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("revision")
+		x.Revision.MarshalProtoJSON(s.WithField("revision"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the PutConfigSetResponse to JSON.
+func (x *PutConfigSetResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the PutConfigSetResponse message from JSON.
+func (x *PutConfigSetResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "revision":
+			if s.ReadNil() {
+				x.Revision = nil
+				return
+			}
+			x.Revision = &store.ConfigSetRevision{}
+			x.Revision.UnmarshalProtoJSON(s.WithField("revision", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the PutConfigSetResponse from JSON.
+func (x *PutConfigSetResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RollbackConfigSetRequest message to JSON.
+func (x *RollbackConfigSetRequest) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.StoreKey != "" || s.HasField("storeKey") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("storeKey")
+		s.WriteString(x.StoreKey)
+	}
+	if x.Rev != 0 || s.HasField("rev") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rev")
+		s.WriteUint64(x.Rev)
+	}
+	if x.Source != "" || s.HasField("source") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("source")
+		s.WriteString(x.Source)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RollbackConfigSetRequest to JSON.
+func (x *RollbackConfigSetRequest) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RollbackConfigSetRequest message from JSON.
+func (x *RollbackConfigSetRequest) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "store_key", "storeKey":
+			s.AddField("store_key")
+			x.StoreKey = s.ReadString()
+		case "rev":
+			s.AddField("rev")
+			x.Rev = s.ReadUint64()
+		case "source":
+			s.AddField("source")
+			x.Source = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RollbackConfigSetRequest from JSON.
+func (x *RollbackConfigSetRequest) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RollbackConfigSetResponse message to JSON.
+func (x *RollbackConfigSetResponse) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Revision != nil || s.HasField("revision") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("revision")
+		x.Revision.MarshalProtoJSON(s.WithField("revision"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RollbackConfigSetResponse to JSON.
+func (x *RollbackConfigSetResponse) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RollbackConfigSetResponse message from JSON.
+func (x *RollbackConfigSetResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "revision":
+			if s.ReadNil() {
+				x.Revision = nil
+				return
+			}
+			x.Revision = &store.ConfigSetRevision{}
+			x.Revision.UnmarshalProtoJSON(s.WithField("revision", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RollbackConfigSetResponse from JSON.
+func (x *RollbackConfigSetResponse) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+func (m *Config) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Config) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Config) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.NoServeExecDirectives {
+		i--
+		if m.NoServeExecDirectives {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnableConfigsetStore {
+		i--
+		if m.EnableConfigsetStore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EnableControlControllers {
+		i--
+		if m.EnableControlControllers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EnableServeDirectives {
+		i--
+		if m.EnableServeDirectives {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EnableExecDirective {
+		i--
+		if m.EnableExecDirective {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EnableExecController {
+		i--
+		if m.EnableExecController {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBusInfoRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBusInfoRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *GetBusInfoRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBusInfoResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBusInfoResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *GetBusInfoResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ConfigSetControllers) > 0 {
+		for iNdEx := len(m.ConfigSetControllers) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.ConfigSetControllers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RunningDirectives) > 0 {
+		for iNdEx := len(m.RunningDirectives) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RunningDirectives[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RunningControllers) > 0 {
+		for iNdEx := len(m.RunningControllers) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RunningControllers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDirectiveInfoRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDirectiveInfoRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *GetDirectiveInfoRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.InstanceId != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.InstanceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDirectiveInfoResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDirectiveInfoResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *GetDirectiveInfoResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Values[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ResolverErrors) > 0 {
+		for iNdEx := len(m.ResolverErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResolverErrors[iNdEx])
+			copy(dAtA[i:], m.ResolverErrors[iNdEx])
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ResolverErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Idle {
+		i--
+		if m.Idle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DirectiveState != nil {
+		size, err := m.DirectiveState.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFactoriesRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFactoriesRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ListFactoriesRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFactoriesResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFactoriesResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ListFactoriesResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Factories) > 0 {
+		for iNdEx := len(m.Factories) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Factories[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FactoryInfo) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryInfo) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *FactoryInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ConfigSchema) > 0 {
+		i -= len(m.ConfigSchema)
+		copy(dAtA[i:], m.ConfigSchema)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigSchema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ResolverId) > 0 {
+		i -= len(m.ResolverId)
+		copy(dAtA[i:], m.ResolverId)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ResolverId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConfigId) > 0 {
+		i -= len(m.ConfigId)
+		copy(dAtA[i:], m.ConfigId)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerTarget) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerTarget) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ControllerTarget) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ControllerId) > 0 {
+		i -= len(m.ControllerId)
+		copy(dAtA[i:], m.ControllerId)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ControllerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConfigKey) > 0 {
+		i -= len(m.ConfigKey)
+		copy(dAtA[i:], m.ConfigKey)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopControllerRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopControllerRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StopControllerRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Target != nil {
+		size, err := m.Target.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopControllerResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopControllerResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StopControllerResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ConfigKeys) > 0 {
+		for iNdEx := len(m.ConfigKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConfigKeys[iNdEx])
+			copy(dAtA[i:], m.ConfigKeys[iNdEx])
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestartControllerRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartControllerRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *RestartControllerRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Target != nil {
+		size, err := m.Target.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestartControllerResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartControllerResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *RestartControllerResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ConfigKeys) > 0 {
+		for iNdEx := len(m.ConfigKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConfigKeys[iNdEx])
+			copy(dAtA[i:], m.ConfigKeys[iNdEx])
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveControllerRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveControllerRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *RemoveControllerRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Target != nil {
+		size, err := m.Target.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveControllerResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveControllerResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *RemoveControllerResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ConfigKeys) > 0 {
+		for iNdEx := len(m.ConfigKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConfigKeys[iNdEx])
+			copy(dAtA[i:], m.ConfigKeys[iNdEx])
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchBusInfoRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchBusInfoRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *WatchBusInfoRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchBusInfoController) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchBusInfoController) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *WatchBusInfoController) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Info != nil {
+		size, err := m.Info.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Handle != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Handle))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchBusInfoDirective) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchBusInfoDirective) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *WatchBusInfoDirective) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ResolverErrors) > 0 {
+		for iNdEx := len(m.ResolverErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResolverErrors[iNdEx])
+			copy(dAtA[i:], m.ResolverErrors[iNdEx])
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ResolverErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ValueCount != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.ValueCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Idle {
+		i--
+		if m.Idle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != nil {
+		size, err := m.State.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Handle != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Handle))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchBusInfoResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchBusInfoResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *WatchBusInfoResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Directives) > 0 {
+		for iNdEx := len(m.Directives) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Directives[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Controllers) > 0 {
+		for iNdEx := len(m.Controllers) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Controllers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EventType != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecDirectiveRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecDirectiveRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ExecDirectiveRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.DirectiveBody) > 0 {
+		i -= len(m.DirectiveBody)
+		copy(dAtA[i:], m.DirectiveBody)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.DirectiveBody)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DirectiveTypeId) > 0 {
+		i -= len(m.DirectiveTypeId)
+		copy(dAtA[i:], m.DirectiveTypeId)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.DirectiveTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecDirectiveResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecDirectiveResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ExecDirectiveResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ResolverErrors) > 0 {
+		for iNdEx := len(m.ResolverErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResolverErrors[iNdEx])
+			copy(dAtA[i:], m.ResolverErrors[iNdEx])
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ResolverErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Idle {
+		i--
+		if m.Idle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValueBody) > 0 {
+		i -= len(m.ValueBody)
+		copy(dAtA[i:], m.ValueBody)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ValueBody)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValueId != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.ValueId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventType != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServeDirectivesRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServeDirectivesRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ServeDirectivesRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.ResolverError) > 0 {
+		i -= len(m.ResolverError)
+		copy(dAtA[i:], m.ResolverError)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ResolverError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ResolverEvent != nil {
+		size, err := m.ResolverEvent.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ResolverId != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.ResolverId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DirectiveTypeIds) > 0 {
+		for iNdEx := len(m.DirectiveTypeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DirectiveTypeIds[iNdEx])
+			copy(dAtA[i:], m.DirectiveTypeIds[iNdEx])
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.DirectiveTypeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServeDirectivesResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServeDirectivesResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ServeDirectivesResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Cancel {
+		i--
+		if m.Cancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DirectiveBody) > 0 {
+		i -= len(m.DirectiveBody)
+		copy(dAtA[i:], m.DirectiveBody)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.DirectiveBody)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DirectiveTypeId) > 0 {
+		i -= len(m.DirectiveTypeId)
+		copy(dAtA[i:], m.DirectiveTypeId)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.DirectiveTypeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ResolverId != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.ResolverId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHealthRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHealthRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *GetHealthRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHealthResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHealthResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *GetHealthResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.FailingKeys) > 0 {
+		for iNdEx := len(m.FailingKeys) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.FailingKeys[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LiveError) > 0 {
+		i -= len(m.LiveError)
+		copy(dAtA[i:], m.LiveError)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.LiveError)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Live {
+		i--
+		if m.Live {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigKeyHealth) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigKeyHealth) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ConfigKeyHealth) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConfigKey) > 0 {
+		i -= len(m.ConfigKey)
+		copy(dAtA[i:], m.ConfigKey)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanConfigSetRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanConfigSetRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *PlanConfigSetRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Replace {
+		i--
+		if m.Replace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConfigSetYaml) > 0 {
+		i -= len(m.ConfigSetYaml)
+		copy(dAtA[i:], m.ConfigSetYaml)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigSetYaml)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanConfigSetResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanConfigSetResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *PlanConfigSetResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Keys[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigKeyPlan) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigKeyPlan) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ConfigKeyPlan) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.UnknownConfigId {
+		i--
+		if m.UnknownConfigId {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ConfigYaml) > 0 {
+		i -= len(m.ConfigYaml)
+		copy(dAtA[i:], m.ConfigYaml)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigYaml)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CurrentConfigYaml) > 0 {
+		i -= len(m.CurrentConfigYaml)
+		copy(dAtA[i:], m.CurrentConfigYaml)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.CurrentConfigYaml)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Rev != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Rev))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CurrentRev != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.CurrentRev))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ConfigId) > 0 {
+		i -= len(m.ConfigId)
+		copy(dAtA[i:], m.ConfigId)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConfigKey) > 0 {
+		i -= len(m.ConfigKey)
+		copy(dAtA[i:], m.ConfigKey)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetConfigSetHistoryRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetConfigSetHistoryRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *GetConfigSetHistoryRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetConfigSetHistoryResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetConfigSetHistoryResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *GetConfigSetHistoryResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.StoreKeys) > 0 {
+		for iNdEx := len(m.StoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StoreKeys[iNdEx])
+			copy(dAtA[i:], m.StoreKeys[iNdEx])
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.StoreKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Revisions[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutConfigSetRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutConfigSetRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *PutConfigSetRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConfigSetYaml) > 0 {
+		i -= len(m.ConfigSetYaml)
+		copy(dAtA[i:], m.ConfigSetYaml)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ConfigSetYaml)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutConfigSetResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutConfigSetResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *PutConfigSetResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Revision != nil {
+		size, err := m.Revision.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackConfigSetRequest) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackConfigSetRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *RollbackConfigSetRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Rev != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Rev))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackConfigSetResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackConfigSetResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *RollbackConfigSetResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Revision != nil {
+		size, err := m.Revision.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Config) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableExecController {
+		n += 2
+	}
+	if m.EnableExecDirective {
+		n += 2
+	}
+	if m.EnableServeDirectives {
+		n += 2
+	}
+	if m.EnableControlControllers {
+		n += 2
+	}
+	if m.EnableConfigsetStore {
+		n += 2
+	}
+	if m.NoServeExecDirectives {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *GetBusInfoRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *GetBusInfoResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RunningControllers) > 0 {
+		for _, e := range m.RunningControllers {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.RunningDirectives) > 0 {
+		for _, e := range m.RunningDirectives {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.ConfigSetControllers) > 0 {
+		for _, e := range m.ConfigSetControllers {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *GetDirectiveInfoRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InstanceId != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.InstanceId))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *GetDirectiveInfoResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	if m.DirectiveState != nil {
+		l = m.DirectiveState.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.Idle {
+		n += 2
+	}
+	if len(m.ResolverErrors) > 0 {
+		for _, s := range m.ResolverErrors {
+			l = len(s)
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ListFactoriesRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ListFactoriesResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Factories) > 0 {
+		for _, e := range m.Factories {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FactoryInfo) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigId)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.ResolverId)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.ConfigSchema)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ControllerTarget) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigKey)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.ControllerId)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *StopControllerRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Target != nil {
+		l = m.Target.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *StopControllerResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConfigKeys) > 0 {
+		for _, s := range m.ConfigKeys {
+			l = len(s)
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RestartControllerRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Target != nil {
+		l = m.Target.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RestartControllerResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConfigKeys) > 0 {
+		for _, s := range m.ConfigKeys {
+			l = len(s)
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RemoveControllerRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Target != nil {
+		l = m.Target.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RemoveControllerResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConfigKeys) > 0 {
+		for _, s := range m.ConfigKeys {
+			l = len(s)
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *WatchBusInfoRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *WatchBusInfoController) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Handle != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.Handle))
+	}
+	if m.Info != nil {
+		l = m.Info.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *WatchBusInfoDirective) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Handle != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.Handle))
+	}
+	if m.State != nil {
+		l = m.State.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.Idle {
+		n += 2
+	}
+	if m.ValueCount != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.ValueCount))
+	}
+	if len(m.ResolverErrors) > 0 {
+		for _, s := range m.ResolverErrors {
+			l = len(s)
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *WatchBusInfoResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.EventType))
+	}
+	if len(m.Controllers) > 0 {
+		for _, e := range m.Controllers {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.Directives) > 0 {
+		for _, e := range m.Directives {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ExecDirectiveRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DirectiveTypeId)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.DirectiveBody)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ExecDirectiveResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.EventType))
+	}
+	if m.ValueId != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.ValueId))
+	}
+	l = len(m.ValueBody)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.Idle {
+		n += 2
+	}
+	if len(m.ResolverErrors) > 0 {
+		for _, s := range m.ResolverErrors {
+			l = len(s)
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ServeDirectivesRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DirectiveTypeIds) > 0 {
+		for _, s := range m.DirectiveTypeIds {
+			l = len(s)
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	if m.ResolverId != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.ResolverId))
+	}
+	if m.ResolverEvent != nil {
+		l = m.ResolverEvent.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.ResolverError)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ServeDirectivesResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResolverId != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.ResolverId))
+	}
+	l = len(m.DirectiveTypeId)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.DirectiveBody)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.Cancel {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *GetHealthRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *GetHealthResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Live {
+		n += 2
+	}
+	l = len(m.LiveError)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.Ready {
+		n += 2
+	}
+	if len(m.FailingKeys) > 0 {
+		for _, e := range m.FailingKeys {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ConfigKeyHealth) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigKey)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *PlanConfigSetRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigSetYaml)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.Replace {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *PlanConfigSetResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ConfigKeyPlan) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigKey)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.Action))
+	}
+	l = len(m.ConfigId)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.CurrentRev != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.CurrentRev))
+	}
+	if m.Rev != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.Rev))
+	}
+	l = len(m.CurrentConfigYaml)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.ConfigYaml)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.UnknownConfigId {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *GetConfigSetHistoryRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *GetConfigSetHistoryResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.StoreKeys) > 0 {
+		for _, s := range m.StoreKeys {
+			l = len(s)
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *PutConfigSetRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.ConfigSetYaml)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *PutConfigSetResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != nil {
+		l = m.Revision.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RollbackConfigSetRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.Rev != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.Rev))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RollbackConfigSetResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != nil {
+		l = m.Revision.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (x WatchBusInfoEventType) MarshalProtoText() string {
+	return x.String()
+}
+
+func (x ExecDirectiveEventType) MarshalProtoText() string {
+	return x.String()
+}
+
+func (x PlanAction) MarshalProtoText() string {
+	return x.String()
+}
+
+func (x *Config) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("Config {")
+	if x.EnableExecController != false {
+		if sb.Len() > 8 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("enable_exec_controller: ")
+		sb.WriteString(strconv.FormatBool(x.EnableExecController))
+	}
+	if x.EnableExecDirective != false {
+		if sb.Len() > 8 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("enable_exec_directive: ")
+		sb.WriteString(strconv.FormatBool(x.EnableExecDirective))
+	}
+	if x.EnableServeDirectives != false {
+		if sb.Len() > 8 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("enable_serve_directives: ")
+		sb.WriteString(strconv.FormatBool(x.EnableServeDirectives))
+	}
+	if x.EnableControlControllers != false {
+		if sb.Len() > 8 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("enable_control_controllers: ")
+		sb.WriteString(strconv.FormatBool(x.EnableControlControllers))
+	}
+	if x.EnableConfigsetStore != false {
+		if sb.Len() > 8 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("enable_configset_store: ")
+		sb.WriteString(strconv.FormatBool(x.EnableConfigsetStore))
+	}
+	if x.NoServeExecDirectives != false {
+		if sb.Len() > 8 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("no_serve_exec_directives: ")
+		sb.WriteString(strconv.FormatBool(x.NoServeExecDirectives))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *Config) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *GetBusInfoRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("GetBusInfoRequest {")
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *GetBusInfoRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *GetBusInfoResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("GetBusInfoResponse {")
+	if len(x.RunningControllers) > 0 {
+		if sb.Len() > 20 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("running_controllers: [")
+		for i, v := range x.RunningControllers {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&controller.Info{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	if len(x.RunningDirectives) > 0 {
+		if sb.Len() > 20 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("running_directives: [")
+		for i, v := range x.RunningDirectives {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&directive.DirectiveState{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	if len(x.ConfigSetControllers) > 0 {
+		if sb.Len() > 20 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_set_controllers: [")
+		for i, v := range x.ConfigSetControllers {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&exec.ExecControllerResponse{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *GetBusInfoResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *GetDirectiveInfoRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("GetDirectiveInfoRequest {")
+	if x.InstanceId != 0 {
+		if sb.Len() > 25 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("instance_id: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.InstanceId), 10))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *GetDirectiveInfoRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *GetDirectiveInfoResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("GetDirectiveInfoResponse {")
+	if x.Found != false {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("found: ")
+		sb.WriteString(strconv.FormatBool(x.Found))
+	}
+	if x.DirectiveState != nil {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("directive_state: ")
+		sb.WriteString(x.DirectiveState.MarshalProtoText())
+	}
+	if x.Idle != false {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("idle: ")
+		sb.WriteString(strconv.FormatBool(x.Idle))
+	}
+	if len(x.ResolverErrors) > 0 {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("resolver_errors: [")
+		for i, v := range x.ResolverErrors {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(v))
+		}
+		sb.WriteString("]")
+	}
+	if len(x.Values) > 0 {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("values: [")
+		for i, v := range x.Values {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&directive.ValueInfo{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *GetDirectiveInfoResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *ListFactoriesRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ListFactoriesRequest {")
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ListFactoriesRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *ListFactoriesResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ListFactoriesResponse {")
+	if len(x.Factories) > 0 {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("factories: [")
+		for i, v := range x.Factories {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&FactoryInfo{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ListFactoriesResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *FactoryInfo) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("FactoryInfo {")
+	if x.ConfigId != "" {
+		if sb.Len() > 13 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_id: ")
+		sb.WriteString(strconv.Quote(x.ConfigId))
+	}
+	if x.Version != "" {
+		if sb.Len() > 13 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("version: ")
+		sb.WriteString(strconv.Quote(x.Version))
+	}
+	if x.ResolverId != "" {
+		if sb.Len() > 13 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("resolver_id: ")
+		sb.WriteString(strconv.Quote(x.ResolverId))
+	}
+	if x.ConfigSchema != "" {
+		if sb.Len() > 13 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_schema: ")
+		sb.WriteString(strconv.Quote(x.ConfigSchema))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *FactoryInfo) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *ControllerTarget) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ControllerTarget {")
+	if x.ConfigKey != "" {
+		if sb.Len() > 18 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_key: ")
+		sb.WriteString(strconv.Quote(x.ConfigKey))
+	}
+	if x.ControllerId != "" {
+		if sb.Len() > 18 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("controller_id: ")
+		sb.WriteString(strconv.Quote(x.ControllerId))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ControllerTarget) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *StopControllerRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("StopControllerRequest {")
+	if x.Target != nil {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("target: ")
+		sb.WriteString(x.Target.MarshalProtoText())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *StopControllerRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *StopControllerResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("StopControllerResponse {")
+	if len(x.ConfigKeys) > 0 {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_keys: [")
+		for i, v := range x.ConfigKeys {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(v))
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *StopControllerResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *RestartControllerRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("RestartControllerRequest {")
+	if x.Target != nil {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("target: ")
+		sb.WriteString(x.Target.MarshalProtoText())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *RestartControllerRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *RestartControllerResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("RestartControllerResponse {")
+	if len(x.ConfigKeys) > 0 {
+		if sb.Len() > 27 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_keys: [")
+		for i, v := range x.ConfigKeys {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(v))
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *RestartControllerResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *RemoveControllerRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("RemoveControllerRequest {")
+	if x.Target != nil {
+		if sb.Len() > 25 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("target: ")
+		sb.WriteString(x.Target.MarshalProtoText())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *RemoveControllerRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *RemoveControllerResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("RemoveControllerResponse {")
+	if len(x.ConfigKeys) > 0 {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_keys: [")
+		for i, v := range x.ConfigKeys {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(v))
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *RemoveControllerResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *WatchBusInfoRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("WatchBusInfoRequest {")
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *WatchBusInfoRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *WatchBusInfoController) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("WatchBusInfoController {")
+	if x.Handle != 0 {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("handle: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.Handle), 10))
+	}
+	if x.Info != nil {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("info: ")
+		sb.WriteString(x.Info.MarshalProtoText())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *WatchBusInfoController) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *WatchBusInfoDirective) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("WatchBusInfoDirective {")
+	if x.Handle != 0 {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("handle: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.Handle), 10))
+	}
+	if x.State != nil {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("state: ")
+		sb.WriteString(x.State.MarshalProtoText())
+	}
+	if x.Idle != false {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("idle: ")
+		sb.WriteString(strconv.FormatBool(x.Idle))
+	}
+	if x.ValueCount != 0 {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("value_count: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.ValueCount), 10))
+	}
+	if len(x.ResolverErrors) > 0 {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("resolver_errors: [")
+		for i, v := range x.ResolverErrors {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(v))
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *WatchBusInfoDirective) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *WatchBusInfoResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("WatchBusInfoResponse {")
+	if x.EventType != 0 {
+		if sb.Len() > 22 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("event_type: ")
+		sb.WriteString("\"")
+		sb.WriteString(WatchBusInfoEventType(x.EventType).String())
+		sb.WriteString("\"")
+	}
+	if len(x.Controllers) > 0 {
+		if sb.Len() > 22 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("controllers: [")
+		for i, v := range x.Controllers {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&WatchBusInfoController{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	if len(x.Directives) > 0 {
+		if sb.Len() > 22 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("directives: [")
+		for i, v := range x.Directives {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&WatchBusInfoDirective{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *WatchBusInfoResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *ExecDirectiveRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ExecDirectiveRequest {")
+	if x.DirectiveTypeId != "" {
+		if sb.Len() > 22 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("directive_type_id: ")
+		sb.WriteString(strconv.Quote(x.DirectiveTypeId))
+	}
+	if len(x.DirectiveBody) != 0 {
+		if sb.Len() > 22 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("directive_body: ")
+		sb.WriteString("\"")
+		sb.WriteString(base64.StdEncoding.EncodeToString(x.DirectiveBody))
+		sb.WriteString("\"")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ExecDirectiveRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *ExecDirectiveResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ExecDirectiveResponse {")
+	if x.EventType != 0 {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("event_type: ")
+		sb.WriteString("\"")
+		sb.WriteString(ExecDirectiveEventType(x.EventType).String())
+		sb.WriteString("\"")
+	}
+	if x.ValueId != 0 {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("value_id: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.ValueId), 10))
+	}
+	if len(x.ValueBody) != 0 {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("value_body: ")
+		sb.WriteString("\"")
+		sb.WriteString(base64.StdEncoding.EncodeToString(x.ValueBody))
+		sb.WriteString("\"")
+	}
+	if x.Idle != false {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("idle: ")
+		sb.WriteString(strconv.FormatBool(x.Idle))
+	}
+	if len(x.ResolverErrors) > 0 {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("resolver_errors: [")
+		for i, v := range x.ResolverErrors {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(v))
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ExecDirectiveResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *ServeDirectivesRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ServeDirectivesRequest {")
+	if len(x.DirectiveTypeIds) > 0 {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("directive_type_ids: [")
+		for i, v := range x.DirectiveTypeIds {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(v))
+		}
+		sb.WriteString("]")
+	}
+	if x.ResolverId != 0 {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("resolver_id: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.ResolverId), 10))
+	}
+	if x.ResolverEvent != nil {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("resolver_event: ")
+		sb.WriteString(x.ResolverEvent.MarshalProtoText())
+	}
+	if x.ResolverError != "" {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("resolver_error: ")
+		sb.WriteString(strconv.Quote(x.ResolverError))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ServeDirectivesRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *ServeDirectivesResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ServeDirectivesResponse {")
+	if x.ResolverId != 0 {
+		if sb.Len() > 25 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("resolver_id: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.ResolverId), 10))
+	}
+	if x.DirectiveTypeId != "" {
+		if sb.Len() > 25 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("directive_type_id: ")
+		sb.WriteString(strconv.Quote(x.DirectiveTypeId))
+	}
+	if len(x.DirectiveBody) != 0 {
+		if sb.Len() > 25 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("directive_body: ")
+		sb.WriteString("\"")
+		sb.WriteString(base64.StdEncoding.EncodeToString(x.DirectiveBody))
+		sb.WriteString("\"")
+	}
+	if x.Cancel != false {
+		if sb.Len() > 25 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("cancel: ")
+		sb.WriteString(strconv.FormatBool(x.Cancel))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ServeDirectivesResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *GetHealthRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("GetHealthRequest {")
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *GetHealthRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *GetHealthResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("GetHealthResponse {")
+	if x.Live != false {
+		if sb.Len() > 19 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("live: ")
+		sb.WriteString(strconv.FormatBool(x.Live))
+	}
+	if x.LiveError != "" {
+		if sb.Len() > 19 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("live_error: ")
+		sb.WriteString(strconv.Quote(x.LiveError))
+	}
+	if x.Ready != false {
+		if sb.Len() > 19 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("ready: ")
+		sb.WriteString(strconv.FormatBool(x.Ready))
+	}
+	if len(x.FailingKeys) > 0 {
+		if sb.Len() > 19 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("failing_keys: [")
+		for i, v := range x.FailingKeys {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&ConfigKeyHealth{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *GetHealthResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *ConfigKeyHealth) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ConfigKeyHealth {")
+	if x.ConfigKey != "" {
+		if sb.Len() > 17 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_key: ")
+		sb.WriteString(strconv.Quote(x.ConfigKey))
+	}
+	if x.Error != "" {
+		if sb.Len() > 17 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("error: ")
+		sb.WriteString(strconv.Quote(x.Error))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ConfigKeyHealth) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *PlanConfigSetRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("PlanConfigSetRequest {")
+	if x.ConfigSetYaml != "" {
+		if sb.Len() > 22 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_set_yaml: ")
+		sb.WriteString(strconv.Quote(x.ConfigSetYaml))
+	}
+	if x.Replace != false {
+		if sb.Len() > 22 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("replace: ")
+		sb.WriteString(strconv.FormatBool(x.Replace))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *PlanConfigSetRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *PlanConfigSetResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("PlanConfigSetResponse {")
+	if len(x.Keys) > 0 {
+		if sb.Len() > 23 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("keys: [")
+		for i, v := range x.Keys {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&ConfigKeyPlan{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *PlanConfigSetResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *ConfigKeyPlan) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ConfigKeyPlan {")
+	if x.ConfigKey != "" {
+		if sb.Len() > 15 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_key: ")
+		sb.WriteString(strconv.Quote(x.ConfigKey))
+	}
+	if x.Action != 0 {
+		if sb.Len() > 15 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("action: ")
+		sb.WriteString("\"")
+		sb.WriteString(PlanAction(x.Action).String())
+		sb.WriteString("\"")
+	}
+	if x.ConfigId != "" {
+		if sb.Len() > 15 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_id: ")
+		sb.WriteString(strconv.Quote(x.ConfigId))
+	}
+	if x.CurrentRev != 0 {
+		if sb.Len() > 15 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("current_rev: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.CurrentRev), 10))
+	}
+	if x.Rev != 0 {
+		if sb.Len() > 15 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("rev: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.Rev), 10))
+	}
+	if x.CurrentConfigYaml != "" {
+		if sb.Len() > 15 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("current_config_yaml: ")
+		sb.WriteString(strconv.Quote(x.CurrentConfigYaml))
+	}
+	if x.ConfigYaml != "" {
+		if sb.Len() > 15 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_yaml: ")
+		sb.WriteString(strconv.Quote(x.ConfigYaml))
+	}
+	if x.Error != "" {
+		if sb.Len() > 15 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("error: ")
+		sb.WriteString(strconv.Quote(x.Error))
+	}
+	if x.UnknownConfigId != false {
+		if sb.Len() > 15 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("unknown_config_id: ")
+		sb.WriteString(strconv.FormatBool(x.UnknownConfigId))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ConfigKeyPlan) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *GetConfigSetHistoryRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("GetConfigSetHistoryRequest {")
+	if x.StoreKey != "" {
+		if sb.Len() > 28 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("store_key: ")
+		sb.WriteString(strconv.Quote(x.StoreKey))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *GetConfigSetHistoryRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *GetConfigSetHistoryResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("GetConfigSetHistoryResponse {")
+	if len(x.Revisions) > 0 {
+		if sb.Len() > 29 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("revisions: [")
+		for i, v := range x.Revisions {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&store.ConfigSetRevision{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	if len(x.StoreKeys) > 0 {
+		if sb.Len() > 29 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("store_keys: [")
+		for i, v := range x.StoreKeys {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(v))
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *GetConfigSetHistoryResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *PutConfigSetRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("PutConfigSetRequest {")
+	if x.StoreKey != "" {
+		if sb.Len() > 21 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("store_key: ")
+		sb.WriteString(strconv.Quote(x.StoreKey))
+	}
+	if x.ConfigSetYaml != "" {
+		if sb.Len() > 21 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_set_yaml: ")
+		sb.WriteString(strconv.Quote(x.ConfigSetYaml))
+	}
+	if x.Source != "" {
+		if sb.Len() > 21 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("source: ")
+		sb.WriteString(strconv.Quote(x.Source))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *PutConfigSetRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *PutConfigSetResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("PutConfigSetResponse {")
+	if x.Revision != nil {
+		if sb.Len() > 22 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("revision: ")
+		sb.WriteString(x.Revision.MarshalProtoText())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *PutConfigSetResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *RollbackConfigSetRequest) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("RollbackConfigSetRequest {")
+	if x.StoreKey != "" {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("store_key: ")
+		sb.WriteString(strconv.Quote(x.StoreKey))
+	}
+	if x.Rev != 0 {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("rev: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.Rev), 10))
+	}
+	if x.Source != "" {
+		if sb.Len() > 26 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("source: ")
+		sb.WriteString(strconv.Quote(x.Source))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *RollbackConfigSetRequest) String() string {
+	return x.MarshalProtoText()
+}
+
+func (x *RollbackConfigSetResponse) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("RollbackConfigSetResponse {")
+	if x.Revision != nil {
+		if sb.Len() > 27 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("revision: ")
+		sb.WriteString(x.Revision.MarshalProtoText())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *RollbackConfigSetResponse) String() string {
+	return x.MarshalProtoText()
+}
+
+func (m *Config) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableExecController", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.EnableExecController = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableExecDirective", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.EnableExecDirective = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableServeDirectives", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.EnableServeDirectives = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableControlControllers", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.EnableControlControllers = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableConfigsetStore", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.EnableConfigsetStore = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoServeExecDirectives", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.NoServeExecDirectives = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GetBusInfoRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBusInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBusInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GetBusInfoResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBusInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBusInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningControllers", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningControllers = append(m.RunningControllers, &controller.Info{})
+			if err := m.RunningControllers[len(m.RunningControllers)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningDirectives", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningDirectives = append(m.RunningDirectives, &directive.DirectiveState{})
+			if err := m.RunningDirectives[len(m.RunningDirectives)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSetControllers", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigSetControllers = append(m.ConfigSetControllers, &exec.ExecControllerResponse{})
+			if err := m.ConfigSetControllers[len(m.ConfigSetControllers)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GetDirectiveInfoRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDirectiveInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDirectiveInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceId", wireType)
+			}
+			m.InstanceId = 0
+			m.InstanceId, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GetDirectiveInfoResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDirectiveInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDirectiveInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.Found = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectiveState", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DirectiveState == nil {
+				m.DirectiveState = &directive.DirectiveState{}
+			}
+			if err := m.DirectiveState.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idle", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.Idle = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverErrors", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolverErrors = append(m.ResolverErrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &directive.ValueInfo{})
+			if err := m.Values[len(m.Values)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ListFactoriesRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListFactoriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListFactoriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ListFactoriesResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListFactoriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListFactoriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factories", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Factories = append(m.Factories, &FactoryInfo{})
+			if err := m.Factories[len(m.Factories)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FactoryInfo) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigId", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverId", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolverId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSchema", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ControllerTarget) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigKey", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerId", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StopControllerRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &ControllerTarget{}
+			}
+			if err := m.Target.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StopControllerResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigKeys", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigKeys = append(m.ConfigKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RestartControllerRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &ControllerTarget{}
+			}
+			if err := m.Target.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RestartControllerResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigKeys", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigKeys = append(m.ConfigKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RemoveControllerRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &ControllerTarget{}
+			}
+			if err := m.Target.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RemoveControllerResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigKeys", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigKeys = append(m.ConfigKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WatchBusInfoRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchBusInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchBusInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WatchBusInfoController) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchBusInfoController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchBusInfoController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			m.Handle = 0
+			m.Handle, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &controller.Info{}
+			}
+			if err := m.Info.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WatchBusInfoDirective) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchBusInfoDirective: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchBusInfoDirective: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			m.Handle = 0
+			m.Handle, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &directive.DirectiveState{}
+			}
+			if err := m.State.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idle", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.Idle = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueCount", wireType)
+			}
+			m.ValueCount = 0
+			m.ValueCount, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverErrors", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolverErrors = append(m.ResolverErrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WatchBusInfoResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchBusInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchBusInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.EventType = WatchBusInfoEventType(_v)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controllers", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controllers = append(m.Controllers, &WatchBusInfoController{})
+			if err := m.Controllers[len(m.Controllers)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directives", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Directives = append(m.Directives, &WatchBusInfoDirective{})
+			if err := m.Directives[len(m.Directives)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ExecDirectiveRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecDirectiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecDirectiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectiveTypeId", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectiveTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectiveBody", wireType)
+			}
+			var byteLen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			byteLen = int(_v)
+			if err != nil {
+				return err
+			}
+			if byteLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectiveBody = append(m.DirectiveBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.DirectiveBody == nil {
+				m.DirectiveBody = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ExecDirectiveResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecDirectiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecDirectiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.EventType = ExecDirectiveEventType(_v)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
+			}
+			m.ValueId = 0
+			m.ValueId, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBody", wireType)
+			}
+			var byteLen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			byteLen = int(_v)
+			if err != nil {
+				return err
+			}
+			if byteLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueBody = append(m.ValueBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValueBody == nil {
+				m.ValueBody = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idle", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.Idle = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverErrors", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolverErrors = append(m.ResolverErrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ServeDirectivesRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServeDirectivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServeDirectivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectiveTypeIds", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectiveTypeIds = append(m.DirectiveTypeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverId", wireType)
+			}
+			m.ResolverId = 0
+			m.ResolverId, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverEvent", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolverEvent == nil {
+				m.ResolverEvent = &ExecDirectiveResponse{}
+			}
+			if err := m.ResolverEvent.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverError", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolverError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ServeDirectivesResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServeDirectivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServeDirectivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverId", wireType)
+			}
+			m.ResolverId = 0
+			m.ResolverId, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectiveTypeId", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectiveTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectiveBody", wireType)
+			}
+			var byteLen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			byteLen = int(_v)
+			if err != nil {
+				return err
+			}
+			if byteLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectiveBody = append(m.DirectiveBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.DirectiveBody == nil {
+				m.DirectiveBody = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.Cancel = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GetHealthRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GetHealthResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Live", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.Live = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveError", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.Ready = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailingKeys", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailingKeys = append(m.FailingKeys, &ConfigKeyHealth{})
+			if err := m.FailingKeys[len(m.FailingKeys)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ConfigKeyHealth) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigKeyHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigKeyHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigKey", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PlanConfigSetRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanConfigSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanConfigSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSetYaml", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigSetYaml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.Replace = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PlanConfigSetResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanConfigSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanConfigSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &ConfigKeyPlan{})
+			if err := m.Keys[len(m.Keys)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ConfigKeyPlan) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigKeyPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigKeyPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigKey", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Action = PlanAction(_v)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigId", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRev", wireType)
+			}
+			m.CurrentRev = 0
+			m.CurrentRev, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rev", wireType)
+			}
+			m.Rev = 0
+			m.Rev, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentConfigYaml", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentConfigYaml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigYaml", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigYaml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnknownConfigId", wireType)
+			}
+			var v int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = int(_v)
+			if err != nil {
+				return err
+			}
+			m.UnknownConfigId = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GetConfigSetHistoryRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetConfigSetHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetConfigSetHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
 			}
-			if v == nil {
-				sb.WriteString((&controller.Info{}).MarshalProtoText())
-			} else {
-				sb.WriteString(v.MarshalProtoText())
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		sb.WriteString("]")
-	}
-	if len(x.RunningDirectives) > 0 {
-		if sb.Len() > 20 {
-			sb.WriteString(" ")
-		}
-		sb.WriteString("running_directives: [")
-		for i, v := range x.RunningDirectives {
-			if i > 0 {
-				sb.WriteString(", ")
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if v == nil {
-				sb.WriteString((&directive.DirectiveState{}).MarshalProtoText())
-			} else {
-				sb.WriteString(v.MarshalProtoText())
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
-		sb.WriteString("]")
 	}
-	sb.WriteString("}")
-	return sb.String()
-}
 
-func (x *GetBusInfoResponse) String() string {
-	return x.MarshalProtoText()
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *Config) UnmarshalVT(dAtA []byte) error {
+func (m *GetConfigSetHistoryResponse) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	var err error
@@ -654,24 +11595,60 @@ func (m *Config) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetConfigSetHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetConfigSetHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableExecController", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			var v int
+			var msglen int
 			var _v uint64
 			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
-			v = int(_v)
+			msglen = int(_v)
 			if err != nil {
 				return err
 			}
-			m.EnableExecController = bool(v != 0)
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, &store.ConfigSetRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKeys", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKeys = append(m.StoreKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
@@ -695,7 +11672,7 @@ func (m *Config) UnmarshalVT(dAtA []byte) error {
 	return nil
 }
 
-func (m *GetBusInfoRequest) UnmarshalVT(dAtA []byte) error {
+func (m *PutConfigSetRequest) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	var err error
@@ -709,12 +11686,78 @@ func (m *GetBusInfoRequest) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBusInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutConfigSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBusInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutConfigSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSetYaml", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigSetYaml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
@@ -738,7 +11781,7 @@ func (m *GetBusInfoRequest) UnmarshalVT(dAtA []byte) error {
 	return nil
 }
 
-func (m *GetBusInfoResponse) UnmarshalVT(dAtA []byte) error {
+func (m *PutConfigSetResponse) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	var err error
@@ -752,15 +11795,15 @@ func (m *GetBusInfoResponse) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBusInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutConfigSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBusInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutConfigSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunningControllers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var msglen int
 			var _v uint64
@@ -779,14 +11822,155 @@ func (m *GetBusInfoResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunningControllers = append(m.RunningControllers, &controller.Info{})
-			if err := m.RunningControllers[len(m.RunningControllers)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Revision == nil {
+				m.Revision = &store.ConfigSetRevision{}
+			}
+			if err := m.Revision.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RollbackConfigSetRequest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackConfigSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackConfigSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
 				return err
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rev", wireType)
+			}
+			m.Rev = 0
+			m.Rev, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunningDirectives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RollbackConfigSetResponse) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackConfigSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackConfigSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var msglen int
 			var _v uint64
@@ -805,8 +11989,10 @@ func (m *GetBusInfoResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunningDirectives = append(m.RunningDirectives, &directive.DirectiveState{})
-			if err := m.RunningDirectives[len(m.RunningDirectives)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Revision == nil {
+				m.Revision = &store.ConfigSetRevision{}
+			}
+			if err := m.Revision.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,6 +6,23 @@ pub struct Config {
     /// EnableExecController enables the exec controller API.
     #[prost(bool, tag="1")]
     pub enable_exec_controller: bool,
+    /// EnableExecDirective enables the exec directive API.
+    #[prost(bool, tag="2")]
+    pub enable_exec_directive: bool,
+    /// EnableServeDirectives enables the serve directives API.
+    #[prost(bool, tag="3")]
+    pub enable_serve_directives: bool,
+    /// EnableControlControllers enables the stop, restart, and remove controller API.
+    #[prost(bool, tag="4")]
+    pub enable_control_controllers: bool,
+    /// EnableConfigSetStore enables the put and rollback configset store API.
+    #[prost(bool, tag="5")]
+    pub enable_configset_store: bool,
+    /// NoServeExecDirectives skips forwarding directives added with ExecDirective
+    /// to ServeDirectives handlers. Set if the api has a single remote which uses
+    /// both to avoid forwarding its directives back to it in a loop.
+    #[prost(bool, tag="6")]
+    pub no_serve_exec_directives: bool,
 }
 /// GetBusInfoRequest is the request type for GetBusInfo.
 #[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
@@ -20,5 +37,512 @@ pub struct GetBusInfoResponse {
     /// RunningDirectives is the list of running directives.
     #[prost(message, repeated, tag="2")]
     pub running_directives: ::prost::alloc::vec::Vec<super::super::directive::DirectiveState>,
+    /// ConfigSetControllers is the state of the controllers managed by the
+    /// configset controller, by configset key.
+    #[prost(message, repeated, tag="3")]
+    pub config_set_controllers: ::prost::alloc::vec::Vec<super::super::controller::exec::ExecControllerResponse>,
+}
+/// GetDirectiveInfoRequest is the request type for GetDirectiveInfo.
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetDirectiveInfoRequest {
+    /// InstanceId is the id of the directive instance.
+    /// Matches the instance_id field of the directive state.
+    #[prost(uint32, tag="1")]
+    pub instance_id: u32,
+}
+/// GetDirectiveInfoResponse is the response type for GetDirectiveInfo.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetDirectiveInfoResponse {
+    /// Found indicates the directive was found.
+    #[prost(bool, tag="1")]
+    pub found: bool,
+    /// DirectiveState is the directive info.
+    #[prost(message, optional, tag="2")]
+    pub directive_state: ::core::option::Option<super::super::directive::DirectiveState>,
+    /// Idle indicates if the directive is idle.
+    #[prost(bool, tag="3")]
+    pub idle: bool,
+    /// ResolverErrors contains any resolver errors.
+    #[prost(string, repeated, tag="4")]
+    pub resolver_errors: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// Values contains the directive values.
+    #[prost(message, repeated, tag="5")]
+    pub values: ::prost::alloc::vec::Vec<super::super::directive::ValueInfo>,
+}
+/// ListFactoriesRequest is the request type for ListFactories.
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListFactoriesRequest {
+}
+/// ListFactoriesResponse is the response type for ListFactories.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListFactoriesResponse {
+    /// Factories contains the factories available to the bus.
+    /// Sorted by config id and resolver id.
+    #[prost(message, repeated, tag="1")]
+    pub factories: ::prost::alloc::vec::Vec<FactoryInfo>,
+}
+/// FactoryInfo is information about a controller factory.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct FactoryInfo {
+    /// ConfigId is the config ID the factory constructs controllers for.
+    #[prost(string, tag="1")]
+    pub config_id: ::prost::alloc::string::String,
+    /// Version is the version of the factory.
+    #[prost(string, tag="2")]
+    pub version: ::prost::alloc::string::String,
+    /// ResolverId is the id of the resolver providing the factory.
+    #[prost(string, tag="3")]
+    pub resolver_id: ::prost::alloc::string::String,
+    /// ConfigSchema is the JSON schema of the factory config.
+    #[prost(string, tag="4")]
+    pub config_schema: ::prost::alloc::string::String,
+}
+/// ControllerTarget selects configset controllers.
+///
+/// If both fields are set both must match.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ControllerTarget {
+    /// ConfigKey is the configset key of the controller.
+    #[prost(string, tag="1")]
+    pub config_key: ::prost::alloc::string::String,
+    /// ControllerId is the controller id of the running controller.
+    #[prost(string, tag="2")]
+    pub controller_id: ::prost::alloc::string::String,
+}
+/// StopControllerRequest is the request type for StopController.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct StopControllerRequest {
+    /// Target selects the controllers to stop.
+    #[prost(message, optional, tag="1")]
+    pub target: ::core::option::Option<ControllerTarget>,
+}
+/// StopControllerResponse is the response type for StopController.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct StopControllerResponse {
+    /// ConfigKeys contains the configset keys of the stopped controllers.
+    #[prost(string, repeated, tag="1")]
+    pub config_keys: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// RestartControllerRequest is the request type for RestartController.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RestartControllerRequest {
+    /// Target selects the controllers to restart.
+    #[prost(message, optional, tag="1")]
+    pub target: ::core::option::Option<ControllerTarget>,
+}
+/// RestartControllerResponse is the response type for RestartController.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RestartControllerResponse {
+    /// ConfigKeys contains the configset keys of the restarted controllers.
+    #[prost(string, repeated, tag="1")]
+    pub config_keys: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// RemoveControllerRequest is the request type for RemoveController.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RemoveControllerRequest {
+    /// Target selects the controllers to remove.
+    #[prost(message, optional, tag="1")]
+    pub target: ::core::option::Option<ControllerTarget>,
+}
+/// RemoveControllerResponse is the response type for RemoveController.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RemoveControllerResponse {
+    /// ConfigKeys contains the configset keys of the removed controllers.
+    #[prost(string, repeated, tag="1")]
+    pub config_keys: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// WatchBusInfoRequest is the request type for WatchBusInfo.
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct WatchBusInfoRequest {
+}
+/// WatchBusInfoController is a controller in a WatchBusInfo stream.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct WatchBusInfoController {
+    /// Handle identifies the controller within the stream.
+    #[prost(uint32, tag="1")]
+    pub handle: u32,
+    /// Info is the controller info.
+    #[prost(message, optional, tag="2")]
+    pub info: ::core::option::Option<super::super::controller::Info>,
+}
+/// WatchBusInfoDirective is a directive in a WatchBusInfo stream.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WatchBusInfoDirective {
+    /// Handle identifies the directive instance within the stream.
+    #[prost(uint32, tag="1")]
+    pub handle: u32,
+    /// State is the directive info.
+    /// Set for SNAPSHOT, DIRECTIVE_ADDED and DIRECTIVE_REMOVED events.
+    #[prost(message, optional, tag="2")]
+    pub state: ::core::option::Option<super::super::directive::DirectiveState>,
+    /// Idle indicates if the directive is idle.
+    /// Set for SNAPSHOT and DIRECTIVE_STATE events.
+    #[prost(bool, tag="3")]
+    pub idle: bool,
+    /// ValueCount is the number of directive values.
+    /// Set for SNAPSHOT and DIRECTIVE_STATE events.
+    #[prost(uint32, tag="4")]
+    pub value_count: u32,
+    /// ResolverErrors contains any resolver errors.
+    /// Set for SNAPSHOT and DIRECTIVE_STATE events.
+    #[prost(string, repeated, tag="5")]
+    pub resolver_errors: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// WatchBusInfoResponse is an event in the WatchBusInfo stream.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WatchBusInfoResponse {
+    /// EventType is the type of event.
+    #[prost(enumeration="WatchBusInfoEventType", tag="1")]
+    pub event_type: i32,
+    /// Controllers contains the controllers for SNAPSHOT and CONTROLLER events.
+    #[prost(message, repeated, tag="2")]
+    pub controllers: ::prost::alloc::vec::Vec<WatchBusInfoController>,
+    /// Directives contains the directives for SNAPSHOT and DIRECTIVE events.
+    #[prost(message, repeated, tag="3")]
+    pub directives: ::prost::alloc::vec::Vec<WatchBusInfoDirective>,
+}
+/// ExecDirectiveRequest is the request type for ExecDirective.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ExecDirectiveRequest {
+    /// DirectiveTypeId is the networked directive type identifier.
+    #[prost(string, tag="1")]
+    pub directive_type_id: ::prost::alloc::string::String,
+    /// DirectiveBody is the directive encoded with the networked codec.
+    #[prost(bytes="vec", tag="2")]
+    pub directive_body: ::prost::alloc::vec::Vec<u8>,
+}
+/// ExecDirectiveResponse is an event in the ExecDirective stream.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ExecDirectiveResponse {
+    /// EventType is the type of event.
+    #[prost(enumeration="ExecDirectiveEventType", tag="1")]
+    pub event_type: i32,
+    /// ValueId is the id of the value that was added or removed.
+    #[prost(uint32, tag="2")]
+    pub value_id: u32,
+    /// ValueBody is the encoded value for VALUE_ADDED events.
+    #[prost(bytes="vec", tag="3")]
+    pub value_body: ::prost::alloc::vec::Vec<u8>,
+    /// Idle indicates if the directive is idle for IDLE events.
+    #[prost(bool, tag="4")]
+    pub idle: bool,
+    /// ResolverErrors contains any resolver errors for IDLE events.
+    #[prost(string, repeated, tag="5")]
+    pub resolver_errors: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// ServeDirectivesRequest is a message from a remote directive handler.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ServeDirectivesRequest {
+    /// DirectiveTypeIds is the list of networked directive types to handle.
+    /// Set in the first message only.
+    #[prost(string, repeated, tag="1")]
+    pub directive_type_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// ResolverId is the id of the resolver this event is for.
+    #[prost(uint32, tag="2")]
+    pub resolver_id: u32,
+    /// ResolverEvent is a value or idle event for the resolver.
+    #[prost(message, optional, tag="3")]
+    pub resolver_event: ::core::option::Option<ExecDirectiveResponse>,
+    /// ResolverError is set if the resolver exited with an error.
+    #[prost(string, tag="4")]
+    pub resolver_error: ::prost::alloc::string::String,
+}
+/// ServeDirectivesResponse is a message to a remote directive handler.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ServeDirectivesResponse {
+    /// ResolverId is the id of the resolver.
+    #[prost(uint32, tag="1")]
+    pub resolver_id: u32,
+    /// DirectiveTypeId is the networked directive type identifier.
+    /// Set when starting a resolver.
+    #[prost(string, tag="2")]
+    pub directive_type_id: ::prost::alloc::string::String,
+    /// DirectiveBody is the directive encoded with the networked codec.
+    /// Set when starting a resolver.
+    #[prost(bytes="vec", tag="3")]
+    pub directive_body: ::prost::alloc::vec::Vec<u8>,
+    /// Cancel indicates the resolver was canceled.
+    #[prost(bool, tag="4")]
+    pub cancel: bool,
+}
+/// GetHealthRequest is the request type for GetHealth.
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetHealthRequest {
+}
+/// GetHealthResponse is the response type for GetHealth.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetHealthResponse {
+    /// Live indicates the bus responded within the liveness timeout.
+    #[prost(bool, tag="1")]
+    pub live: bool,
+    /// LiveError is the reason the bus is not live.
+    #[prost(string, tag="2")]
+    pub live_error: ::prost::alloc::string::String,
+    /// Ready indicates every configset key has a running controller without errors.
+    #[prost(bool, tag="3")]
+    pub ready: bool,
+    /// FailingKeys contains the configset keys that are not ready.
+    /// Sorted by config key.
+    #[prost(message, repeated, tag="4")]
+    pub failing_keys: ::prost::alloc::vec::Vec<ConfigKeyHealth>,
+}
+/// ConfigKeyHealth is the health of a configset key.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ConfigKeyHealth {
+    /// ConfigKey is the configset key.
+    #[prost(string, tag="1")]
+    pub config_key: ::prost::alloc::string::String,
+    /// Error is the reason the key is not ready.
+    #[prost(string, tag="2")]
+    pub error: ::prost::alloc::string::String,
+}
+/// PlanConfigSetRequest is the request type for PlanConfigSet.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct PlanConfigSetRequest {
+    /// ConfigSetYaml is the configset to plan in YAML or JSON format.
+    #[prost(string, tag="1")]
+    pub config_set_yaml: ::prost::alloc::string::String,
+    /// Replace plans replacing the applied configs with the configset.
+    /// Applied keys which are not in the configset are stopped.
+    /// If false, plans adding the configset like ApplyConfigSet: applied keys
+    /// which are not in the configset are unchanged.
+    #[prost(bool, tag="2")]
+    pub replace: bool,
+}
+/// PlanConfigSetResponse is the response type for PlanConfigSet.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PlanConfigSetResponse {
+    /// Keys contains the planned change for each configset key.
+    /// Sorted by config key.
+    #[prost(message, repeated, tag="1")]
+    pub keys: ::prost::alloc::vec::Vec<ConfigKeyPlan>,
+}
+/// ConfigKeyPlan is the planned change to a configset key.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ConfigKeyPlan {
+    /// ConfigKey is the configset key.
+    #[prost(string, tag="1")]
+    pub config_key: ::prost::alloc::string::String,
+    /// Action is the planned change.
+    #[prost(enumeration="PlanAction", tag="2")]
+    pub action: i32,
+    /// ConfigId is the config id of the planned config.
+    /// If the key is stopped, the config id of the applied config.
+    #[prost(string, tag="3")]
+    pub config_id: ::prost::alloc::string::String,
+    /// CurrentRev is the revision of the applied config, if any.
+    #[prost(uint64, tag="4")]
+    pub current_rev: u64,
+    /// Rev is the revision of the planned config, if any.
+    #[prost(uint64, tag="5")]
+    pub rev: u64,
+    /// CurrentConfigYaml is the applied config in YAML format, if any.
+    #[prost(string, tag="6")]
+    pub current_config_yaml: ::prost::alloc::string::String,
+    /// ConfigYaml is the planned config in YAML format, if valid.
+    #[prost(string, tag="7")]
+    pub config_yaml: ::prost::alloc::string::String,
+    /// Error is the reason the planned config is invalid.
+    #[prost(string, tag="8")]
+    pub error: ::prost::alloc::string::String,
+    /// UnknownConfigId indicates no factory is available for the config id.
+    #[prost(bool, tag="9")]
+    pub unknown_config_id: bool,
+}
+/// GetConfigSetHistoryRequest is the request type for GetConfigSetHistory.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetConfigSetHistoryRequest {
+    /// StoreKey is the key of the stored configset.
+    /// If empty, lists the stored keys.
+    #[prost(string, tag="1")]
+    pub store_key: ::prost::alloc::string::String,
+}
+/// GetConfigSetHistoryResponse is the response type for GetConfigSetHistory.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetConfigSetHistoryResponse {
+    /// Revisions contains the revisions of the key, oldest first.
+    #[prost(message, repeated, tag="1")]
+    pub revisions: ::prost::alloc::vec::Vec<super::super::configset::store::ConfigSetRevision>,
+    /// StoreKeys contains the stored keys if no key was requested, sorted.
+    #[prost(string, repeated, tag="2")]
+    pub store_keys: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// PutConfigSetRequest is the request type for PutConfigSet.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct PutConfigSetRequest {
+    /// StoreKey is the key to store the configset under.
+    #[prost(string, tag="1")]
+    pub store_key: ::prost::alloc::string::String,
+    /// ConfigSetYaml is the configset in YAML or JSON format.
+    #[prost(string, tag="2")]
+    pub config_set_yaml: ::prost::alloc::string::String,
+    /// Source describes who or what stored the revision.
+    #[prost(string, tag="3")]
+    pub source: ::prost::alloc::string::String,
+}
+/// PutConfigSetResponse is the response type for PutConfigSet.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PutConfigSetResponse {
+    /// Revision is the stored revision.
+    #[prost(message, optional, tag="1")]
+    pub revision: ::core::option::Option<super::super::configset::store::ConfigSetRevision>,
+}
+/// RollbackConfigSetRequest is the request type for RollbackConfigSet.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RollbackConfigSetRequest {
+    /// StoreKey is the key of the stored configset.
+    #[prost(string, tag="1")]
+    pub store_key: ::prost::alloc::string::String,
+    /// Rev is the revision to roll back to.
+    #[prost(uint64, tag="2")]
+    pub rev: u64,
+    /// Source describes who or what rolled back the configset.
+    #[prost(string, tag="3")]
+    pub source: ::prost::alloc::string::String,
+}
+/// RollbackConfigSetResponse is the response type for RollbackConfigSet.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RollbackConfigSetResponse {
+    /// Revision is the new revision with the configset of the earlier revision.
+    #[prost(message, optional, tag="1")]
+    pub revision: ::core::option::Option<super::super::configset::store::ConfigSetRevision>,
+}
+/// WatchBusInfoEventType is the type of event in a WatchBusInfo stream.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum WatchBusInfoEventType {
+    /// WatchBusInfoEventType_UNKNOWN is unrecognized.
+    Unknown = 0,
+    /// WatchBusInfoEventType_SNAPSHOT is the initial snapshot of the bus.
+    /// Contains the state of each directive.
+    Snapshot = 1,
+    /// WatchBusInfoEventType_CONTROLLER_ADDED indicates a controller was added.
+    ControllerAdded = 2,
+    /// WatchBusInfoEventType_CONTROLLER_REMOVED indicates a controller was removed.
+    ControllerRemoved = 3,
+    /// WatchBusInfoEventType_DIRECTIVE_ADDED indicates a directive was added.
+    DirectiveAdded = 4,
+    /// WatchBusInfoEventType_DIRECTIVE_REMOVED indicates a directive was removed.
+    DirectiveRemoved = 5,
+    /// WatchBusInfoEventType_DIRECTIVE_STATE indicates the state of a directive changed.
+    DirectiveState = 6,
+}
+impl WatchBusInfoEventType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unknown => "WatchBusInfoEventType_UNKNOWN",
+            Self::Snapshot => "WatchBusInfoEventType_SNAPSHOT",
+            Self::ControllerAdded => "WatchBusInfoEventType_CONTROLLER_ADDED",
+            Self::ControllerRemoved => "WatchBusInfoEventType_CONTROLLER_REMOVED",
+            Self::DirectiveAdded => "WatchBusInfoEventType_DIRECTIVE_ADDED",
+            Self::DirectiveRemoved => "WatchBusInfoEventType_DIRECTIVE_REMOVED",
+            Self::DirectiveState => "WatchBusInfoEventType_DIRECTIVE_STATE",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "WatchBusInfoEventType_UNKNOWN" => Some(Self::Unknown),
+            "WatchBusInfoEventType_SNAPSHOT" => Some(Self::Snapshot),
+            "WatchBusInfoEventType_CONTROLLER_ADDED" => Some(Self::ControllerAdded),
+            "WatchBusInfoEventType_CONTROLLER_REMOVED" => Some(Self::ControllerRemoved),
+            "WatchBusInfoEventType_DIRECTIVE_ADDED" => Some(Self::DirectiveAdded),
+            "WatchBusInfoEventType_DIRECTIVE_REMOVED" => Some(Self::DirectiveRemoved),
+            "WatchBusInfoEventType_DIRECTIVE_STATE" => Some(Self::DirectiveState),
+            _ => None,
+        }
+    }
+}
+/// ExecDirectiveEventType is the type of event in an ExecDirective stream.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ExecDirectiveEventType {
+    /// ExecDirectiveEventType_UNKNOWN is unrecognized.
+    Unknown = 0,
+    /// ExecDirectiveEventType_VALUE_ADDED indicates a value was added.
+    ValueAdded = 1,
+    /// ExecDirectiveEventType_VALUE_REMOVED indicates a value was removed.
+    ValueRemoved = 2,
+    /// ExecDirectiveEventType_IDLE indicates the idle state changed.
+    Idle = 3,
+}
+impl ExecDirectiveEventType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unknown => "ExecDirectiveEventType_UNKNOWN",
+            Self::ValueAdded => "ExecDirectiveEventType_VALUE_ADDED",
+            Self::ValueRemoved => "ExecDirectiveEventType_VALUE_REMOVED",
+            Self::Idle => "ExecDirectiveEventType_IDLE",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "ExecDirectiveEventType_UNKNOWN" => Some(Self::Unknown),
+            "ExecDirectiveEventType_VALUE_ADDED" => Some(Self::ValueAdded),
+            "ExecDirectiveEventType_VALUE_REMOVED" => Some(Self::ValueRemoved),
+            "ExecDirectiveEventType_IDLE" => Some(Self::Idle),
+            _ => None,
+        }
+    }
+}
+/// PlanAction is the planned change to a configset key.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum PlanAction {
+    /// PlanAction_UNCHANGED indicates the applied config is left alone.
+    Unchanged = 0,
+    /// PlanAction_START indicates the key is not applied and would be started.
+    Start = 1,
+    /// PlanAction_STOP indicates the key is applied and not in the configset
+    /// and the configset replaces the applied configs.
+    Stop = 2,
+    /// PlanAction_RESTART indicates the revision is newer than the applied
+    /// revision and the controller would be restarted, unless it applies the
+    /// config in place.
+    Restart = 3,
+    /// PlanAction_IGNORED indicates the config differs from the applied config
+    /// but the revision is not newer, so ApplyConfigSet would not apply it.
+    /// The daemon config file and PutConfigSet apply changed configs with a
+    /// revision newer than the applied one, so they would restart the key.
+    Ignored = 4,
+    /// PlanAction_INVALID indicates the config is invalid and cannot be applied.
+    Invalid = 5,
+}
+impl PlanAction {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::Unchanged => "PlanAction_UNCHANGED",
+            Self::Start => "PlanAction_START",
+            Self::Stop => "PlanAction_STOP",
+            Self::Restart => "PlanAction_RESTART",
+            Self::Ignored => "PlanAction_IGNORED",
+            Self::Invalid => "PlanAction_INVALID",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "PlanAction_UNCHANGED" => Some(Self::Unchanged),
+            "PlanAction_START" => Some(Self::Start),
+            "PlanAction_STOP" => Some(Self::Stop),
+            "PlanAction_RESTART" => Some(Self::Restart),
+            "PlanAction_IGNORED" => Some(Self::Ignored),
+            "PlanAction_INVALID" => Some(Self::Invalid),
+            _ => None,
+        }
+    }
 }
 // @@protoc_insertion_point(module)
@@ -3,12 +3,203 @@
 /* eslint-disable */
 
 import type { MessageType, PartialFieldInfo } from '@aptre/protobuf-es-lite'
-import { createMessageType, ScalarType } from '@aptre/protobuf-es-lite'
+import {
+  createEnumType,
+  createMessageType,
+  ScalarType,
+} from '@aptre/protobuf-es-lite'
+import { ConfigSetRevision } from '../../controller/configset/store/store.pb.js'
 import { Info } from '../../controller/controller.pb.js'
-import { DirectiveState } from '../../directive/directive.pb.js'
+import { ExecControllerResponse } from '../../controller/exec/exec.pb.js'
+import { DirectiveState, ValueInfo } from '../../directive/directive.pb.js'
 
 export const protobufPackage = 'bus.api'
 
+/**
+ * WatchBusInfoEventType is the type of event in a WatchBusInfo stream.
+ *
+ * @generated from enum bus.api.WatchBusInfoEventType
+ */
+export enum WatchBusInfoEventType {
+  /**
+   * WatchBusInfoEventType_UNKNOWN is unrecognized.
+   *
+   * @generated from enum value: WatchBusInfoEventType_UNKNOWN = 0;
+   */
+  WatchBusInfoEventType_UNKNOWN = 0,
+
+  /**
+   * WatchBusInfoEventType_SNAPSHOT is the initial snapshot of the bus.
+   * Contains the state of each directive.
+   *
+   * @generated from enum value: WatchBusInfoEventType_SNAPSHOT = 1;
+   */
+  WatchBusInfoEventType_SNAPSHOT = 1,
+
+  /**
+   * WatchBusInfoEventType_CONTROLLER_ADDED indicates a controller was added.
+   *
+   * @generated from enum value: WatchBusInfoEventType_CONTROLLER_ADDED = 2;
+   */
+  WatchBusInfoEventType_CONTROLLER_ADDED = 2,
+
+  /**
+   * WatchBusInfoEventType_CONTROLLER_REMOVED indicates a controller was removed.
+   *
+   * @generated from enum value: WatchBusInfoEventType_CONTROLLER_REMOVED = 3;
+   */
+  WatchBusInfoEventType_CONTROLLER_REMOVED = 3,
+
+  /**
+   * WatchBusInfoEventType_DIRECTIVE_ADDED indicates a directive was added.
+   *
+   * @generated from enum value: WatchBusInfoEventType_DIRECTIVE_ADDED = 4;
+   */
+  WatchBusInfoEventType_DIRECTIVE_ADDED = 4,
+
+  /**
+   * WatchBusInfoEventType_DIRECTIVE_REMOVED indicates a directive was removed.
+   *
+   * @generated from enum value: WatchBusInfoEventType_DIRECTIVE_REMOVED = 5;
+   */
+  WatchBusInfoEventType_DIRECTIVE_REMOVED = 5,
+
+  /**
+   * WatchBusInfoEventType_DIRECTIVE_STATE indicates the state of a directive changed.
+   *
+   * @generated from enum value: WatchBusInfoEventType_DIRECTIVE_STATE = 6;
+   */
+  WatchBusInfoEventType_DIRECTIVE_STATE = 6,
+}
+
+// WatchBusInfoEventType_Enum is the enum type for WatchBusInfoEventType.
+export const WatchBusInfoEventType_Enum = createEnumType(
+  'bus.api.WatchBusInfoEventType',
+  [
+    { no: 0, name: 'WatchBusInfoEventType_UNKNOWN' },
+    { no: 1, name: 'WatchBusInfoEventType_SNAPSHOT' },
+    { no: 2, name: 'WatchBusInfoEventType_CONTROLLER_ADDED' },
+    { no: 3, name: 'WatchBusInfoEventType_CONTROLLER_REMOVED' },
+    { no: 4, name: 'WatchBusInfoEventType_DIRECTIVE_ADDED' },
+    { no: 5, name: 'WatchBusInfoEventType_DIRECTIVE_REMOVED' },
+    { no: 6, name: 'WatchBusInfoEventType_DIRECTIVE_STATE' },
+  ],
+)
+
+/**
+ * ExecDirectiveEventType is the type of event in an ExecDirective stream.
+ *
+ * @generated from enum bus.api.ExecDirectiveEventType
+ */
+export enum ExecDirectiveEventType {
+  /**
+   * ExecDirectiveEventType_UNKNOWN is unrecognized.
+   *
+   * @generated from enum value: ExecDirectiveEventType_UNKNOWN = 0;
+   */
+  ExecDirectiveEventType_UNKNOWN = 0,
+
+  /**
+   * ExecDirectiveEventType_VALUE_ADDED indicates a value was added.
+   *
+   * @generated from enum value: ExecDirectiveEventType_VALUE_ADDED = 1;
+   */
+  ExecDirectiveEventType_VALUE_ADDED = 1,
+
+  /**
+   * ExecDirectiveEventType_VALUE_REMOVED indicates a value was removed.
+   *
+   * @generated from enum value: ExecDirectiveEventType_VALUE_REMOVED = 2;
+   */
+  ExecDirectiveEventType_VALUE_REMOVED = 2,
+
+  /**
+   * ExecDirectiveEventType_IDLE indicates the idle state changed.
+   *
+   * @generated from enum value: ExecDirectiveEventType_IDLE = 3;
+   */
+  ExecDirectiveEventType_IDLE = 3,
+}
+
+// ExecDirectiveEventType_Enum is the enum type for ExecDirectiveEventType.
+export const ExecDirectiveEventType_Enum = createEnumType(
+  'bus.api.ExecDirectiveEventType',
+  [
+    { no: 0, name: 'ExecDirectiveEventType_UNKNOWN' },
+    { no: 1, name: 'ExecDirectiveEventType_VALUE_ADDED' },
+    { no: 2, name: 'ExecDirectiveEventType_VALUE_REMOVED' },
+    { no: 3, name: 'ExecDirectiveEventType_IDLE' },
+  ],
+)
+
+/**
+ * PlanAction is the planned change to a configset key.
+ *
+ * @generated from enum bus.api.PlanAction
+ */
+export enum PlanAction {
+  /**
+   * PlanAction_UNCHANGED indicates the applied config is left alone.
+   *
+   * @generated from enum value: PlanAction_UNCHANGED = 0;
+   */
+  PlanAction_UNCHANGED = 0,
+
+  /**
+   * PlanAction_START indicates the key is not applied and would be started.
+   *
+   * @generated from enum value: PlanAction_START = 1;
+   */
+  PlanAction_START = 1,
+
+  /**
+   * PlanAction_STOP indicates the key is applied and not in the configset
+   * and the configset replaces the applied configs.
+   *
+   * @generated from enum value: PlanAction_STOP = 2;
+   */
+  PlanAction_STOP = 2,
+
+  /**
+   * PlanAction_RESTART indicates the revision is newer than the applied
+   * revision and the controller would be restarted, unless it applies the
+   * config in place.
+   *
+   * @generated from enum value: PlanAction_RESTART = 3;
+   */
+  PlanAction_RESTART = 3,
+
+  /**
+   * PlanAction_IGNORED indicates the config differs from the applied config
+   * but the revision is not newer, so ApplyConfigSet would not apply it.
+   * The daemon config file and PutConfigSet apply changed configs with a
+   * revision newer than the applied one, so they would restart the key.
+   *
+   * @generated from enum value: PlanAction_IGNORED = 4;
+   */
+  PlanAction_IGNORED = 4,
+
+  /**
+   * PlanAction_INVALID indicates the config is invalid and cannot be applied.
+   *
+   * @generated from enum value: PlanAction_INVALID = 5;
+   */
+  PlanAction_INVALID = 5,
+}
+
+// PlanAction_Enum is the enum type for PlanAction.
+export const PlanAction_Enum = createEnumType(
+  'bus.api.PlanAction',
+  [
+    { no: 0, name: 'PlanAction_UNCHANGED' },
+    { no: 1, name: 'PlanAction_START' },
+    { no: 2, name: 'PlanAction_STOP' },
+    { no: 3, name: 'PlanAction_RESTART' },
+    { no: 4, name: 'PlanAction_IGNORED' },
+    { no: 5, name: 'PlanAction_INVALID' },
+  ],
+)
+
 /**
  * Config are configuration arguments.
  *
@@ -21,6 +212,38 @@ export interface Config {
    * @generated from field: bool enable_exec_controller = 1;
    */
   enableExecController?: boolean
+  /**
+   * EnableExecDirective enables the exec directive API.
+   *
+   * @generated from field: bool enable_exec_directive = 2;
+   */
+  enableExecDirective?: boolean
+  /**
+   * EnableServeDirectives enables the serve directives API.
+   *
+   * @generated from field: bool enable_serve_directives = 3;
+   */
+  enableServeDirectives?: boolean
+  /**
+   * EnableControlControllers enables the stop, restart, and remove controller API.
+   *
+   * @generated from field: bool enable_control_controllers = 4;
+   */
+  enableControlControllers?: boolean
+  /**
+   * EnableConfigSetStore enables the put and rollback configset store API.
+   *
+   * @generated from field: bool enable_configset_store = 5;
+   */
+  enableConfigsetStore?: boolean
+  /**
+   * NoServeExecDirectives skips forwarding directives added with ExecDirective
+   * to ServeDirectives handlers. Set if the api has a single remote which uses
+   * both to avoid forwarding its directives back to it in a loop.
+   *
+   * @generated from field: bool no_serve_exec_directives = 6;
+   */
+  noServeExecDirectives?: boolean
 }
 
 // Config contains the message type declaration for Config.
@@ -33,6 +256,36 @@ export const Config: MessageType<Config> = createMessageType({
       kind: 'scalar',
       T: ScalarType.BOOL,
     },
+    {
+      no: 2,
+      name: 'enable_exec_directive',
+      kind: 'scalar',
+      T: ScalarType.BOOL,
+    },
+    {
+      no: 3,
+      name: 'enable_serve_directives',
+      kind: 'scalar',
+      T: ScalarType.BOOL,
+    },
+    {
+      no: 4,
+      name: 'enable_control_controllers',
+      kind: 'scalar',
+      T: ScalarType.BOOL,
+    },
+    {
+      no: 5,
+      name: 'enable_configset_store',
+      kind: 'scalar',
+      T: ScalarType.BOOL,
+    },
+    {
+      no: 6,
+      name: 'no_serve_exec_directives',
+      kind: 'scalar',
+      T: ScalarType.BOOL,
+    },
   ] as readonly PartialFieldInfo[],
   packedByDefault: true,
 })
@@ -70,6 +323,13 @@ export interface GetBusInfoResponse {
    * @generated from field: repeated directive.DirectiveState running_directives = 2;
    */
   runningDirectives?: DirectiveState[]
+  /**
+   * ConfigSetControllers is the state of the controllers managed by the
+   * configset controller, by configset key.
+   *
+   * @generated from field: repeated controller.exec.ExecControllerResponse config_set_controllers = 3;
+   */
+  configSetControllers?: ExecControllerResponse[]
 }
 
 // GetBusInfoResponse contains the message type declaration for GetBusInfoResponse.
@@ -91,6 +351,1202 @@ export const GetBusInfoResponse: MessageType<GetBusInfoResponse> =
         T: () => DirectiveState,
         repeated: true,
       },
+      {
+        no: 3,
+        name: 'config_set_controllers',
+        kind: 'message',
+        T: () => ExecControllerResponse,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * GetDirectiveInfoRequest is the request type for GetDirectiveInfo.
+ *
+ * @generated from message bus.api.GetDirectiveInfoRequest
+ */
+export interface GetDirectiveInfoRequest {
+  /**
+   * InstanceId is the id of the directive instance.
+   * Matches the instance_id field of the directive state.
+   *
+   * @generated from field: uint32 instance_id = 1;
+   */
+  instanceId?: number
+}
+
+// GetDirectiveInfoRequest contains the message type declaration for GetDirectiveInfoRequest.
+export const GetDirectiveInfoRequest: MessageType<GetDirectiveInfoRequest> =
+  createMessageType({
+    typeName: 'bus.api.GetDirectiveInfoRequest',
+    fields: [
+      { no: 1, name: 'instance_id', kind: 'scalar', T: ScalarType.UINT32 },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * GetDirectiveInfoResponse is the response type for GetDirectiveInfo.
+ *
+ * @generated from message bus.api.GetDirectiveInfoResponse
+ */
+export interface GetDirectiveInfoResponse {
+  /**
+   * Found indicates the directive was found.
+   *
+   * @generated from field: bool found = 1;
+   */
+  found?: boolean
+  /**
+   * DirectiveState is the directive info.
+   *
+   * @generated from field: directive.DirectiveState directive_state = 2;
+   */
+  directiveState?: DirectiveState
+  /**
+   * Idle indicates if the directive is idle.
+   *
+   * @generated from field: bool idle = 3;
+   */
+  idle?: boolean
+  /**
+   * ResolverErrors contains any resolver errors.
+   *
+   * @generated from field: repeated string resolver_errors = 4;
+   */
+  resolverErrors?: string[]
+  /**
+   * Values contains the directive values.
+   *
+   * @generated from field: repeated directive.ValueInfo values = 5;
+   */
+  values?: ValueInfo[]
+}
+
+// GetDirectiveInfoResponse contains the message type declaration for GetDirectiveInfoResponse.
+export const GetDirectiveInfoResponse: MessageType<GetDirectiveInfoResponse> =
+  createMessageType({
+    typeName: 'bus.api.GetDirectiveInfoResponse',
+    fields: [
+      { no: 1, name: 'found', kind: 'scalar', T: ScalarType.BOOL },
+      {
+        no: 2,
+        name: 'directive_state',
+        kind: 'message',
+        T: () => DirectiveState,
+      },
+      { no: 3, name: 'idle', kind: 'scalar', T: ScalarType.BOOL },
+      {
+        no: 4,
+        name: 'resolver_errors',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
+      {
+        no: 5,
+        name: 'values',
+        kind: 'message',
+        T: () => ValueInfo,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ListFactoriesRequest is the request type for ListFactories.
+ *
+ * @generated from message bus.api.ListFactoriesRequest
+ */
+export interface ListFactoriesRequest {}
+
+// ListFactoriesRequest contains the message type declaration for ListFactoriesRequest.
+export const ListFactoriesRequest: MessageType<ListFactoriesRequest> =
+  createMessageType({
+    typeName: 'bus.api.ListFactoriesRequest',
+    fields: [] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * FactoryInfo is information about a controller factory.
+ *
+ * @generated from message bus.api.FactoryInfo
+ */
+export interface FactoryInfo {
+  /**
+   * ConfigId is the config ID the factory constructs controllers for.
+   *
+   * @generated from field: string config_id = 1;
+   */
+  configId?: string
+  /**
+   * Version is the version of the factory.
+   *
+   * @generated from field: string version = 2;
+   */
+  version?: string
+  /**
+   * ResolverId is the id of the resolver providing the factory.
+   *
+   * @generated from field: string resolver_id = 3;
+   */
+  resolverId?: string
+  /**
+   * ConfigSchema is the JSON schema of the factory config.
+   *
+   * @generated from field: string config_schema = 4;
+   */
+  configSchema?: string
+}
+
+// FactoryInfo contains the message type declaration for FactoryInfo.
+export const FactoryInfo: MessageType<FactoryInfo> = createMessageType({
+  typeName: 'bus.api.FactoryInfo',
+  fields: [
+    { no: 1, name: 'config_id', kind: 'scalar', T: ScalarType.STRING },
+    { no: 2, name: 'version', kind: 'scalar', T: ScalarType.STRING },
+    { no: 3, name: 'resolver_id', kind: 'scalar', T: ScalarType.STRING },
+    { no: 4, name: 'config_schema', kind: 'scalar', T: ScalarType.STRING },
+  ] as readonly PartialFieldInfo[],
+  packedByDefault: true,
+})
+
+/**
+ * ListFactoriesResponse is the response type for ListFactories.
+ *
+ * @generated from message bus.api.ListFactoriesResponse
+ */
+export interface ListFactoriesResponse {
+  /**
+   * Factories contains the factories available to the bus.
+   * Sorted by config id and resolver id.
+   *
+   * @generated from field: repeated bus.api.FactoryInfo factories = 1;
+   */
+  factories?: FactoryInfo[]
+}
+
+// ListFactoriesResponse contains the message type declaration for ListFactoriesResponse.
+export const ListFactoriesResponse: MessageType<ListFactoriesResponse> =
+  createMessageType({
+    typeName: 'bus.api.ListFactoriesResponse',
+    fields: [
+      {
+        no: 1,
+        name: 'factories',
+        kind: 'message',
+        T: () => FactoryInfo,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ControllerTarget selects configset controllers.
+ *
+ * If both fields are set both must match.
+ *
+ * @generated from message bus.api.ControllerTarget
+ */
+export interface ControllerTarget {
+  /**
+   * ConfigKey is the configset key of the controller.
+   *
+   * @generated from field: string config_key = 1;
+   */
+  configKey?: string
+  /**
+   * ControllerId is the controller id of the running controller.
+   *
+   * @generated from field: string controller_id = 2;
+   */
+  controllerId?: string
+}
+
+// ControllerTarget contains the message type declaration for ControllerTarget.
+export const ControllerTarget: MessageType<ControllerTarget> =
+  createMessageType({
+    typeName: 'bus.api.ControllerTarget',
+    fields: [
+      { no: 1, name: 'config_key', kind: 'scalar', T: ScalarType.STRING },
+      { no: 2, name: 'controller_id', kind: 'scalar', T: ScalarType.STRING },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * StopControllerRequest is the request type for StopController.
+ *
+ * @generated from message bus.api.StopControllerRequest
+ */
+export interface StopControllerRequest {
+  /**
+   * Target selects the controllers to stop.
+   *
+   * @generated from field: bus.api.ControllerTarget target = 1;
+   */
+  target?: ControllerTarget
+}
+
+// StopControllerRequest contains the message type declaration for StopControllerRequest.
+export const StopControllerRequest: MessageType<StopControllerRequest> =
+  createMessageType({
+    typeName: 'bus.api.StopControllerRequest',
+    fields: [
+      { no: 1, name: 'target', kind: 'message', T: () => ControllerTarget },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * StopControllerResponse is the response type for StopController.
+ *
+ * @generated from message bus.api.StopControllerResponse
+ */
+export interface StopControllerResponse {
+  /**
+   * ConfigKeys contains the configset keys of the stopped controllers.
+   *
+   * @generated from field: repeated string config_keys = 1;
+   */
+  configKeys?: string[]
+}
+
+// StopControllerResponse contains the message type declaration for StopControllerResponse.
+export const StopControllerResponse: MessageType<StopControllerResponse> =
+  createMessageType({
+    typeName: 'bus.api.StopControllerResponse',
+    fields: [
+      {
+        no: 1,
+        name: 'config_keys',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * RestartControllerRequest is the request type for RestartController.
+ *
+ * @generated from message bus.api.RestartControllerRequest
+ */
+export interface RestartControllerRequest {
+  /**
+   * Target selects the controllers to restart.
+   *
+   * @generated from field: bus.api.ControllerTarget target = 1;
+   */
+  target?: ControllerTarget
+}
+
+// RestartControllerRequest contains the message type declaration for RestartControllerRequest.
+export const RestartControllerRequest: MessageType<RestartControllerRequest> =
+  createMessageType({
+    typeName: 'bus.api.RestartControllerRequest',
+    fields: [
+      { no: 1, name: 'target', kind: 'message', T: () => ControllerTarget },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * RestartControllerResponse is the response type for RestartController.
+ *
+ * @generated from message bus.api.RestartControllerResponse
+ */
+export interface RestartControllerResponse {
+  /**
+   * ConfigKeys contains the configset keys of the restarted controllers.
+   *
+   * @generated from field: repeated string config_keys = 1;
+   */
+  configKeys?: string[]
+}
+
+// RestartControllerResponse contains the message type declaration for RestartControllerResponse.
+export const RestartControllerResponse: MessageType<RestartControllerResponse> =
+  createMessageType({
+    typeName: 'bus.api.RestartControllerResponse',
+    fields: [
+      {
+        no: 1,
+        name: 'config_keys',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * RemoveControllerRequest is the request type for RemoveController.
+ *
+ * @generated from message bus.api.RemoveControllerRequest
+ */
+export interface RemoveControllerRequest {
+  /**
+   * Target selects the controllers to remove.
+   *
+   * @generated from field: bus.api.ControllerTarget target = 1;
+   */
+  target?: ControllerTarget
+}
+
+// RemoveControllerRequest contains the message type declaration for RemoveControllerRequest.
+export const RemoveControllerRequest: MessageType<RemoveControllerRequest> =
+  createMessageType({
+    typeName: 'bus.api.RemoveControllerRequest',
+    fields: [
+      { no: 1, name: 'target', kind: 'message', T: () => ControllerTarget },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * RemoveControllerResponse is the response type for RemoveController.
+ *
+ * @generated from message bus.api.RemoveControllerResponse
+ */
+export interface RemoveControllerResponse {
+  /**
+   * ConfigKeys contains the configset keys of the removed controllers.
+   *
+   * @generated from field: repeated string config_keys = 1;
+   */
+  configKeys?: string[]
+}
+
+// RemoveControllerResponse contains the message type declaration for RemoveControllerResponse.
+export const RemoveControllerResponse: MessageType<RemoveControllerResponse> =
+  createMessageType({
+    typeName: 'bus.api.RemoveControllerResponse',
+    fields: [
+      {
+        no: 1,
+        name: 'config_keys',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * WatchBusInfoRequest is the request type for WatchBusInfo.
+ *
+ * @generated from message bus.api.WatchBusInfoRequest
+ */
+export interface WatchBusInfoRequest {}
+
+// WatchBusInfoRequest contains the message type declaration for WatchBusInfoRequest.
+export const WatchBusInfoRequest: MessageType<WatchBusInfoRequest> =
+  createMessageType({
+    typeName: 'bus.api.WatchBusInfoRequest',
+    fields: [] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * WatchBusInfoController is a controller in a WatchBusInfo stream.
+ *
+ * @generated from message bus.api.WatchBusInfoController
+ */
+export interface WatchBusInfoController {
+  /**
+   * Handle identifies the controller within the stream.
+   *
+   * @generated from field: uint32 handle = 1;
+   */
+  handle?: number
+  /**
+   * Info is the controller info.
+   *
+   * @generated from field: controller.Info info = 2;
+   */
+  info?: Info
+}
+
+// WatchBusInfoController contains the message type declaration for WatchBusInfoController.
+export const WatchBusInfoController: MessageType<WatchBusInfoController> =
+  createMessageType({
+    typeName: 'bus.api.WatchBusInfoController',
+    fields: [
+      { no: 1, name: 'handle', kind: 'scalar', T: ScalarType.UINT32 },
+      { no: 2, name: 'info', kind: 'message', T: () => Info },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * WatchBusInfoDirective is a directive in a WatchBusInfo stream.
+ *
+ * @generated from message bus.api.WatchBusInfoDirective
+ */
+export interface WatchBusInfoDirective {
+  /**
+   * Handle identifies the directive instance within the stream.
+   *
+   * @generated from field: uint32 handle = 1;
+   */
+  handle?: number
+  /**
+   * State is the directive info.
+   * Set for SNAPSHOT, DIRECTIVE_ADDED and DIRECTIVE_REMOVED events.
+   *
+   * @generated from field: directive.DirectiveState state = 2;
+   */
+  state?: DirectiveState
+  /**
+   * Idle indicates if the directive is idle.
+   * Set for SNAPSHOT and DIRECTIVE_STATE events.
+   *
+   * @generated from field: bool idle = 3;
+   */
+  idle?: boolean
+  /**
+   * ValueCount is the number of directive values.
+   * Set for SNAPSHOT and DIRECTIVE_STATE events.
+   *
+   * @generated from field: uint32 value_count = 4;
+   */
+  valueCount?: number
+  /**
+   * ResolverErrors contains any resolver errors.
+   * Set for SNAPSHOT and DIRECTIVE_STATE events.
+   *
+   * @generated from field: repeated string resolver_errors = 5;
+   */
+  resolverErrors?: string[]
+}
+
+// WatchBusInfoDirective contains the message type declaration for WatchBusInfoDirective.
+export const WatchBusInfoDirective: MessageType<WatchBusInfoDirective> =
+  createMessageType({
+    typeName: 'bus.api.WatchBusInfoDirective',
+    fields: [
+      { no: 1, name: 'handle', kind: 'scalar', T: ScalarType.UINT32 },
+      { no: 2, name: 'state', kind: 'message', T: () => DirectiveState },
+      { no: 3, name: 'idle', kind: 'scalar', T: ScalarType.BOOL },
+      { no: 4, name: 'value_count', kind: 'scalar', T: ScalarType.UINT32 },
+      {
+        no: 5,
+        name: 'resolver_errors',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * WatchBusInfoResponse is an event in the WatchBusInfo stream.
+ *
+ * @generated from message bus.api.WatchBusInfoResponse
+ */
+export interface WatchBusInfoResponse {
+  /**
+   * EventType is the type of event.
+   *
+   * @generated from field: bus.api.WatchBusInfoEventType event_type = 1;
+   */
+  eventType?: WatchBusInfoEventType
+  /**
+   * Controllers contains the controllers for SNAPSHOT and CONTROLLER events.
+   *
+   * @generated from field: repeated bus.api.WatchBusInfoController controllers = 2;
+   */
+  controllers?: WatchBusInfoController[]
+  /**
+   * Directives contains the directives for SNAPSHOT and DIRECTIVE events.
+   *
+   * @generated from field: repeated bus.api.WatchBusInfoDirective directives = 3;
+   */
+  directives?: WatchBusInfoDirective[]
+}
+
+// WatchBusInfoResponse contains the message type declaration for WatchBusInfoResponse.
+export const WatchBusInfoResponse: MessageType<WatchBusInfoResponse> =
+  createMessageType({
+    typeName: 'bus.api.WatchBusInfoResponse',
+    fields: [
+      {
+        no: 1,
+        name: 'event_type',
+        kind: 'enum',
+        T: WatchBusInfoEventType_Enum,
+      },
+      {
+        no: 2,
+        name: 'controllers',
+        kind: 'message',
+        T: () => WatchBusInfoController,
+        repeated: true,
+      },
+      {
+        no: 3,
+        name: 'directives',
+        kind: 'message',
+        T: () => WatchBusInfoDirective,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ExecDirectiveRequest is the request type for ExecDirective.
+ *
+ * @generated from message bus.api.ExecDirectiveRequest
+ */
+export interface ExecDirectiveRequest {
+  /**
+   * DirectiveTypeId is the networked directive type identifier.
+   *
+   * @generated from field: string directive_type_id = 1;
+   */
+  directiveTypeId?: string
+  /**
+   * DirectiveBody is the directive encoded with the networked codec.
+   *
+   * @generated from field: bytes directive_body = 2;
+   */
+  directiveBody?: Uint8Array
+}
+
+// ExecDirectiveRequest contains the message type declaration for ExecDirectiveRequest.
+export const ExecDirectiveRequest: MessageType<ExecDirectiveRequest> =
+  createMessageType({
+    typeName: 'bus.api.ExecDirectiveRequest',
+    fields: [
+      {
+        no: 1,
+        name: 'directive_type_id',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+      },
+      { no: 2, name: 'directive_body', kind: 'scalar', T: ScalarType.BYTES },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ExecDirectiveResponse is an event in the ExecDirective stream.
+ *
+ * @generated from message bus.api.ExecDirectiveResponse
+ */
+export interface ExecDirectiveResponse {
+  /**
+   * EventType is the type of event.
+   *
+   * @generated from field: bus.api.ExecDirectiveEventType event_type = 1;
+   */
+  eventType?: ExecDirectiveEventType
+  /**
+   * ValueId is the id of the value that was added or removed.
+   *
+   * @generated from field: uint32 value_id = 2;
+   */
+  valueId?: number
+  /**
+   * ValueBody is the encoded value for VALUE_ADDED events.
+   *
+   * @generated from field: bytes value_body = 3;
+   */
+  valueBody?: Uint8Array
+  /**
+   * Idle indicates if the directive is idle for IDLE events.
+   *
+   * @generated from field: bool idle = 4;
+   */
+  idle?: boolean
+  /**
+   * ResolverErrors contains any resolver errors for IDLE events.
+   *
+   * @generated from field: repeated string resolver_errors = 5;
+   */
+  resolverErrors?: string[]
+}
+
+// ExecDirectiveResponse contains the message type declaration for ExecDirectiveResponse.
+export const ExecDirectiveResponse: MessageType<ExecDirectiveResponse> =
+  createMessageType({
+    typeName: 'bus.api.ExecDirectiveResponse',
+    fields: [
+      {
+        no: 1,
+        name: 'event_type',
+        kind: 'enum',
+        T: ExecDirectiveEventType_Enum,
+      },
+      { no: 2, name: 'value_id', kind: 'scalar', T: ScalarType.UINT32 },
+      { no: 3, name: 'value_body', kind: 'scalar', T: ScalarType.BYTES },
+      { no: 4, name: 'idle', kind: 'scalar', T: ScalarType.BOOL },
+      {
+        no: 5,
+        name: 'resolver_errors',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ServeDirectivesRequest is a message from a remote directive handler.
+ *
+ * @generated from message bus.api.ServeDirectivesRequest
+ */
+export interface ServeDirectivesRequest {
+  /**
+   * DirectiveTypeIds is the list of networked directive types to handle.
+   * Set in the first message only.
+   *
+   * @generated from field: repeated string directive_type_ids = 1;
+   */
+  directiveTypeIds?: string[]
+  /**
+   * ResolverId is the id of the resolver this event is for.
+   *
+   * @generated from field: uint32 resolver_id = 2;
+   */
+  resolverId?: number
+  /**
+   * ResolverEvent is a value or idle event for the resolver.
+   *
+   * @generated from field: bus.api.ExecDirectiveResponse resolver_event = 3;
+   */
+  resolverEvent?: ExecDirectiveResponse
+  /**
+   * ResolverError is set if the resolver exited with an error.
+   *
+   * @generated from field: string resolver_error = 4;
+   */
+  resolverError?: string
+}
+
+// ServeDirectivesRequest contains the message type declaration for ServeDirectivesRequest.
+export const ServeDirectivesRequest: MessageType<ServeDirectivesRequest> =
+  createMessageType({
+    typeName: 'bus.api.ServeDirectivesRequest',
+    fields: [
+      {
+        no: 1,
+        name: 'directive_type_ids',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
+      { no: 2, name: 'resolver_id', kind: 'scalar', T: ScalarType.UINT32 },
+      {
+        no: 3,
+        name: 'resolver_event',
+        kind: 'message',
+        T: () => ExecDirectiveResponse,
+      },
+      { no: 4, name: 'resolver_error', kind: 'scalar', T: ScalarType.STRING },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ServeDirectivesResponse is a message to a remote directive handler.
+ *
+ * @generated from message bus.api.ServeDirectivesResponse
+ */
+export interface ServeDirectivesResponse {
+  /**
+   * ResolverId is the id of the resolver.
+   *
+   * @generated from field: uint32 resolver_id = 1;
+   */
+  resolverId?: number
+  /**
+   * DirectiveTypeId is the networked directive type identifier.
+   * Set when starting a resolver.
+   *
+   * @generated from field: string directive_type_id = 2;
+   */
+  directiveTypeId?: string
+  /**
+   * DirectiveBody is the directive encoded with the networked codec.
+   * Set when starting a resolver.
+   *
+   * @generated from field: bytes directive_body = 3;
+   */
+  directiveBody?: Uint8Array
+  /**
+   * Cancel indicates the resolver was canceled.
+   *
+   * @generated from field: bool cancel = 4;
+   */
+  cancel?: boolean
+}
+
+// ServeDirectivesResponse contains the message type declaration for ServeDirectivesResponse.
+export const ServeDirectivesResponse: MessageType<ServeDirectivesResponse> =
+  createMessageType({
+    typeName: 'bus.api.ServeDirectivesResponse',
+    fields: [
+      { no: 1, name: 'resolver_id', kind: 'scalar', T: ScalarType.UINT32 },
+      {
+        no: 2,
+        name: 'directive_type_id',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+      },
+      { no: 3, name: 'directive_body', kind: 'scalar', T: ScalarType.BYTES },
+      { no: 4, name: 'cancel', kind: 'scalar', T: ScalarType.BOOL },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * GetHealthRequest is the request type for GetHealth.
+ *
+ * @generated from message bus.api.GetHealthRequest
+ */
+export interface GetHealthRequest {}
+
+// GetHealthRequest contains the message type declaration for GetHealthRequest.
+export const GetHealthRequest: MessageType<GetHealthRequest> =
+  createMessageType({
+    typeName: 'bus.api.GetHealthRequest',
+    fields: [] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ConfigKeyHealth is the health of a configset key.
+ *
+ * @generated from message bus.api.ConfigKeyHealth
+ */
+export interface ConfigKeyHealth {
+  /**
+   * ConfigKey is the configset key.
+   *
+   * @generated from field: string config_key = 1;
+   */
+  configKey?: string
+  /**
+   * Error is the reason the key is not ready.
+   *
+   * @generated from field: string error = 2;
+   */
+  error?: string
+}
+
+// ConfigKeyHealth contains the message type declaration for ConfigKeyHealth.
+export const ConfigKeyHealth: MessageType<ConfigKeyHealth> = createMessageType({
+  typeName: 'bus.api.ConfigKeyHealth',
+  fields: [
+    { no: 1, name: 'config_key', kind: 'scalar', T: ScalarType.STRING },
+    { no: 2, name: 'error', kind: 'scalar', T: ScalarType.STRING },
+  ] as readonly PartialFieldInfo[],
+  packedByDefault: true,
+})
+
+/**
+ * GetHealthResponse is the response type for GetHealth.
+ *
+ * @generated from message bus.api.GetHealthResponse
+ */
+export interface GetHealthResponse {
+  /**
+   * Live indicates the bus responded within the liveness timeout.
+   *
+   * @generated from field: bool live = 1;
+   */
+  live?: boolean
+  /**
+   * LiveError is the reason the bus is not live.
+   *
+   * @generated from field: string live_error = 2;
+   */
+  liveError?: string
+  /**
+   * Ready indicates every configset key has a running controller without errors.
+   *
+   * @generated from field: bool ready = 3;
+   */
+  ready?: boolean
+  /**
+   * FailingKeys contains the configset keys that are not ready.
+   * Sorted by config key.
+   *
+   * @generated from field: repeated bus.api.ConfigKeyHealth failing_keys = 4;
+   */
+  failingKeys?: ConfigKeyHealth[]
+}
+
+// GetHealthResponse contains the message type declaration for GetHealthResponse.
+export const GetHealthResponse: MessageType<GetHealthResponse> =
+  createMessageType({
+    typeName: 'bus.api.GetHealthResponse',
+    fields: [
+      { no: 1, name: 'live', kind: 'scalar', T: ScalarType.BOOL },
+      { no: 2, name: 'live_error', kind: 'scalar', T: ScalarType.STRING },
+      { no: 3, name: 'ready', kind: 'scalar', T: ScalarType.BOOL },
+      {
+        no: 4,
+        name: 'failing_keys',
+        kind: 'message',
+        T: () => ConfigKeyHealth,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * PlanConfigSetRequest is the request type for PlanConfigSet.
+ *
+ * @generated from message bus.api.PlanConfigSetRequest
+ */
+export interface PlanConfigSetRequest {
+  /**
+   * ConfigSetYaml is the configset to plan in YAML or JSON format.
+   *
+   * @generated from field: string config_set_yaml = 1;
+   */
+  configSetYaml?: string
+  /**
+   * Replace plans replacing the applied configs with the configset.
+   * Applied keys which are not in the configset are stopped.
+   * If false, plans adding the configset like ApplyConfigSet: applied keys
+   * which are not in the configset are unchanged.
+   *
+   * @generated from field: bool replace = 2;
+   */
+  replace?: boolean
+}
+
+// PlanConfigSetRequest contains the message type declaration for PlanConfigSetRequest.
+export const PlanConfigSetRequest: MessageType<PlanConfigSetRequest> =
+  createMessageType({
+    typeName: 'bus.api.PlanConfigSetRequest',
+    fields: [
+      { no: 1, name: 'config_set_yaml', kind: 'scalar', T: ScalarType.STRING },
+      { no: 2, name: 'replace', kind: 'scalar', T: ScalarType.BOOL },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ConfigKeyPlan is the planned change to a configset key.
+ *
+ * @generated from message bus.api.ConfigKeyPlan
+ */
+export interface ConfigKeyPlan {
+  /**
+   * ConfigKey is the configset key.
+   *
+   * @generated from field: string config_key = 1;
+   */
+  configKey?: string
+  /**
+   * Action is the planned change.
+   *
+   * @generated from field: bus.api.PlanAction action = 2;
+   */
+  action?: PlanAction
+  /**
+   * ConfigId is the config id of the planned config.
+   * If the key is stopped, the config id of the applied config.
+   *
+   * @generated from field: string config_id = 3;
+   */
+  configId?: string
+  /**
+   * CurrentRev is the revision of the applied config, if any.
+   *
+   * @generated from field: uint64 current_rev = 4;
+   */
+  currentRev?: bigint
+  /**
+   * Rev is the revision of the planned config, if any.
+   *
+   * @generated from field: uint64 rev = 5;
+   */
+  rev?: bigint
+  /**
+   * CurrentConfigYaml is the applied config in YAML format, if any.
+   *
+   * @generated from field: string current_config_yaml = 6;
+   */
+  currentConfigYaml?: string
+  /**
+   * ConfigYaml is the planned config in YAML format, if valid.
+   *
+   * @generated from field: string config_yaml = 7;
+   */
+  configYaml?: string
+  /**
+   * Error is the reason the planned config is invalid.
+   *
+   * @generated from field: string error = 8;
+   */
+  error?: string
+  /**
+   * UnknownConfigId indicates no factory is available for the config id.
+   *
+   * @generated from field: bool unknown_config_id = 9;
+   */
+  unknownConfigId?: boolean
+}
+
+// ConfigKeyPlan contains the message type declaration for ConfigKeyPlan.
+export const ConfigKeyPlan: MessageType<ConfigKeyPlan> = createMessageType({
+  typeName: 'bus.api.ConfigKeyPlan',
+  fields: [
+    { no: 1, name: 'config_key', kind: 'scalar', T: ScalarType.STRING },
+    { no: 2, name: 'action', kind: 'enum', T: PlanAction_Enum },
+    { no: 3, name: 'config_id', kind: 'scalar', T: ScalarType.STRING },
+    { no: 4, name: 'current_rev', kind: 'scalar', T: ScalarType.UINT64 },
+    { no: 5, name: 'rev', kind: 'scalar', T: ScalarType.UINT64 },
+    {
+      no: 6,
+      name: 'current_config_yaml',
+      kind: 'scalar',
+      T: ScalarType.STRING,
+    },
+    { no: 7, name: 'config_yaml', kind: 'scalar', T: ScalarType.STRING },
+    { no: 8, name: 'error', kind: 'scalar', T: ScalarType.STRING },
+    { no: 9, name: 'unknown_config_id', kind: 'scalar', T: ScalarType.BOOL },
+  ] as readonly PartialFieldInfo[],
+  packedByDefault: true,
+})
+
+/**
+ * PlanConfigSetResponse is the response type for PlanConfigSet.
+ *
+ * @generated from message bus.api.PlanConfigSetResponse
+ */
+export interface PlanConfigSetResponse {
+  /**
+   * Keys contains the planned change for each configset key.
+   * Sorted by config key.
+   *
+   * @generated from field: repeated bus.api.ConfigKeyPlan keys = 1;
+   */
+  keys?: ConfigKeyPlan[]
+}
+
+// PlanConfigSetResponse contains the message type declaration for PlanConfigSetResponse.
+export const PlanConfigSetResponse: MessageType<PlanConfigSetResponse> =
+  createMessageType({
+    typeName: 'bus.api.PlanConfigSetResponse',
+    fields: [
+      {
+        no: 1,
+        name: 'keys',
+        kind: 'message',
+        T: () => ConfigKeyPlan,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * GetConfigSetHistoryRequest is the request type for GetConfigSetHistory.
+ *
+ * @generated from message bus.api.GetConfigSetHistoryRequest
+ */
+export interface GetConfigSetHistoryRequest {
+  /**
+   * StoreKey is the key of the stored configset.
+   * If empty, lists the stored keys.
+   *
+   * @generated from field: string store_key = 1;
+   */
+  storeKey?: string
+}
+
+// GetConfigSetHistoryRequest contains the message type declaration for GetConfigSetHistoryRequest.
+export const GetConfigSetHistoryRequest: MessageType<GetConfigSetHistoryRequest> =
+  createMessageType({
+    typeName: 'bus.api.GetConfigSetHistoryRequest',
+    fields: [
+      { no: 1, name: 'store_key', kind: 'scalar', T: ScalarType.STRING },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * GetConfigSetHistoryResponse is the response type for GetConfigSetHistory.
+ *
+ * @generated from message bus.api.GetConfigSetHistoryResponse
+ */
+export interface GetConfigSetHistoryResponse {
+  /**
+   * Revisions contains the revisions of the key, oldest first.
+   *
+   * @generated from field: repeated configset.store.ConfigSetRevision revisions = 1;
+   */
+  revisions?: ConfigSetRevision[]
+  /**
+   * StoreKeys contains the stored keys if no key was requested, sorted.
+   *
+   * @generated from field: repeated string store_keys = 2;
+   */
+  storeKeys?: string[]
+}
+
+// GetConfigSetHistoryResponse contains the message type declaration for GetConfigSetHistoryResponse.
+export const GetConfigSetHistoryResponse: MessageType<GetConfigSetHistoryResponse> =
+  createMessageType({
+    typeName: 'bus.api.GetConfigSetHistoryResponse',
+    fields: [
+      {
+        no: 1,
+        name: 'revisions',
+        kind: 'message',
+        T: () => ConfigSetRevision,
+        repeated: true,
+      },
+      {
+        no: 2,
+        name: 'store_keys',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * PutConfigSetRequest is the request type for PutConfigSet.
+ *
+ * @generated from message bus.api.PutConfigSetRequest
+ */
+export interface PutConfigSetRequest {
+  /**
+   * StoreKey is the key to store the configset under.
+   *
+   * @generated from field: string store_key = 1;
+   */
+  storeKey?: string
+  /**
+   * ConfigSetYaml is the configset in YAML or JSON format.
+   *
+   * @generated from field: string config_set_yaml = 2;
+   */
+  configSetYaml?: string
+  /**
+   * Source describes who or what stored the revision.
+   *
+   * @generated from field: string source = 3;
+   */
+  source?: string
+}
+
+// PutConfigSetRequest contains the message type declaration for PutConfigSetRequest.
+export const PutConfigSetRequest: MessageType<PutConfigSetRequest> =
+  createMessageType({
+    typeName: 'bus.api.PutConfigSetRequest',
+    fields: [
+      { no: 1, name: 'store_key', kind: 'scalar', T: ScalarType.STRING },
+      { no: 2, name: 'config_set_yaml', kind: 'scalar', T: ScalarType.STRING },
+      { no: 3, name: 'source', kind: 'scalar', T: ScalarType.STRING },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * PutConfigSetResponse is the response type for PutConfigSet.
+ *
+ * @generated from message bus.api.PutConfigSetResponse
+ */
+export interface PutConfigSetResponse {
+  /**
+   * Revision is the stored revision.
+   *
+   * @generated from field: configset.store.ConfigSetRevision revision = 1;
+   */
+  revision?: ConfigSetRevision
+}
+
+// PutConfigSetResponse contains the message type declaration for PutConfigSetResponse.
+export const PutConfigSetResponse: MessageType<PutConfigSetResponse> =
+  createMessageType({
+    typeName: 'bus.api.PutConfigSetResponse',
+    fields: [
+      { no: 1, name: 'revision', kind: 'message', T: () => ConfigSetRevision },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * RollbackConfigSetRequest is the request type for RollbackConfigSet.
+ *
+ * @generated from message bus.api.RollbackConfigSetRequest
+ */
+export interface RollbackConfigSetRequest {
+  /**
+   * StoreKey is the key of the stored configset.
+   *
+   * @generated from field: string store_key = 1;
+   */
+  storeKey?: string
+  /**
+   * Rev is the revision to roll back to.
+   *
+   * @generated from field: uint64 rev = 2;
+   */
+  rev?: bigint
+  /**
+   * Source describes who or what rolled back the configset.
+   *
+   * @generated from field: string source = 3;
+   */
+  source?: string
+}
+
+// RollbackConfigSetRequest contains the message type declaration for RollbackConfigSetRequest.
+export const RollbackConfigSetRequest: MessageType<RollbackConfigSetRequest> =
+  createMessageType({
+    typeName: 'bus.api.RollbackConfigSetRequest',
+    fields: [
+      { no: 1, name: 'store_key', kind: 'scalar', T: ScalarType.STRING },
+      { no: 2, name: 'rev', kind: 'scalar', T: ScalarType.UINT64 },
+      { no: 3, name: 'source', kind: 'scalar', T: ScalarType.STRING },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * RollbackConfigSetResponse is the response type for RollbackConfigSet.
+ *
+ * @generated from message bus.api.RollbackConfigSetResponse
+ */
+export interface RollbackConfigSetResponse {
+  /**
+   * Revision is the new revision with the configset of the earlier revision.
+   *
+   * @generated from field: configset.store.ConfigSetRevision revision = 1;
+   */
+  revision?: ConfigSetRevision
+}
+
+// RollbackConfigSetResponse contains the message type declaration for RollbackConfigSetResponse.
+export const RollbackConfigSetResponse: MessageType<RollbackConfigSetResponse> =
+  createMessageType({
+    typeName: 'bus.api.RollbackConfigSetResponse',
+    fields: [
+      { no: 1, name: 'revision', kind: 'message', T: () => ConfigSetRevision },
     ] as readonly PartialFieldInfo[],
     packedByDefault: true,
   })
@@ -0,0 +1,51 @@
+package bus_api
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrExecDirectiveDisabled is returned if exec directive isn't enabled.
+	ErrExecDirectiveDisabled = errors.New("exec directive is disabled on this api")
+	// ErrUnknownNetworkedType is returned if the directive type is not known.
+	ErrUnknownNetworkedType = errors.New("unknown networked directive type")
+)
+
+// ExecDirective executes a networked directive on the bus.
+// Streams value events until the request is canceled.
+func (a *API) ExecDirective(
+	req *ExecDirectiveRequest,
+	strm SRPCControllerBusService_ExecDirectiveStream,
+) error {
+	if !a.conf.GetEnableExecDirective() {
+		return ErrExecDirectiveDisabled
+	}
+
+	typeID := req.GetDirectiveTypeId()
+	dirType := a.types.GetNetworkedType(typeID)
+	if dirType == nil {
+		return errors.Wrap(ErrUnknownNetworkedType, typeID)
+	}
+	dir, err := decodeNetworked(dirType, req.GetDirectiveBody())
+	if err != nil {
+		return err
+	}
+
+	relServed := a.getServed().add(dir)
+	defer relServed()
+
+	var queue sendQueue[*ExecDirectiveResponse]
+	rel, err := ExecDirectiveEvents(a.bus, dirType, dir, func(ev *ExecDirectiveResponse, err error) {
+		if err != nil {
+			queue.fail(err)
+		} else {
+			queue.push(ev)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	defer rel()
+
+	return queue.drain(strm.Context(), strm.Send)
+}
@@ -0,0 +1,78 @@
+package bus_api
+
+import (
+	"context"
+	"io"
+
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/pkg/errors"
+)
+
+// ErrServeDirectivesDisabled is returned if serve directives isn't enabled.
+var ErrServeDirectivesDisabled = errors.New("serve directives is disabled on this api")
+
+// ServeDirectives registers the caller as a handler for networked directives.
+// The handler and its values are removed when the stream closes.
+func (a *API) ServeDirectives(strm SRPCControllerBusService_ServeDirectivesStream) error {
+	if !a.conf.GetEnableServeDirectives() {
+		return ErrServeDirectivesDisabled
+	}
+
+	initMsg, err := strm.Recv()
+	if err != nil {
+		return err
+	}
+	typeIDs := initMsg.GetDirectiveTypeIds()
+	if len(typeIDs) == 0 {
+		return errors.New("at least one directive type id must be specified")
+	}
+	types := make([]directive.NetworkedType, 0, len(typeIDs))
+	for _, typeID := range typeIDs {
+		dirType := a.types.GetNetworkedType(typeID)
+		if dirType == nil {
+			return errors.Wrap(ErrUnknownNetworkedType, typeID)
+		}
+		types = append(types, dirType)
+	}
+
+	ctx, ctxCancel := context.WithCancel(strm.Context())
+	defer ctxCancel()
+
+	var queue sendQueue[*ServeDirectivesResponse]
+	h := newRemoteHandler(ctx, directive.NewNetworkedTypeSet(types...), a.getServed(), &queue)
+	relHandler, err := a.bus.AddHandler(h)
+	if err != nil {
+		return err
+	}
+	defer relHandler()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- queue.drain(ctx, strm.Send)
+	}()
+
+	// receive in a separate routine to return if sending fails
+	recvErrCh := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := strm.Recv()
+			if err == nil {
+				err = h.handleResolverEvent(msg)
+			}
+			if err != nil {
+				recvErrCh <- err
+				return
+			}
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case err := <-recvErrCh:
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+}
@@ -17,8 +17,45 @@ type SRPCControllerBusServiceClient interface {
 
 	// GetBusInfo requests information about the controller bus.
 	GetBusInfo(ctx context.Context, in *GetBusInfoRequest) (*GetBusInfoResponse, error)
+	// GetDirectiveInfo requests the state and values of a directive.
+	GetDirectiveInfo(ctx context.Context, in *GetDirectiveInfoRequest) (*GetDirectiveInfoResponse, error)
+	// ListFactories lists the controller factories available to the bus.
+	ListFactories(ctx context.Context, in *ListFactoriesRequest) (*ListFactoriesResponse, error)
+	// GetHealth returns the liveness and readiness of the bus.
+	// Readiness is derived from the state of the configset controllers.
+	GetHealth(ctx context.Context, in *GetHealthRequest) (*GetHealthResponse, error)
+	// PlanConfigSet computes the changes to the configset controllers if the
+	// configset was applied without applying it.
+	PlanConfigSet(ctx context.Context, in *PlanConfigSetRequest) (*PlanConfigSetResponse, error)
+	// GetConfigSetHistory returns the revision history of a stored configset.
+	GetConfigSetHistory(ctx context.Context, in *GetConfigSetHistoryRequest) (*GetConfigSetHistoryResponse, error)
+	// PutConfigSet stores a configset as a new revision of a key and applies it
+	// in place of the previous revision. Stored configsets are applied again
+	// when the daemon restarts.
+	PutConfigSet(ctx context.Context, in *PutConfigSetRequest) (*PutConfigSetResponse, error)
+	// RollbackConfigSet stores a copy of an earlier revision of a stored
+	// configset as a new revision and applies it.
+	RollbackConfigSet(ctx context.Context, in *RollbackConfigSetRequest) (*RollbackConfigSetResponse, error)
+	// WatchBusInfo streams a snapshot of the controller bus followed by
+	// controller and directive events.
+	WatchBusInfo(ctx context.Context, in *WatchBusInfoRequest) (SRPCControllerBusService_WatchBusInfoClient, error)
 	// ExecController executes a controller configuration on the bus.
 	ExecController(ctx context.Context, in *controller_exec.ExecControllerRequest) (SRPCControllerBusService_ExecControllerClient, error)
+	// StopController stops a configset controller and releases the configset
+	// references to it. It is started again if the configset is re-applied.
+	StopController(ctx context.Context, in *StopControllerRequest) (*StopControllerResponse, error)
+	// RestartController restarts a configset controller with the current config.
+	// Clears the quarantine if the controller was quarantined.
+	RestartController(ctx context.Context, in *RestartControllerRequest) (*RestartControllerResponse, error)
+	// RemoveController stops a configset controller and releases all configset
+	// references to it, including persistent references.
+	RemoveController(ctx context.Context, in *RemoveControllerRequest) (*RemoveControllerResponse, error)
+	// ExecDirective executes a networked directive on the bus.
+	// Streams value events until the request is canceled.
+	ExecDirective(ctx context.Context, in *ExecDirectiveRequest) (SRPCControllerBusService_ExecDirectiveClient, error)
+	// ServeDirectives registers the caller as a handler for networked directives.
+	// The handler and its values are removed when the stream closes.
+	ServeDirectives(ctx context.Context) (SRPCControllerBusService_ServeDirectivesClient, error)
 }
 
 type srpcControllerBusServiceClient struct {
@@ -48,6 +85,103 @@ func (c *srpcControllerBusServiceClient) GetBusInfo(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *srpcControllerBusServiceClient) GetDirectiveInfo(ctx context.Context, in *GetDirectiveInfoRequest) (*GetDirectiveInfoResponse, error) {
+	out := new(GetDirectiveInfoResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "GetDirectiveInfo", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) ListFactories(ctx context.Context, in *ListFactoriesRequest) (*ListFactoriesResponse, error) {
+	out := new(ListFactoriesResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "ListFactories", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) GetHealth(ctx context.Context, in *GetHealthRequest) (*GetHealthResponse, error) {
+	out := new(GetHealthResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "GetHealth", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) PlanConfigSet(ctx context.Context, in *PlanConfigSetRequest) (*PlanConfigSetResponse, error) {
+	out := new(PlanConfigSetResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "PlanConfigSet", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) GetConfigSetHistory(ctx context.Context, in *GetConfigSetHistoryRequest) (*GetConfigSetHistoryResponse, error) {
+	out := new(GetConfigSetHistoryResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "GetConfigSetHistory", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) PutConfigSet(ctx context.Context, in *PutConfigSetRequest) (*PutConfigSetResponse, error) {
+	out := new(PutConfigSetResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "PutConfigSet", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) RollbackConfigSet(ctx context.Context, in *RollbackConfigSetRequest) (*RollbackConfigSetResponse, error) {
+	out := new(RollbackConfigSetResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "RollbackConfigSet", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) WatchBusInfo(ctx context.Context, in *WatchBusInfoRequest) (SRPCControllerBusService_WatchBusInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, c.serviceID, "WatchBusInfo", in)
+	if err != nil {
+		return nil, err
+	}
+	strm := &srpcControllerBusService_WatchBusInfoClient{stream}
+	if err := strm.CloseSend(); err != nil {
+		return nil, err
+	}
+	return strm, nil
+}
+
+type SRPCControllerBusService_WatchBusInfoClient interface {
+	srpc.Stream
+	Recv() (*WatchBusInfoResponse, error)
+	RecvTo(*WatchBusInfoResponse) error
+}
+
+type srpcControllerBusService_WatchBusInfoClient struct {
+	srpc.Stream
+}
+
+func (x *srpcControllerBusService_WatchBusInfoClient) Recv() (*WatchBusInfoResponse, error) {
+	m := new(WatchBusInfoResponse)
+	if err := x.MsgRecv(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *srpcControllerBusService_WatchBusInfoClient) RecvTo(m *WatchBusInfoResponse) error {
+	return x.MsgRecv(m)
+}
+
 func (c *srpcControllerBusServiceClient) ExecController(ctx context.Context, in *controller_exec.ExecControllerRequest) (SRPCControllerBusService_ExecControllerClient, error) {
 	stream, err := c.cc.NewStream(ctx, c.serviceID, "ExecController", in)
 	if err != nil {
@@ -82,11 +216,148 @@ func (x *srpcControllerBusService_ExecControllerClient) RecvTo(m *controller_exe
 	return x.MsgRecv(m)
 }
 
+func (c *srpcControllerBusServiceClient) StopController(ctx context.Context, in *StopControllerRequest) (*StopControllerResponse, error) {
+	out := new(StopControllerResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "StopController", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) RestartController(ctx context.Context, in *RestartControllerRequest) (*RestartControllerResponse, error) {
+	out := new(RestartControllerResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "RestartController", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) RemoveController(ctx context.Context, in *RemoveControllerRequest) (*RemoveControllerResponse, error) {
+	out := new(RemoveControllerResponse)
+	err := c.cc.ExecCall(ctx, c.serviceID, "RemoveController", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpcControllerBusServiceClient) ExecDirective(ctx context.Context, in *ExecDirectiveRequest) (SRPCControllerBusService_ExecDirectiveClient, error) {
+	stream, err := c.cc.NewStream(ctx, c.serviceID, "ExecDirective", in)
+	if err != nil {
+		return nil, err
+	}
+	strm := &srpcControllerBusService_ExecDirectiveClient{stream}
+	if err := strm.CloseSend(); err != nil {
+		return nil, err
+	}
+	return strm, nil
+}
+
+type SRPCControllerBusService_ExecDirectiveClient interface {
+	srpc.Stream
+	Recv() (*ExecDirectiveResponse, error)
+	RecvTo(*ExecDirectiveResponse) error
+}
+
+type srpcControllerBusService_ExecDirectiveClient struct {
+	srpc.Stream
+}
+
+func (x *srpcControllerBusService_ExecDirectiveClient) Recv() (*ExecDirectiveResponse, error) {
+	m := new(ExecDirectiveResponse)
+	if err := x.MsgRecv(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *srpcControllerBusService_ExecDirectiveClient) RecvTo(m *ExecDirectiveResponse) error {
+	return x.MsgRecv(m)
+}
+
+func (c *srpcControllerBusServiceClient) ServeDirectives(ctx context.Context) (SRPCControllerBusService_ServeDirectivesClient, error) {
+	stream, err := c.cc.NewStream(ctx, c.serviceID, "ServeDirectives", nil)
+	if err != nil {
+		return nil, err
+	}
+	strm := &srpcControllerBusService_ServeDirectivesClient{stream}
+	return strm, nil
+}
+
+type SRPCControllerBusService_ServeDirectivesClient interface {
+	srpc.Stream
+	Send(*ServeDirectivesRequest) error
+	Recv() (*ServeDirectivesResponse, error)
+	RecvTo(*ServeDirectivesResponse) error
+}
+
+type srpcControllerBusService_ServeDirectivesClient struct {
+	srpc.Stream
+}
+
+func (x *srpcControllerBusService_ServeDirectivesClient) Send(m *ServeDirectivesRequest) error {
+	if m == nil {
+		return nil
+	}
+	return x.MsgSend(m)
+}
+
+func (x *srpcControllerBusService_ServeDirectivesClient) Recv() (*ServeDirectivesResponse, error) {
+	m := new(ServeDirectivesResponse)
+	if err := x.MsgRecv(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *srpcControllerBusService_ServeDirectivesClient) RecvTo(m *ServeDirectivesResponse) error {
+	return x.MsgRecv(m)
+}
+
 type SRPCControllerBusServiceServer interface {
 	// GetBusInfo requests information about the controller bus.
 	GetBusInfo(context.Context, *GetBusInfoRequest) (*GetBusInfoResponse, error)
+	// GetDirectiveInfo requests the state and values of a directive.
+	GetDirectiveInfo(context.Context, *GetDirectiveInfoRequest) (*GetDirectiveInfoResponse, error)
+	// ListFactories lists the controller factories available to the bus.
+	ListFactories(context.Context, *ListFactoriesRequest) (*ListFactoriesResponse, error)
+	// GetHealth returns the liveness and readiness of the bus.
+	// Readiness is derived from the state of the configset controllers.
+	GetHealth(context.Context, *GetHealthRequest) (*GetHealthResponse, error)
+	// PlanConfigSet computes the changes to the configset controllers if the
+	// configset was applied without applying it.
+	PlanConfigSet(context.Context, *PlanConfigSetRequest) (*PlanConfigSetResponse, error)
+	// GetConfigSetHistory returns the revision history of a stored configset.
+	GetConfigSetHistory(context.Context, *GetConfigSetHistoryRequest) (*GetConfigSetHistoryResponse, error)
+	// PutConfigSet stores a configset as a new revision of a key and applies it
+	// in place of the previous revision. Stored configsets are applied again
+	// when the daemon restarts.
+	PutConfigSet(context.Context, *PutConfigSetRequest) (*PutConfigSetResponse, error)
+	// RollbackConfigSet stores a copy of an earlier revision of a stored
+	// configset as a new revision and applies it.
+	RollbackConfigSet(context.Context, *RollbackConfigSetRequest) (*RollbackConfigSetResponse, error)
+	// WatchBusInfo streams a snapshot of the controller bus followed by
+	// controller and directive events.
+	WatchBusInfo(*WatchBusInfoRequest, SRPCControllerBusService_WatchBusInfoStream) error
 	// ExecController executes a controller configuration on the bus.
 	ExecController(*controller_exec.ExecControllerRequest, SRPCControllerBusService_ExecControllerStream) error
+	// StopController stops a configset controller and releases the configset
+	// references to it. It is started again if the configset is re-applied.
+	StopController(context.Context, *StopControllerRequest) (*StopControllerResponse, error)
+	// RestartController restarts a configset controller with the current config.
+	// Clears the quarantine if the controller was quarantined.
+	RestartController(context.Context, *RestartControllerRequest) (*RestartControllerResponse, error)
+	// RemoveController stops a configset controller and releases all configset
+	// references to it, including persistent references.
+	RemoveController(context.Context, *RemoveControllerRequest) (*RemoveControllerResponse, error)
+	// ExecDirective executes a networked directive on the bus.
+	// Streams value events until the request is canceled.
+	ExecDirective(*ExecDirectiveRequest, SRPCControllerBusService_ExecDirectiveStream) error
+	// ServeDirectives registers the caller as a handler for networked directives.
+	// The handler and its values are removed when the stream closes.
+	ServeDirectives(SRPCControllerBusService_ServeDirectivesStream) error
 }
 
 const SRPCControllerBusServiceServiceID = "bus.api.ControllerBusService"
@@ -116,7 +387,20 @@ func (d *SRPCControllerBusServiceHandler) GetServiceID() string { return d.servi
 func (SRPCControllerBusServiceHandler) GetMethodIDs() []string {
 	return []string{
 		"GetBusInfo",
+		"GetDirectiveInfo",
+		"ListFactories",
+		"GetHealth",
+		"PlanConfigSet",
+		"GetConfigSetHistory",
+		"PutConfigSet",
+		"RollbackConfigSet",
+		"WatchBusInfo",
 		"ExecController",
+		"StopController",
+		"RestartController",
+		"RemoveController",
+		"ExecDirective",
+		"ServeDirectives",
 	}
 }
 
@@ -131,8 +415,34 @@ func (d *SRPCControllerBusServiceHandler) InvokeMethod(
 	switch methodID {
 	case "GetBusInfo":
 		return true, d.InvokeMethod_GetBusInfo(d.impl, strm)
+	case "GetDirectiveInfo":
+		return true, d.InvokeMethod_GetDirectiveInfo(d.impl, strm)
+	case "ListFactories":
+		return true, d.InvokeMethod_ListFactories(d.impl, strm)
+	case "GetHealth":
+		return true, d.InvokeMethod_GetHealth(d.impl, strm)
+	case "PlanConfigSet":
+		return true, d.InvokeMethod_PlanConfigSet(d.impl, strm)
+	case "GetConfigSetHistory":
+		return true, d.InvokeMethod_GetConfigSetHistory(d.impl, strm)
+	case "PutConfigSet":
+		return true, d.InvokeMethod_PutConfigSet(d.impl, strm)
+	case "RollbackConfigSet":
+		return true, d.InvokeMethod_RollbackConfigSet(d.impl, strm)
+	case "WatchBusInfo":
+		return true, d.InvokeMethod_WatchBusInfo(d.impl, strm)
 	case "ExecController":
 		return true, d.InvokeMethod_ExecController(d.impl, strm)
+	case "StopController":
+		return true, d.InvokeMethod_StopController(d.impl, strm)
+	case "RestartController":
+		return true, d.InvokeMethod_RestartController(d.impl, strm)
+	case "RemoveController":
+		return true, d.InvokeMethod_RemoveController(d.impl, strm)
+	case "ExecDirective":
+		return true, d.InvokeMethod_ExecDirective(d.impl, strm)
+	case "ServeDirectives":
+		return true, d.InvokeMethod_ServeDirectives(d.impl, strm)
 	default:
 		return false, nil
 	}
@@ -150,6 +460,99 @@ func (SRPCControllerBusServiceHandler) InvokeMethod_GetBusInfo(impl SRPCControll
 	return strm.MsgSend(out)
 }
 
+func (SRPCControllerBusServiceHandler) InvokeMethod_GetDirectiveInfo(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(GetDirectiveInfoRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.GetDirectiveInfo(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_ListFactories(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(ListFactoriesRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.ListFactories(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_GetHealth(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(GetHealthRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.GetHealth(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_PlanConfigSet(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(PlanConfigSetRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.PlanConfigSet(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_GetConfigSetHistory(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(GetConfigSetHistoryRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.GetConfigSetHistory(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_PutConfigSet(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(PutConfigSetRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.PutConfigSet(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_RollbackConfigSet(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(RollbackConfigSetRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.RollbackConfigSet(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_WatchBusInfo(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(WatchBusInfoRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	serverStrm := &srpcControllerBusService_WatchBusInfoStream{strm}
+	return impl.WatchBusInfo(req, serverStrm)
+}
+
 func (SRPCControllerBusServiceHandler) InvokeMethod_ExecController(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
 	req := new(controller_exec.ExecControllerRequest)
 	if err := strm.MsgRecv(req); err != nil {
@@ -159,6 +562,56 @@ func (SRPCControllerBusServiceHandler) InvokeMethod_ExecController(impl SRPCCont
 	return impl.ExecController(req, serverStrm)
 }
 
+func (SRPCControllerBusServiceHandler) InvokeMethod_StopController(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(StopControllerRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.StopController(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_RestartController(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(RestartControllerRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.RestartController(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_RemoveController(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(RemoveControllerRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	out, err := impl.RemoveController(strm.Context(), req)
+	if err != nil {
+		return err
+	}
+	return strm.MsgSend(out)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_ExecDirective(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	req := new(ExecDirectiveRequest)
+	if err := strm.MsgRecv(req); err != nil {
+		return err
+	}
+	serverStrm := &srpcControllerBusService_ExecDirectiveStream{strm}
+	return impl.ExecDirective(req, serverStrm)
+}
+
+func (SRPCControllerBusServiceHandler) InvokeMethod_ServeDirectives(impl SRPCControllerBusServiceServer, strm srpc.Stream) error {
+	clientStrm := &srpcControllerBusService_ServeDirectivesStream{strm}
+	return impl.ServeDirectives(clientStrm)
+}
+
 type SRPCControllerBusService_GetBusInfoStream interface {
 	srpc.Stream
 }
@@ -167,6 +620,85 @@ type srpcControllerBusService_GetBusInfoStream struct {
 	srpc.Stream
 }
 
+type SRPCControllerBusService_GetDirectiveInfoStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_GetDirectiveInfoStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_ListFactoriesStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_ListFactoriesStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_GetHealthStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_GetHealthStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_PlanConfigSetStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_PlanConfigSetStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_GetConfigSetHistoryStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_GetConfigSetHistoryStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_PutConfigSetStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_PutConfigSetStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_RollbackConfigSetStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_RollbackConfigSetStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_WatchBusInfoStream interface {
+	srpc.Stream
+	Send(*WatchBusInfoResponse) error
+	SendAndClose(*WatchBusInfoResponse) error
+}
+
+type srpcControllerBusService_WatchBusInfoStream struct {
+	srpc.Stream
+}
+
+func (x *srpcControllerBusService_WatchBusInfoStream) Send(m *WatchBusInfoResponse) error {
+	return x.MsgSend(m)
+}
+
+func (x *srpcControllerBusService_WatchBusInfoStream) SendAndClose(m *WatchBusInfoResponse) error {
+	if m != nil {
+		if err := x.MsgSend(m); err != nil {
+			return err
+		}
+	}
+	return x.CloseSend()
+}
+
 type SRPCControllerBusService_ExecControllerStream interface {
 	srpc.Stream
 	Send(*controller_exec.ExecControllerResponse) error
@@ -189,3 +721,87 @@ func (x *srpcControllerBusService_ExecControllerStream) SendAndClose(m *controll
 	}
 	return x.CloseSend()
 }
+
+type SRPCControllerBusService_StopControllerStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_StopControllerStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_RestartControllerStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_RestartControllerStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_RemoveControllerStream interface {
+	srpc.Stream
+}
+
+type srpcControllerBusService_RemoveControllerStream struct {
+	srpc.Stream
+}
+
+type SRPCControllerBusService_ExecDirectiveStream interface {
+	srpc.Stream
+	Send(*ExecDirectiveResponse) error
+	SendAndClose(*ExecDirectiveResponse) error
+}
+
+type srpcControllerBusService_ExecDirectiveStream struct {
+	srpc.Stream
+}
+
+func (x *srpcControllerBusService_ExecDirectiveStream) Send(m *ExecDirectiveResponse) error {
+	return x.MsgSend(m)
+}
+
+func (x *srpcControllerBusService_ExecDirectiveStream) SendAndClose(m *ExecDirectiveResponse) error {
+	if m != nil {
+		if err := x.MsgSend(m); err != nil {
+			return err
+		}
+	}
+	return x.CloseSend()
+}
+
+type SRPCControllerBusService_ServeDirectivesStream interface {
+	srpc.Stream
+	Send(*ServeDirectivesResponse) error
+	SendAndClose(*ServeDirectivesResponse) error
+	Recv() (*ServeDirectivesRequest, error)
+	RecvTo(*ServeDirectivesRequest) error
+}
+
+type srpcControllerBusService_ServeDirectivesStream struct {
+	srpc.Stream
+}
+
+func (x *srpcControllerBusService_ServeDirectivesStream) Send(m *ServeDirectivesResponse) error {
+	return x.MsgSend(m)
+}
+
+func (x *srpcControllerBusService_ServeDirectivesStream) SendAndClose(m *ServeDirectivesResponse) error {
+	if m != nil {
+		if err := x.MsgSend(m); err != nil {
+			return err
+		}
+	}
+	return x.CloseSend()
+}
+
+func (x *srpcControllerBusService_ServeDirectivesStream) Recv() (*ServeDirectivesRequest, error) {
+	m := new(ServeDirectivesRequest)
+	if err := x.MsgRecv(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *srpcControllerBusService_ServeDirectivesStream) RecvTo(m *ServeDirectivesRequest) error {
+	return x.MsgRecv(m)
+}
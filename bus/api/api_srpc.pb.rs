@@ -8,6 +8,17 @@ use starpc::StreamExt;
 /// Service ID for ControllerBusService.
 pub const CONTROLLER_BUS_SERVICE_SERVICE_ID: &str = "bus.api.ControllerBusService";
 
+/// Stream trait for ControllerBusService.WatchBusInfo.
+#[starpc::async_trait]
+pub trait ControllerBusServiceWatchBusInfoStream: Send + Sync {
+    /// Returns the context for this stream.
+    fn context(&self) -> &starpc::Context;
+    /// Receives a message from the stream.
+    async fn recv(&self) -> starpc::Result<WatchBusInfoResponse>;
+    /// Closes the stream.
+    async fn close(&self) -> starpc::Result<()>;
+}
+
 /// Stream trait for ControllerBusService.ExecController.
 #[starpc::async_trait]
 pub trait ControllerBusServiceExecControllerStream: Send + Sync {
@@ -19,13 +30,65 @@ pub trait ControllerBusServiceExecControllerStream: Send + Sync {
     async fn close(&self) -> starpc::Result<()>;
 }
 
+/// Stream trait for ControllerBusService.ExecDirective.
+#[starpc::async_trait]
+pub trait ControllerBusServiceExecDirectiveStream: Send + Sync {
+    /// Returns the context for this stream.
+    fn context(&self) -> &starpc::Context;
+    /// Receives a message from the stream.
+    async fn recv(&self) -> starpc::Result<ExecDirectiveResponse>;
+    /// Closes the stream.
+    async fn close(&self) -> starpc::Result<()>;
+}
+
+/// Stream trait for ControllerBusService.ServeDirectives.
+#[starpc::async_trait]
+pub trait ControllerBusServiceServeDirectivesStream: Send + Sync {
+    /// Returns the context for this stream.
+    fn context(&self) -> &starpc::Context;
+    /// Sends a message on the stream.
+    async fn send(&self, msg: &ServeDirectivesRequest) -> starpc::Result<()>;
+    /// Receives a message from the stream.
+    async fn recv(&self) -> starpc::Result<ServeDirectivesResponse>;
+    /// Closes the send side of the stream.
+    async fn close_send(&self) -> starpc::Result<()>;
+    /// Closes the stream.
+    async fn close(&self) -> starpc::Result<()>;
+}
+
 /// Client trait for ControllerBusService.
 #[starpc::async_trait]
 pub trait ControllerBusServiceClient: Send + Sync {
     /// GetBusInfo.
     async fn get_bus_info(&self, request: &GetBusInfoRequest) -> starpc::Result<GetBusInfoResponse>;
+    /// GetDirectiveInfo.
+    async fn get_directive_info(&self, request: &GetDirectiveInfoRequest) -> starpc::Result<GetDirectiveInfoResponse>;
+    /// ListFactories.
+    async fn list_factories(&self, request: &ListFactoriesRequest) -> starpc::Result<ListFactoriesResponse>;
+    /// GetHealth.
+    async fn get_health(&self, request: &GetHealthRequest) -> starpc::Result<GetHealthResponse>;
+    /// PlanConfigSet.
+    async fn plan_config_set(&self, request: &PlanConfigSetRequest) -> starpc::Result<PlanConfigSetResponse>;
+    /// GetConfigSetHistory.
+    async fn get_config_set_history(&self, request: &GetConfigSetHistoryRequest) -> starpc::Result<GetConfigSetHistoryResponse>;
+    /// PutConfigSet.
+    async fn put_config_set(&self, request: &PutConfigSetRequest) -> starpc::Result<PutConfigSetResponse>;
+    /// RollbackConfigSet.
+    async fn rollback_config_set(&self, request: &RollbackConfigSetRequest) -> starpc::Result<RollbackConfigSetResponse>;
+    /// WatchBusInfo.
+    async fn watch_bus_info(&self, request: &WatchBusInfoRequest) -> starpc::Result<Box<dyn ControllerBusServiceWatchBusInfoStream>>;
     /// ExecController.
     async fn exec_controller(&self, request: &ExecControllerRequest) -> starpc::Result<Box<dyn ControllerBusServiceExecControllerStream>>;
+    /// StopController.
+    async fn stop_controller(&self, request: &StopControllerRequest) -> starpc::Result<StopControllerResponse>;
+    /// RestartController.
+    async fn restart_controller(&self, request: &RestartControllerRequest) -> starpc::Result<RestartControllerResponse>;
+    /// RemoveController.
+    async fn remove_controller(&self, request: &RemoveControllerRequest) -> starpc::Result<RemoveControllerResponse>;
+    /// ExecDirective.
+    async fn exec_directive(&self, request: &ExecDirectiveRequest) -> starpc::Result<Box<dyn ControllerBusServiceExecDirectiveStream>>;
+    /// ServeDirectives.
+    async fn serve_directives(&self) -> starpc::Result<Box<dyn ControllerBusServiceServeDirectivesStream>>;
 }
 
 /// Client implementation for ControllerBusService.
@@ -45,6 +108,34 @@ impl<C: starpc::Client + 'static> ControllerBusServiceClient for ControllerBusSe
     async fn get_bus_info(&self, request: &GetBusInfoRequest) -> starpc::Result<GetBusInfoResponse> {
         self.client.exec_call("bus.api.ControllerBusService", "GetBusInfo", request).await
     }
+    async fn get_directive_info(&self, request: &GetDirectiveInfoRequest) -> starpc::Result<GetDirectiveInfoResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "GetDirectiveInfo", request).await
+    }
+    async fn list_factories(&self, request: &ListFactoriesRequest) -> starpc::Result<ListFactoriesResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "ListFactories", request).await
+    }
+    async fn get_health(&self, request: &GetHealthRequest) -> starpc::Result<GetHealthResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "GetHealth", request).await
+    }
+    async fn plan_config_set(&self, request: &PlanConfigSetRequest) -> starpc::Result<PlanConfigSetResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "PlanConfigSet", request).await
+    }
+    async fn get_config_set_history(&self, request: &GetConfigSetHistoryRequest) -> starpc::Result<GetConfigSetHistoryResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "GetConfigSetHistory", request).await
+    }
+    async fn put_config_set(&self, request: &PutConfigSetRequest) -> starpc::Result<PutConfigSetResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "PutConfigSet", request).await
+    }
+    async fn rollback_config_set(&self, request: &RollbackConfigSetRequest) -> starpc::Result<RollbackConfigSetResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "RollbackConfigSet", request).await
+    }
+    async fn watch_bus_info(&self, request: &WatchBusInfoRequest) -> starpc::Result<Box<dyn ControllerBusServiceWatchBusInfoStream>> {
+        use starpc::ProstMessage;
+        let data = request.encode_to_vec();
+        let stream = self.client.new_stream("bus.api.ControllerBusService", "WatchBusInfo", Some(&data)).await?;
+        stream.close_send().await?;
+        Ok(Box::new(ControllerBusServiceWatchBusInfoStreamImpl { stream }))
+    }
     async fn exec_controller(&self, request: &ExecControllerRequest) -> starpc::Result<Box<dyn ControllerBusServiceExecControllerStream>> {
         use starpc::ProstMessage;
         let data = request.encode_to_vec();
@@ -52,6 +143,43 @@ impl<C: starpc::Client + 'static> ControllerBusServiceClient for ControllerBusSe
         stream.close_send().await?;
         Ok(Box::new(ControllerBusServiceExecControllerStreamImpl { stream }))
     }
+    async fn stop_controller(&self, request: &StopControllerRequest) -> starpc::Result<StopControllerResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "StopController", request).await
+    }
+    async fn restart_controller(&self, request: &RestartControllerRequest) -> starpc::Result<RestartControllerResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "RestartController", request).await
+    }
+    async fn remove_controller(&self, request: &RemoveControllerRequest) -> starpc::Result<RemoveControllerResponse> {
+        self.client.exec_call("bus.api.ControllerBusService", "RemoveController", request).await
+    }
+    async fn exec_directive(&self, request: &ExecDirectiveRequest) -> starpc::Result<Box<dyn ControllerBusServiceExecDirectiveStream>> {
+        use starpc::ProstMessage;
+        let data = request.encode_to_vec();
+        let stream = self.client.new_stream("bus.api.ControllerBusService", "ExecDirective", Some(&data)).await?;
+        stream.close_send().await?;
+        Ok(Box::new(ControllerBusServiceExecDirectiveStreamImpl { stream }))
+    }
+    async fn serve_directives(&self) -> starpc::Result<Box<dyn ControllerBusServiceServeDirectivesStream>> {
+        let stream = self.client.new_stream("bus.api.ControllerBusService", "ServeDirectives", None).await?;
+        Ok(Box::new(ControllerBusServiceServeDirectivesStreamImpl { stream }))
+    }
+}
+
+struct ControllerBusServiceWatchBusInfoStreamImpl {
+    stream: Box<dyn starpc::Stream>,
+}
+
+#[starpc::async_trait]
+impl ControllerBusServiceWatchBusInfoStream for ControllerBusServiceWatchBusInfoStreamImpl {
+    fn context(&self) -> &starpc::Context {
+        self.stream.context()
+    }
+    async fn recv(&self) -> starpc::Result<WatchBusInfoResponse> {
+        self.stream.msg_recv().await
+    }
+    async fn close(&self) -> starpc::Result<()> {
+        self.stream.close().await
+    }
 }
 
 struct ControllerBusServiceExecControllerStreamImpl {
@@ -71,18 +199,97 @@ impl ControllerBusServiceExecControllerStream for ControllerBusServiceExecContro
     }
 }
 
+struct ControllerBusServiceExecDirectiveStreamImpl {
+    stream: Box<dyn starpc::Stream>,
+}
+
+#[starpc::async_trait]
+impl ControllerBusServiceExecDirectiveStream for ControllerBusServiceExecDirectiveStreamImpl {
+    fn context(&self) -> &starpc::Context {
+        self.stream.context()
+    }
+    async fn recv(&self) -> starpc::Result<ExecDirectiveResponse> {
+        self.stream.msg_recv().await
+    }
+    async fn close(&self) -> starpc::Result<()> {
+        self.stream.close().await
+    }
+}
+
+struct ControllerBusServiceServeDirectivesStreamImpl {
+    stream: Box<dyn starpc::Stream>,
+}
+
+#[starpc::async_trait]
+impl ControllerBusServiceServeDirectivesStream for ControllerBusServiceServeDirectivesStreamImpl {
+    fn context(&self) -> &starpc::Context {
+        self.stream.context()
+    }
+    async fn send(&self, msg: &ServeDirectivesRequest) -> starpc::Result<()> {
+        self.stream.msg_send(msg).await
+    }
+    async fn recv(&self) -> starpc::Result<ServeDirectivesResponse> {
+        self.stream.msg_recv().await
+    }
+    async fn close_send(&self) -> starpc::Result<()> {
+        self.stream.close_send().await
+    }
+    async fn close(&self) -> starpc::Result<()> {
+        self.stream.close().await
+    }
+}
+
 /// Server trait for ControllerBusService.
 #[starpc::async_trait]
 pub trait ControllerBusServiceServer: Send + Sync {
     /// GetBusInfo.
     async fn get_bus_info(&self, request: GetBusInfoRequest) -> starpc::Result<GetBusInfoResponse>;
+    /// GetDirectiveInfo.
+    async fn get_directive_info(&self, request: GetDirectiveInfoRequest) -> starpc::Result<GetDirectiveInfoResponse>;
+    /// ListFactories.
+    async fn list_factories(&self, request: ListFactoriesRequest) -> starpc::Result<ListFactoriesResponse>;
+    /// GetHealth.
+    async fn get_health(&self, request: GetHealthRequest) -> starpc::Result<GetHealthResponse>;
+    /// PlanConfigSet.
+    async fn plan_config_set(&self, request: PlanConfigSetRequest) -> starpc::Result<PlanConfigSetResponse>;
+    /// GetConfigSetHistory.
+    async fn get_config_set_history(&self, request: GetConfigSetHistoryRequest) -> starpc::Result<GetConfigSetHistoryResponse>;
+    /// PutConfigSet.
+    async fn put_config_set(&self, request: PutConfigSetRequest) -> starpc::Result<PutConfigSetResponse>;
+    /// RollbackConfigSet.
+    async fn rollback_config_set(&self, request: RollbackConfigSetRequest) -> starpc::Result<RollbackConfigSetResponse>;
+    /// WatchBusInfo.
+    async fn watch_bus_info(&self, request: WatchBusInfoRequest, stream: Box<dyn starpc::Stream>) -> starpc::Result<()>;
     /// ExecController.
     async fn exec_controller(&self, request: ExecControllerRequest, stream: Box<dyn starpc::Stream>) -> starpc::Result<()>;
+    /// StopController.
+    async fn stop_controller(&self, request: StopControllerRequest) -> starpc::Result<StopControllerResponse>;
+    /// RestartController.
+    async fn restart_controller(&self, request: RestartControllerRequest) -> starpc::Result<RestartControllerResponse>;
+    /// RemoveController.
+    async fn remove_controller(&self, request: RemoveControllerRequest) -> starpc::Result<RemoveControllerResponse>;
+    /// ExecDirective.
+    async fn exec_directive(&self, request: ExecDirectiveRequest, stream: Box<dyn starpc::Stream>) -> starpc::Result<()>;
+    /// ServeDirectives.
+    async fn serve_directives(&self, stream: Box<dyn starpc::Stream>) -> starpc::Result<()>;
 }
 
 const CONTROLLER_BUS_SERVICE_METHOD_IDS: &[&str] = &[
     "GetBusInfo",
+    "GetDirectiveInfo",
+    "ListFactories",
+    "GetHealth",
+    "PlanConfigSet",
+    "GetConfigSetHistory",
+    "PutConfigSet",
+    "RollbackConfigSet",
+    "WatchBusInfo",
     "ExecController",
+    "StopController",
+    "RestartController",
+    "RemoveController",
+    "ExecDirective",
+    "ServeDirectives",
 ];
 
 /// Handler for ControllerBusService.
@@ -126,6 +333,118 @@ impl<S: ControllerBusServiceServer + 'static> starpc::Invoker for ControllerBusS
                     Err(e) => (true, Err(e)),
                 }
             }
+            "GetDirectiveInfo" => {
+                let request: GetDirectiveInfoRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.get_directive_info(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "ListFactories" => {
+                let request: ListFactoriesRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.list_factories(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "GetHealth" => {
+                let request: GetHealthRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.get_health(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "PlanConfigSet" => {
+                let request: PlanConfigSetRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.plan_config_set(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "GetConfigSetHistory" => {
+                let request: GetConfigSetHistoryRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.get_config_set_history(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "PutConfigSet" => {
+                let request: PutConfigSetRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.put_config_set(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "RollbackConfigSet" => {
+                let request: RollbackConfigSetRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.rollback_config_set(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "WatchBusInfo" => {
+                let request: WatchBusInfoRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                (true, self.server.watch_bus_info(request, stream).await)
+            }
             "ExecController" => {
                 let request: ExecControllerRequest = match stream.msg_recv().await {
                     Ok(r) => r,
@@ -133,6 +452,61 @@ impl<S: ControllerBusServiceServer + 'static> starpc::Invoker for ControllerBusS
                 };
                 (true, self.server.exec_controller(request, stream).await)
             }
+            "StopController" => {
+                let request: StopControllerRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.stop_controller(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "RestartController" => {
+                let request: RestartControllerRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.restart_controller(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "RemoveController" => {
+                let request: RemoveControllerRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                match self.server.remove_controller(request).await {
+                    Ok(response) => {
+                        if let Err(e) = stream.msg_send(&response).await {
+                            return (true, Err(e));
+                        }
+                        (true, Ok(()))
+                    }
+                    Err(e) => (true, Err(e)),
+                }
+            }
+            "ExecDirective" => {
+                let request: ExecDirectiveRequest = match stream.msg_recv().await {
+                    Ok(r) => r,
+                    Err(e) => return (true, Err(e)),
+                };
+                (true, self.server.exec_directive(request, stream).await)
+            }
+            "ServeDirectives" => {
+                (true, self.server.serve_directives(stream).await)
+            }
             _ => (false, Err(starpc::Error::Unimplemented)),
         }
     }
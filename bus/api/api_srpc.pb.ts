@@ -2,13 +2,47 @@
 // @generated from file github.com/aperturerobotics/controllerbus/bus/api/api.proto (package bus.api, syntax proto3)
 /* eslint-disable */
 
-import { GetBusInfoRequest, GetBusInfoResponse } from './api.pb.js'
+import {
+  ExecDirectiveRequest,
+  ExecDirectiveResponse,
+  GetBusInfoRequest,
+  GetBusInfoResponse,
+  GetConfigSetHistoryRequest,
+  GetConfigSetHistoryResponse,
+  GetDirectiveInfoRequest,
+  GetDirectiveInfoResponse,
+  GetHealthRequest,
+  GetHealthResponse,
+  ListFactoriesRequest,
+  ListFactoriesResponse,
+  PlanConfigSetRequest,
+  PlanConfigSetResponse,
+  PutConfigSetRequest,
+  PutConfigSetResponse,
+  RemoveControllerRequest,
+  RemoveControllerResponse,
+  RestartControllerRequest,
+  RestartControllerResponse,
+  RollbackConfigSetRequest,
+  RollbackConfigSetResponse,
+  ServeDirectivesRequest,
+  ServeDirectivesResponse,
+  StopControllerRequest,
+  StopControllerResponse,
+  WatchBusInfoRequest,
+  WatchBusInfoResponse,
+} from './api.pb.js'
 import { MethodKind } from '@aptre/protobuf-es-lite'
 import {
   ExecControllerRequest,
   ExecControllerResponse,
 } from '../../controller/exec/exec.pb.js'
-import { buildDecodeMessageTransform, MessageStream, ProtoRpc } from 'starpc'
+import {
+  buildDecodeMessageTransform,
+  buildEncodeMessageTransform,
+  MessageStream,
+  ProtoRpc,
+} from 'starpc'
 
 /**
  * ControllerBusService is a generic controller bus lookup api.
@@ -29,6 +63,100 @@ export const ControllerBusServiceDefinition = {
       O: GetBusInfoResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetDirectiveInfo requests the state and values of a directive.
+     *
+     * @generated from rpc bus.api.ControllerBusService.GetDirectiveInfo
+     */
+    GetDirectiveInfo: {
+      name: 'GetDirectiveInfo',
+      I: GetDirectiveInfoRequest,
+      O: GetDirectiveInfoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListFactories lists the controller factories available to the bus.
+     *
+     * @generated from rpc bus.api.ControllerBusService.ListFactories
+     */
+    ListFactories: {
+      name: 'ListFactories',
+      I: ListFactoriesRequest,
+      O: ListFactoriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetHealth returns the liveness and readiness of the bus.
+     * Readiness is derived from the state of the configset controllers.
+     *
+     * @generated from rpc bus.api.ControllerBusService.GetHealth
+     */
+    GetHealth: {
+      name: 'GetHealth',
+      I: GetHealthRequest,
+      O: GetHealthResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * PlanConfigSet computes the changes to the configset controllers if the
+     * configset was applied without applying it.
+     *
+     * @generated from rpc bus.api.ControllerBusService.PlanConfigSet
+     */
+    PlanConfigSet: {
+      name: 'PlanConfigSet',
+      I: PlanConfigSetRequest,
+      O: PlanConfigSetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetConfigSetHistory returns the revision history of a stored configset.
+     *
+     * @generated from rpc bus.api.ControllerBusService.GetConfigSetHistory
+     */
+    GetConfigSetHistory: {
+      name: 'GetConfigSetHistory',
+      I: GetConfigSetHistoryRequest,
+      O: GetConfigSetHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * PutConfigSet stores a configset as a new revision of a key and applies it
+     * in place of the previous revision. Stored configsets are applied again
+     * when the daemon restarts.
+     *
+     * @generated from rpc bus.api.ControllerBusService.PutConfigSet
+     */
+    PutConfigSet: {
+      name: 'PutConfigSet',
+      I: PutConfigSetRequest,
+      O: PutConfigSetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RollbackConfigSet stores a copy of an earlier revision of a stored
+     * configset as a new revision and applies it.
+     *
+     * @generated from rpc bus.api.ControllerBusService.RollbackConfigSet
+     */
+    RollbackConfigSet: {
+      name: 'RollbackConfigSet',
+      I: RollbackConfigSetRequest,
+      O: RollbackConfigSetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * WatchBusInfo streams a snapshot of the controller bus followed by
+     * controller and directive events.
+     *
+     * @generated from rpc bus.api.ControllerBusService.WatchBusInfo
+     */
+    WatchBusInfo: {
+      name: 'WatchBusInfo',
+      I: WatchBusInfoRequest,
+      O: WatchBusInfoResponse,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * ExecController executes a controller configuration on the bus.
      *
@@ -40,6 +168,66 @@ export const ControllerBusServiceDefinition = {
       O: ExecControllerResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * StopController stops a configset controller and releases the configset
+     * references to it. It is started again if the configset is re-applied.
+     *
+     * @generated from rpc bus.api.ControllerBusService.StopController
+     */
+    StopController: {
+      name: 'StopController',
+      I: StopControllerRequest,
+      O: StopControllerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RestartController restarts a configset controller with the current config.
+     * Clears the quarantine if the controller was quarantined.
+     *
+     * @generated from rpc bus.api.ControllerBusService.RestartController
+     */
+    RestartController: {
+      name: 'RestartController',
+      I: RestartControllerRequest,
+      O: RestartControllerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveController stops a configset controller and releases all configset
+     * references to it, including persistent references.
+     *
+     * @generated from rpc bus.api.ControllerBusService.RemoveController
+     */
+    RemoveController: {
+      name: 'RemoveController',
+      I: RemoveControllerRequest,
+      O: RemoveControllerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ExecDirective executes a networked directive on the bus.
+     * Streams value events until the request is canceled.
+     *
+     * @generated from rpc bus.api.ControllerBusService.ExecDirective
+     */
+    ExecDirective: {
+      name: 'ExecDirective',
+      I: ExecDirectiveRequest,
+      O: ExecDirectiveResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * ServeDirectives registers the caller as a handler for networked directives.
+     * The handler and its values are removed when the stream closes.
+     *
+     * @generated from rpc bus.api.ControllerBusService.ServeDirectives
+     */
+    ServeDirectives: {
+      name: 'ServeDirectives',
+      I: ServeDirectivesRequest,
+      O: ServeDirectivesResponse,
+      kind: MethodKind.BiDiStreaming,
+    },
   },
 } as const
 
@@ -59,6 +247,92 @@ export interface ControllerBusService {
     abortSignal?: AbortSignal,
   ): Promise<GetBusInfoResponse>
 
+  /**
+   * GetDirectiveInfo requests the state and values of a directive.
+   *
+   * @generated from rpc bus.api.ControllerBusService.GetDirectiveInfo
+   */
+  GetDirectiveInfo(
+    request: GetDirectiveInfoRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetDirectiveInfoResponse>
+
+  /**
+   * ListFactories lists the controller factories available to the bus.
+   *
+   * @generated from rpc bus.api.ControllerBusService.ListFactories
+   */
+  ListFactories(
+    request: ListFactoriesRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<ListFactoriesResponse>
+
+  /**
+   * GetHealth returns the liveness and readiness of the bus.
+   * Readiness is derived from the state of the configset controllers.
+   *
+   * @generated from rpc bus.api.ControllerBusService.GetHealth
+   */
+  GetHealth(
+    request: GetHealthRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetHealthResponse>
+
+  /**
+   * PlanConfigSet computes the changes to the configset controllers if the
+   * configset was applied without applying it.
+   *
+   * @generated from rpc bus.api.ControllerBusService.PlanConfigSet
+   */
+  PlanConfigSet(
+    request: PlanConfigSetRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<PlanConfigSetResponse>
+
+  /**
+   * GetConfigSetHistory returns the revision history of a stored configset.
+   *
+   * @generated from rpc bus.api.ControllerBusService.GetConfigSetHistory
+   */
+  GetConfigSetHistory(
+    request: GetConfigSetHistoryRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetConfigSetHistoryResponse>
+
+  /**
+   * PutConfigSet stores a configset as a new revision of a key and applies it
+   * in place of the previous revision. Stored configsets are applied again
+   * when the daemon restarts.
+   *
+   * @generated from rpc bus.api.ControllerBusService.PutConfigSet
+   */
+  PutConfigSet(
+    request: PutConfigSetRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<PutConfigSetResponse>
+
+  /**
+   * RollbackConfigSet stores a copy of an earlier revision of a stored
+   * configset as a new revision and applies it.
+   *
+   * @generated from rpc bus.api.ControllerBusService.RollbackConfigSet
+   */
+  RollbackConfigSet(
+    request: RollbackConfigSetRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<RollbackConfigSetResponse>
+
+  /**
+   * WatchBusInfo streams a snapshot of the controller bus followed by
+   * controller and directive events.
+   *
+   * @generated from rpc bus.api.ControllerBusService.WatchBusInfo
+   */
+  WatchBusInfo(
+    request: WatchBusInfoRequest,
+    abortSignal?: AbortSignal,
+  ): MessageStream<WatchBusInfoResponse>
+
   /**
    * ExecController executes a controller configuration on the bus.
    *
@@ -68,6 +342,61 @@ export interface ControllerBusService {
     request: ExecControllerRequest,
     abortSignal?: AbortSignal,
   ): MessageStream<ExecControllerResponse>
+
+  /**
+   * StopController stops a configset controller and releases the configset
+   * references to it. It is started again if the configset is re-applied.
+   *
+   * @generated from rpc bus.api.ControllerBusService.StopController
+   */
+  StopController(
+    request: StopControllerRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<StopControllerResponse>
+
+  /**
+   * RestartController restarts a configset controller with the current config.
+   * Clears the quarantine if the controller was quarantined.
+   *
+   * @generated from rpc bus.api.ControllerBusService.RestartController
+   */
+  RestartController(
+    request: RestartControllerRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<RestartControllerResponse>
+
+  /**
+   * RemoveController stops a configset controller and releases all configset
+   * references to it, including persistent references.
+   *
+   * @generated from rpc bus.api.ControllerBusService.RemoveController
+   */
+  RemoveController(
+    request: RemoveControllerRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<RemoveControllerResponse>
+
+  /**
+   * ExecDirective executes a networked directive on the bus.
+   * Streams value events until the request is canceled.
+   *
+   * @generated from rpc bus.api.ControllerBusService.ExecDirective
+   */
+  ExecDirective(
+    request: ExecDirectiveRequest,
+    abortSignal?: AbortSignal,
+  ): MessageStream<ExecDirectiveResponse>
+
+  /**
+   * ServeDirectives registers the caller as a handler for networked directives.
+   * The handler and its values are removed when the stream closes.
+   *
+   * @generated from rpc bus.api.ControllerBusService.ServeDirectives
+   */
+  ServeDirectives(
+    request: MessageStream<ServeDirectivesRequest>,
+    abortSignal?: AbortSignal,
+  ): MessageStream<ServeDirectivesResponse>
 }
 
 export const ControllerBusServiceServiceName =
@@ -80,7 +409,20 @@ export class ControllerBusServiceClient implements ControllerBusService {
     this.service = opts?.service || ControllerBusServiceServiceName
     this.rpc = rpc
     this.GetBusInfo = this.GetBusInfo.bind(this)
+    this.GetDirectiveInfo = this.GetDirectiveInfo.bind(this)
+    this.ListFactories = this.ListFactories.bind(this)
+    this.GetHealth = this.GetHealth.bind(this)
+    this.PlanConfigSet = this.PlanConfigSet.bind(this)
+    this.GetConfigSetHistory = this.GetConfigSetHistory.bind(this)
+    this.PutConfigSet = this.PutConfigSet.bind(this)
+    this.RollbackConfigSet = this.RollbackConfigSet.bind(this)
+    this.WatchBusInfo = this.WatchBusInfo.bind(this)
     this.ExecController = this.ExecController.bind(this)
+    this.StopController = this.StopController.bind(this)
+    this.RestartController = this.RestartController.bind(this)
+    this.RemoveController = this.RemoveController.bind(this)
+    this.ExecDirective = this.ExecDirective.bind(this)
+    this.ServeDirectives = this.ServeDirectives.bind(this)
   }
   /**
    * GetBusInfo requests information about the controller bus.
@@ -101,6 +443,164 @@ export class ControllerBusServiceClient implements ControllerBusService {
     return GetBusInfoResponse.fromBinary(result)
   }
 
+  /**
+   * GetDirectiveInfo requests the state and values of a directive.
+   *
+   * @generated from rpc bus.api.ControllerBusService.GetDirectiveInfo
+   */
+  async GetDirectiveInfo(
+    request: GetDirectiveInfoRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetDirectiveInfoResponse> {
+    const requestMsg = GetDirectiveInfoRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.GetDirectiveInfo.name,
+      GetDirectiveInfoRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return GetDirectiveInfoResponse.fromBinary(result)
+  }
+
+  /**
+   * ListFactories lists the controller factories available to the bus.
+   *
+   * @generated from rpc bus.api.ControllerBusService.ListFactories
+   */
+  async ListFactories(
+    request: ListFactoriesRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<ListFactoriesResponse> {
+    const requestMsg = ListFactoriesRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.ListFactories.name,
+      ListFactoriesRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return ListFactoriesResponse.fromBinary(result)
+  }
+
+  /**
+   * GetHealth returns the liveness and readiness of the bus.
+   * Readiness is derived from the state of the configset controllers.
+   *
+   * @generated from rpc bus.api.ControllerBusService.GetHealth
+   */
+  async GetHealth(
+    request: GetHealthRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetHealthResponse> {
+    const requestMsg = GetHealthRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.GetHealth.name,
+      GetHealthRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return GetHealthResponse.fromBinary(result)
+  }
+
+  /**
+   * PlanConfigSet computes the changes to the configset controllers if the
+   * configset was applied without applying it.
+   *
+   * @generated from rpc bus.api.ControllerBusService.PlanConfigSet
+   */
+  async PlanConfigSet(
+    request: PlanConfigSetRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<PlanConfigSetResponse> {
+    const requestMsg = PlanConfigSetRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.PlanConfigSet.name,
+      PlanConfigSetRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return PlanConfigSetResponse.fromBinary(result)
+  }
+
+  /**
+   * GetConfigSetHistory returns the revision history of a stored configset.
+   *
+   * @generated from rpc bus.api.ControllerBusService.GetConfigSetHistory
+   */
+  async GetConfigSetHistory(
+    request: GetConfigSetHistoryRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetConfigSetHistoryResponse> {
+    const requestMsg = GetConfigSetHistoryRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.GetConfigSetHistory.name,
+      GetConfigSetHistoryRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return GetConfigSetHistoryResponse.fromBinary(result)
+  }
+
+  /**
+   * PutConfigSet stores a configset as a new revision of a key and applies it
+   * in place of the previous revision. Stored configsets are applied again
+   * when the daemon restarts.
+   *
+   * @generated from rpc bus.api.ControllerBusService.PutConfigSet
+   */
+  async PutConfigSet(
+    request: PutConfigSetRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<PutConfigSetResponse> {
+    const requestMsg = PutConfigSetRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.PutConfigSet.name,
+      PutConfigSetRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return PutConfigSetResponse.fromBinary(result)
+  }
+
+  /**
+   * RollbackConfigSet stores a copy of an earlier revision of a stored
+   * configset as a new revision and applies it.
+   *
+   * @generated from rpc bus.api.ControllerBusService.RollbackConfigSet
+   */
+  async RollbackConfigSet(
+    request: RollbackConfigSetRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<RollbackConfigSetResponse> {
+    const requestMsg = RollbackConfigSetRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.RollbackConfigSet.name,
+      RollbackConfigSetRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return RollbackConfigSetResponse.fromBinary(result)
+  }
+
+  /**
+   * WatchBusInfo streams a snapshot of the controller bus followed by
+   * controller and directive events.
+   *
+   * @generated from rpc bus.api.ControllerBusService.WatchBusInfo
+   */
+  WatchBusInfo(
+    request: WatchBusInfoRequest,
+    abortSignal?: AbortSignal,
+  ): MessageStream<WatchBusInfoResponse> {
+    const requestMsg = WatchBusInfoRequest.create(request)
+    const result = this.rpc.serverStreamingRequest(
+      this.service,
+      ControllerBusServiceDefinition.methods.WatchBusInfo.name,
+      WatchBusInfoRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return buildDecodeMessageTransform(WatchBusInfoResponse)(result)
+  }
+
   /**
    * ExecController executes a controller configuration on the bus.
    *
@@ -119,4 +619,103 @@ export class ControllerBusServiceClient implements ControllerBusService {
     )
     return buildDecodeMessageTransform(ExecControllerResponse)(result)
   }
+
+  /**
+   * StopController stops a configset controller and releases the configset
+   * references to it. It is started again if the configset is re-applied.
+   *
+   * @generated from rpc bus.api.ControllerBusService.StopController
+   */
+  async StopController(
+    request: StopControllerRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<StopControllerResponse> {
+    const requestMsg = StopControllerRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.StopController.name,
+      StopControllerRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return StopControllerResponse.fromBinary(result)
+  }
+
+  /**
+   * RestartController restarts a configset controller with the current config.
+   * Clears the quarantine if the controller was quarantined.
+   *
+   * @generated from rpc bus.api.ControllerBusService.RestartController
+   */
+  async RestartController(
+    request: RestartControllerRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<RestartControllerResponse> {
+    const requestMsg = RestartControllerRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.RestartController.name,
+      RestartControllerRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return RestartControllerResponse.fromBinary(result)
+  }
+
+  /**
+   * RemoveController stops a configset controller and releases all configset
+   * references to it, including persistent references.
+   *
+   * @generated from rpc bus.api.ControllerBusService.RemoveController
+   */
+  async RemoveController(
+    request: RemoveControllerRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<RemoveControllerResponse> {
+    const requestMsg = RemoveControllerRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      ControllerBusServiceDefinition.methods.RemoveController.name,
+      RemoveControllerRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return RemoveControllerResponse.fromBinary(result)
+  }
+
+  /**
+   * ExecDirective executes a networked directive on the bus.
+   * Streams value events until the request is canceled.
+   *
+   * @generated from rpc bus.api.ControllerBusService.ExecDirective
+   */
+  ExecDirective(
+    request: ExecDirectiveRequest,
+    abortSignal?: AbortSignal,
+  ): MessageStream<ExecDirectiveResponse> {
+    const requestMsg = ExecDirectiveRequest.create(request)
+    const result = this.rpc.serverStreamingRequest(
+      this.service,
+      ControllerBusServiceDefinition.methods.ExecDirective.name,
+      ExecDirectiveRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return buildDecodeMessageTransform(ExecDirectiveResponse)(result)
+  }
+
+  /**
+   * ServeDirectives registers the caller as a handler for networked directives.
+   * The handler and its values are removed when the stream closes.
+   *
+   * @generated from rpc bus.api.ControllerBusService.ServeDirectives
+   */
+  ServeDirectives(
+    request: MessageStream<ServeDirectivesRequest>,
+    abortSignal?: AbortSignal,
+  ): MessageStream<ServeDirectivesResponse> {
+    const result = this.rpc.bidirectionalStreamingRequest(
+      this.service,
+      ControllerBusServiceDefinition.methods.ServeDirectives.name,
+      buildEncodeMessageTransform(ServeDirectivesRequest)(request),
+      abortSignal || undefined,
+    )
+    return buildDecodeMessageTransform(ServeDirectivesResponse)(result)
+  }
 }
@@ -0,0 +1,68 @@
+package bus_api
+
+import (
+	"context"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/bus/inmem"
+	"github.com/aperturerobotics/controllerbus/directive"
+	cdc "github.com/aperturerobotics/controllerbus/directive/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// ExecDirectiveHandler forwards networked directives to a remote bus.
+type ExecDirectiveHandler struct {
+	client SRPCControllerBusServiceClient
+	types  directive.NetworkedTypeSet
+	// skip contains directives served for the remote, may be nil
+	skip *servedDirectives
+}
+
+// NewExecDirectiveHandler constructs a new ExecDirectiveHandler.
+//
+// Only directives with a type in types are forwarded.
+func NewExecDirectiveHandler(
+	client SRPCControllerBusServiceClient,
+	types ...directive.NetworkedType,
+) *ExecDirectiveHandler {
+	return &ExecDirectiveHandler{
+		client: client,
+		types:  directive.NewNetworkedTypeSet(types...),
+	}
+}
+
+// HandleDirective asks if the handler can resolve the directive.
+func (h *ExecDirectiveHandler) HandleDirective(
+	ctx context.Context,
+	di directive.Instance,
+) ([]directive.Resolver, error) {
+	dir := di.GetDirective()
+	dirType := h.types.GetNetworkedTypeForDirective(dir)
+	if dirType == nil || h.skip.has(dir) {
+		return nil, nil
+	}
+	return directive.R(NewExecDirectiveResolver(h.client, dirType, dir.(directive.Networked)), nil)
+}
+
+// NewClientBus constructs a bus which executes networked directives against a
+// remote bus with the API client.
+//
+// Directives with a type in types are forwarded to the remote bus with
+// ExecDirective. Controllers added to the bus are executed locally.
+// Returns the bus and a function to release the remote handler.
+func NewClientBus(
+	ctx context.Context,
+	le *logrus.Entry,
+	client SRPCControllerBusServiceClient,
+	types ...directive.NetworkedType,
+) (bus.Bus, func(), error) {
+	b := inmem.NewBus(cdc.NewController(ctx, le))
+	rel, err := b.AddHandler(NewExecDirectiveHandler(client, types...))
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, rel, nil
+}
+
+// _ is a type assertion
+var _ directive.Handler = ((*ExecDirectiveHandler)(nil))
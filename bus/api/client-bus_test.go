@@ -0,0 +1,231 @@
+package bus_api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
+	directive_proto "github.com/aperturerobotics/controllerbus/directive/proto"
+	"github.com/aperturerobotics/controllerbus/example/boilerplate"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/sirupsen/logrus"
+)
+
+// TestClientBus tests executing a directive against a remote bus.
+func TestClientBus(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	log := logrus.New()
+	log.SetLevel(logrus.DebugLevel)
+	le := logrus.NewEntry(log)
+
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+
+	execDir := resolver.NewLoadControllerWithConfig(&boilerplate_controller.Config{
+		ExampleField: "testing",
+	})
+	_, _, ctrlRef, err := bus.ExecOneOff(ctx, b, execDir, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ctrlRef.Release()
+
+	mux := srpc.NewMux()
+	api := NewAPI(b, &Config{EnableExecDirective: true}, boilerplate_v1.NetworkedType)
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	cb, relCb, err := NewClientBus(ctx, le, client, boilerplate_v1.NetworkedType)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer relCb()
+
+	res, _, resRef, err := bus.ExecOneOff(ctx, cb, &boilerplate_v1.Boilerplate{
+		MessageText: "hello world",
+	}, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resRef.Release()
+	plen := res.GetValue().(boilerplate.BoilerplateResult).GetPrintedLen()
+	if plen != 55 {
+		t.Fatalf("expected length 55 got %d", plen)
+	}
+}
+
+// TestClientBusResolverErrors tests returning all remote resolver errors.
+func TestClientBusResolverErrors(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	errA, errB := errors.New("resolver a failed"), errors.New("resolver b failed")
+	relHandler, err := b.AddHandler(directive.NewFuncHandler(func(ctx context.Context, di directive.Instance) ([]directive.Resolver, error) {
+		if _, ok := di.GetDirective().(*boilerplate_v1.Boilerplate); !ok {
+			return nil, nil
+		}
+		return []directive.Resolver{
+			directive.NewFuncResolver(func(ctx context.Context, handler directive.ResolverHandler) error { return errA }),
+			directive.NewFuncResolver(func(ctx context.Context, handler directive.ResolverHandler) error { return errB }),
+		}, nil
+	}))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer relHandler()
+
+	mux := srpc.NewMux()
+	api := NewAPI(b, &Config{EnableExecDirective: true}, boilerplate_v1.NetworkedType)
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	cb, relCb, err := NewClientBus(ctx, le, client, boilerplate_v1.NetworkedType)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer relCb()
+
+	_, _, resRef, err := bus.ExecOneOff(ctx, cb, &boilerplate_v1.Boilerplate{MessageText: "hello world"}, nil, nil)
+	if resRef != nil {
+		resRef.Release()
+	}
+	if err == nil || !strings.Contains(err.Error(), errA.Error()) || !strings.Contains(err.Error(), errB.Error()) {
+		t.Fatalf("expected both resolver errors but got %v", err)
+	}
+}
+
+// uncappedBoilerplate is a Boilerplate directive without a value cap.
+type uncappedBoilerplate struct {
+	*boilerplate_v1.Boilerplate
+}
+
+// uncappedBoilerplateType is the networked type for uncappedBoilerplate.
+var uncappedBoilerplateType = directive_proto.NewNetworkedType(
+	"controllerbus/test/uncapped-boilerplate",
+	func() *uncappedBoilerplate { return &uncappedBoilerplate{Boilerplate: &boilerplate_v1.Boilerplate{}} },
+	func() *boilerplate_v1.BoilerplateResult { return &boilerplate_v1.BoilerplateResult{} },
+)
+
+// GetNetworkedTypeID returns the unique type identifier.
+func (b *uncappedBoilerplate) GetNetworkedTypeID() string {
+	return uncappedBoilerplateType.GetNetworkedTypeID()
+}
+
+// GetValueOptions returns options relating to value handling.
+func (b *uncappedBoilerplate) GetValueOptions() directive.ValueOptions {
+	return directive.ValueOptions{}
+}
+
+// TestClientBusValuesWithErrors tests keeping the remote values while a remote
+// resolver fails.
+func TestClientBusValuesWithErrors(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	errA := errors.New("resolver a failed")
+	relHandler, err := b.AddHandler(directive.NewFuncHandler(func(ctx context.Context, di directive.Instance) ([]directive.Resolver, error) {
+		if _, ok := di.GetDirective().(*uncappedBoilerplate); !ok {
+			return nil, nil
+		}
+		return []directive.Resolver{
+			directive.NewValueResolver([]*boilerplate_v1.BoilerplateResult{{PrintedLen: 1}, {PrintedLen: 2}}),
+			directive.NewFuncResolver(func(ctx context.Context, handler directive.ResolverHandler) error { return errA }),
+		}, nil
+	}))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer relHandler()
+
+	mux := srpc.NewMux()
+	api := NewAPI(b, &Config{EnableExecDirective: true}, uncappedBoilerplateType)
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	cb, relCb, err := NewClientBus(ctx, le, client, uncappedBoilerplateType)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer relCb()
+
+	var mtx sync.Mutex
+	var added, removed int
+	di, ref, err := cb.AddDirective(
+		&uncappedBoilerplate{Boilerplate: &boilerplate_v1.Boilerplate{MessageText: "hello world"}},
+		bus.NewCallbackHandler(
+			func(val directive.AttachedValue) {
+				mtx.Lock()
+				added++
+				mtx.Unlock()
+			},
+			func(val directive.AttachedValue) {
+				mtx.Lock()
+				removed++
+				mtx.Unlock()
+			},
+			nil,
+		),
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ref.Release()
+
+	// wait for the values and the error, then check the values are not removed
+	var settled time.Time
+	for {
+		mtx.Lock()
+		nAdded, nRemoved := added, removed
+		mtx.Unlock()
+		errs := di.GetResolverErrors()
+		if nRemoved != 0 || nAdded > 2 {
+			t.Fatalf("expected values to stay attached but got %d added and %d removed", nAdded, nRemoved)
+		}
+		if nAdded == 2 && len(errs) != 0 {
+			if len(errs) != 1 || errs[0].Error() != errA.Error() {
+				t.Fatalf("unexpected resolver errors: %v", errs)
+			}
+			if settled.IsZero() {
+				settled = time.Now()
+			} else if time.Since(settled) > 200*time.Millisecond {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected values and a resolver error but got %d values and errors %v", nAdded, errs)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
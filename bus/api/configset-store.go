@@ -0,0 +1,27 @@
+package bus_api
+
+import (
+	"errors"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	configset_store "github.com/aperturerobotics/controllerbus/controller/configset/store"
+)
+
+var (
+	// ErrConfigSetStoreDisabled is returned if the configset store isn't enabled.
+	ErrConfigSetStoreDisabled = errors.New("configset store is disabled on this api")
+	// ErrConfigSetStoreNotFound is returned if no configset store controller is running.
+	ErrConfigSetStoreNotFound = errors.New("no configset store controller is running")
+)
+
+// LookupConfigSetStore returns the first configset store controller on the bus.
+//
+// Returns ErrConfigSetStoreNotFound if there is none.
+func LookupConfigSetStore(b bus.Bus) (configset_store.Controller, error) {
+	for _, ctrl := range b.GetControllers() {
+		if storeCtrl, ok := ctrl.(configset_store.Controller); ok {
+			return storeCtrl, nil
+		}
+	}
+	return nil, ErrConfigSetStoreNotFound
+}
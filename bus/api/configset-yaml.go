@@ -0,0 +1,34 @@
+//go:build !tinygo
+
+package bus_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_json "github.com/aperturerobotics/controllerbus/controller/configset/json"
+)
+
+// ResolveConfigSetYAML parses and validates a YAML configset.
+//
+// Returns the errors with each invalid controller config joined.
+func ResolveConfigSetYAML(ctx context.Context, b bus.Bus, data []byte) (configset.ConfigSet, error) {
+	ycs, err := configset_json.UnmarshalConfigSetYAML(data)
+	if err != nil {
+		return nil, err
+	}
+	cs, confErrs := ycs.Validate(ctx, b)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(confErrs) != 0 {
+		errs := make([]error, len(confErrs))
+		for i, confErr := range confErrs {
+			errs[i] = confErr
+		}
+		return nil, errors.Join(errs...)
+	}
+	return cs, nil
+}
@@ -0,0 +1,16 @@
+//go:build tinygo
+
+package bus_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+)
+
+// ResolveConfigSetYAML parses and validates a YAML configset.
+func ResolveConfigSetYAML(ctx context.Context, b bus.Bus, data []byte) (configset.ConfigSet, error) {
+	return nil, errors.New("yaml controller config sets are unsupported in tinygo")
+}
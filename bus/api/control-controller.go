@@ -0,0 +1,71 @@
+package bus_api
+
+import (
+	"errors"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+)
+
+var (
+	// ErrControlControllersDisabled is returned if control controllers isn't enabled.
+	ErrControlControllersDisabled = errors.New("control controllers is disabled on this api")
+	// ErrEmptyControllerTarget is returned if the controller target is empty.
+	ErrEmptyControllerTarget = errors.New("config key or controller id must be set")
+	// ErrControllerNotFound is returned if no controller matched the target.
+	ErrControllerNotFound = errors.New("no configset controller matched the target")
+)
+
+// Validate validates the controller target.
+func (t *ControllerTarget) Validate() error {
+	if t.GetConfigKey() == "" && t.GetControllerId() == "" {
+		return ErrEmptyControllerTarget
+	}
+	return nil
+}
+
+// Matches checks if the configset controller state matches the target.
+func (t *ControllerTarget) Matches(st configset.State) bool {
+	if key := t.GetConfigKey(); key != "" && st.GetId() != key {
+		return false
+	}
+	if id := t.GetControllerId(); id != "" {
+		ctrl := st.GetController()
+		if ctrl == nil || ctrl.GetControllerInfo().GetId() != id {
+			return false
+		}
+	}
+	return true
+}
+
+// ControlConfigSetControllers calls cb with each configset controller key on
+// the bus matching the target.
+//
+// Returns the keys for which cb returned true.
+// Returns ErrControllerNotFound if there were none.
+func ControlConfigSetControllers(
+	b bus.Bus,
+	target *ControllerTarget,
+	cb func(csCtrl configset.Controller, key string) bool,
+) ([]string, error) {
+	if err := target.Validate(); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, ctrl := range b.GetControllers() {
+		csCtrl, ok := ctrl.(configset.Controller)
+		if !ok {
+			continue
+		}
+		for _, st := range csCtrl.GetControllerStates() {
+			if target.Matches(st) && cb(csCtrl, st.GetId()) {
+				keys = append(keys, st.GetId())
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return nil, ErrControllerNotFound
+	}
+	return keys, nil
+}
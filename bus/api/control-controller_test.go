@@ -0,0 +1,111 @@
+package bus_api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	controller_exec "github.com/aperturerobotics/controllerbus/controller/exec"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/sirupsen/logrus"
+)
+
+// TestControlController tests restarting and stopping configset controllers.
+func TestControlController(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+
+	_, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+
+	_, applyRef, err := b.AddDirective(configset.NewApplyConfigSet(configset.ConfigSet{
+		"boilerplate": configset.NewControllerConfig(1, &boilerplate_controller.Config{ExampleField: "testing"}),
+	}), nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer applyRef.Release()
+
+	mux := srpc.NewMux()
+	api := NewAPI(b, &Config{EnableControlControllers: true})
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	// waitState waits for the configset controller state to match.
+	waitState := func(match func(st *controller_exec.ExecControllerResponse) bool) {
+		for {
+			resp, err := client.GetBusInfo(ctx, &GetBusInfoRequest{})
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			var st *controller_exec.ExecControllerResponse
+			for _, cs := range resp.GetConfigSetControllers() {
+				if cs.GetId() == "boilerplate" {
+					st = cs
+				}
+			}
+			if match(st) {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatal(ctx.Err().Error())
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	isRunning := func(st *controller_exec.ExecControllerResponse) bool {
+		return st.GetStatus() == controller_exec.ControllerStatus_ControllerStatus_RUNNING
+	}
+	waitState(isRunning)
+
+	if _, err := client.RestartController(ctx, &RestartControllerRequest{
+		Target: &ControllerTarget{ConfigKey: "unknown"},
+	}); err == nil || err.Error() != ErrControllerNotFound.Error() {
+		t.Fatalf("expected not found error but got %v", err)
+	}
+
+	resp, err := client.RestartController(ctx, &RestartControllerRequest{
+		Target: &ControllerTarget{ControllerId: boilerplate_controller.ControllerID},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if keys := resp.GetConfigKeys(); len(keys) != 1 || keys[0] != "boilerplate" {
+		t.Fatalf("unexpected restarted keys: %v", keys)
+	}
+	waitState(isRunning)
+
+	if _, err := client.StopController(ctx, &StopControllerRequest{
+		Target: &ControllerTarget{ConfigKey: "boilerplate"},
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	waitState(func(st *controller_exec.ExecControllerResponse) bool {
+		return st == nil
+	})
+}
@@ -3,12 +3,15 @@ package bus_api_controller
 import (
 	"context"
 	"net"
+	"net/http"
+	"time"
 
 	"github.com/aperturerobotics/controllerbus/bus"
 	api "github.com/aperturerobotics/controllerbus/bus/api"
 	"github.com/aperturerobotics/controllerbus/controller"
 	"github.com/aperturerobotics/controllerbus/directive"
 	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/aperturerobotics/util/ccontainer"
 	"github.com/sirupsen/logrus"
 )
 
@@ -26,20 +29,29 @@ type Controller struct {
 	listenAddr string
 	// conf is the config
 	conf *Config
+	// types are the networked directive types accepted by the api
+	types []directive.NetworkedType
+	// ready is set while the listeners are accepting connections
+	ready *ccontainer.CContainer[bool]
 }
 
 // NewController constructs a new API controller.
+//
+// types are the networked directive types accepted by ExecDirective.
 func NewController(
 	le *logrus.Entry,
 	listenAddr string,
 	bus bus.Bus,
 	conf *Config,
+	types ...directive.NetworkedType,
 ) *Controller {
 	return &Controller{
 		le:         le,
 		bus:        bus,
 		listenAddr: listenAddr,
 		conf:       conf,
+		types:      types,
+		ready:      ccontainer.NewCContainer(false),
 	}
 }
 
@@ -58,7 +70,7 @@ func (c *Controller) GetControllerInfo() *controller.Info {
 func (c *Controller) Execute(ctx context.Context) error {
 	// Construct the API
 	mux := srpc.NewMux()
-	api := api.NewAPI(c.bus, c.conf.GetBusApiConfig())
+	api := api.NewAPI(c.bus, c.conf.GetBusApiConfig(), c.types...)
 	if err := api.RegisterAsSRPCServer(mux); err != nil {
 		return err
 	}
@@ -68,14 +80,38 @@ func (c *Controller) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	defer lis.Close()
 
-	errCh := make(chan error, 1)
+	var healthLis net.Listener
+	if healthAddr := c.conf.GetHealthListenAddr(); healthAddr != "" {
+		healthLis, err = net.Listen("tcp", healthAddr)
+		if err != nil {
+			return err
+		}
+		c.le.Infof("health probes listening on: %s", healthAddr)
+	}
+
+	errCh := make(chan error, 2)
 	srv := srpc.NewServer(mux)
 	go func() {
 		errCh <- srpc.AcceptMuxedListener(ctx, lis, srv, nil)
 		_ = lis.Close()
 	}()
 
+	if healthLis != nil {
+		healthSrv := &http.Server{
+			Handler:           NewHealthHandler(c.bus),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		defer healthSrv.Close()
+		go func() {
+			errCh <- healthSrv.Serve(healthLis)
+		}()
+	}
+
+	c.ready.SetValue(true)
+	defer c.ready.SetValue(false)
+
 	select {
 	case <-ctx.Done():
 		return nil
@@ -84,6 +120,12 @@ func (c *Controller) Execute(ctx context.Context) error {
 	}
 }
 
+// WaitReady waits for the listeners to accept connections.
+func (c *Controller) WaitReady(ctx context.Context) error {
+	_, err := c.ready.WaitValue(ctx, nil)
+	return err
+}
+
 // HandleDirective asks if the handler can resolve the directive.
 // If it can, it returns a resolver. If not, returns nil.
 // Any unexpected errors are returned for logging.
@@ -103,4 +145,4 @@ func (c *Controller) Close() error {
 }
 
 // _ is a type assertion
-var _ controller.Controller = ((*Controller)(nil))
+var _ controller.ControllerWithReady = ((*Controller)(nil))
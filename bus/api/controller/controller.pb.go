@@ -23,6 +23,9 @@ type Config struct {
 	ListenAddr string `protobuf:"bytes,1,opt,name=listen_addr,json=listenAddr,proto3" json:"listenAddr,omitempty"`
 	// BusApiConfig are options for controller bus api.
 	BusApiConfig *api.Config `protobuf:"bytes,2,opt,name=bus_api_config,json=busApiConfig,proto3" json:"busApiConfig,omitempty"`
+	// HealthListenAddr is the address to listen on for http health probes.
+	// Serves /healthz and /readyz. If empty, the probes are disabled.
+	HealthListenAddr string `protobuf:"bytes,3,opt,name=health_listen_addr,json=healthListenAddr,proto3" json:"healthListenAddr,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -45,6 +48,13 @@ func (x *Config) GetBusApiConfig() *api.Config {
 	return nil
 }
 
+func (x *Config) GetHealthListenAddr() string {
+	if x != nil {
+		return x.HealthListenAddr
+	}
+	return ""
+}
+
 func (m *Config) CloneVT() *Config {
 	if m == nil {
 		return (*Config)(nil)
@@ -52,6 +62,7 @@ func (m *Config) CloneVT() *Config {
 	r := new(Config)
 	r.ListenAddr = m.ListenAddr
 	r.BusApiConfig = m.BusApiConfig.CloneVT()
+	r.HealthListenAddr = m.HealthListenAddr
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = slices.Clone(m.unknownFields)
 	}
@@ -74,6 +85,9 @@ func (this *Config) EqualVT(that *Config) bool {
 	if !this.BusApiConfig.EqualVT(that.BusApiConfig) {
 		return false
 	}
+	if this.HealthListenAddr != that.HealthListenAddr {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -103,6 +117,11 @@ func (x *Config) MarshalProtoJSON(s *json.MarshalState) {
 		s.WriteObjectField("busApiConfig")
 		x.BusApiConfig.MarshalProtoJSON(s.WithField("busApiConfig"))
 	}
+	if x.HealthListenAddr != "" || s.HasField("healthListenAddr") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("healthListenAddr")
+		s.WriteString(x.HealthListenAddr)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -130,6 +149,9 @@ func (x *Config) UnmarshalProtoJSON(s *json.UnmarshalState) {
 			}
 			x.BusApiConfig = &api.Config{}
 			x.BusApiConfig.UnmarshalProtoJSON(s.WithField("bus_api_config", true))
+		case "health_listen_addr", "healthListenAddr":
+			s.AddField("health_listen_addr")
+			x.HealthListenAddr = s.ReadString()
 		}
 	})
 }
@@ -169,6 +191,13 @@ func (m *Config) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.HealthListenAddr) > 0 {
+		i -= len(m.HealthListenAddr)
+		copy(dAtA[i:], m.HealthListenAddr)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.HealthListenAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.BusApiConfig != nil {
 		size, err := m.BusApiConfig.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
@@ -203,6 +232,10 @@ func (m *Config) SizeVT() (n int) {
 		l = m.BusApiConfig.SizeVT()
 		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
 	}
+	l = len(m.HealthListenAddr)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -224,6 +257,13 @@ func (x *Config) MarshalProtoText() string {
 		sb.WriteString("bus_api_config: ")
 		sb.WriteString(x.BusApiConfig.MarshalProtoText())
 	}
+	if x.HealthListenAddr != "" {
+		if sb.Len() > 8 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("health_listen_addr: ")
+		sb.WriteString(strconv.Quote(x.HealthListenAddr))
+	}
 	sb.WriteString("}")
 	return sb.String()
 }
@@ -302,6 +342,28 @@ func (m *Config) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthListenAddr", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthListenAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
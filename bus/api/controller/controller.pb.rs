@@ -9,5 +9,9 @@ pub struct Config {
     /// BusApiConfig are options for controller bus api.
     #[prost(message, optional, tag="2")]
     pub bus_api_config: ::core::option::Option<super::Config>,
+    /// HealthListenAddr is the address to listen on for http health probes.
+    /// Serves /healthz and /readyz. If empty, the probes are disabled.
+    #[prost(string, tag="3")]
+    pub health_listen_addr: ::prost::alloc::string::String,
 }
 // @@protoc_insertion_point(module)
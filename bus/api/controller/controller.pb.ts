@@ -26,6 +26,13 @@ export interface Config {
    * @generated from field: bus.api.Config bus_api_config = 2;
    */
   busApiConfig?: Config$1
+  /**
+   * HealthListenAddr is the address to listen on for http health probes.
+   * Serves /healthz and /readyz. If empty, the probes are disabled.
+   *
+   * @generated from field: string health_listen_addr = 3;
+   */
+  healthListenAddr?: string
 }
 
 // Config contains the message type declaration for Config.
@@ -34,6 +41,7 @@ export const Config: MessageType<Config> = createMessageType({
   fields: [
     { no: 1, name: 'listen_addr', kind: 'scalar', T: ScalarType.STRING },
     { no: 2, name: 'bus_api_config', kind: 'message', T: () => Config$1 },
+    { no: 3, name: 'health_listen_addr', kind: 'scalar', T: ScalarType.STRING },
   ] as readonly PartialFieldInfo[],
   packedByDefault: true,
 })
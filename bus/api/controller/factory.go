@@ -6,6 +6,7 @@ import (
 	"github.com/aperturerobotics/controllerbus/bus"
 	"github.com/aperturerobotics/controllerbus/config"
 	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
 )
 
 // ControllerID identifies the API controller.
@@ -15,11 +16,15 @@ const ControllerID = "controllerbus/bus/api"
 type Factory struct {
 	// bus is the controller bus
 	bus bus.Bus
+	// types are the networked directive types accepted by the api
+	types []directive.NetworkedType
 }
 
 // NewFactory builds a bus API factory.
-func NewFactory(bus bus.Bus) *Factory {
-	return &Factory{bus: bus}
+//
+// types are the networked directive types accepted by ExecDirective.
+func NewFactory(bus bus.Bus, types ...directive.NetworkedType) *Factory {
+	return &Factory{bus: bus, types: types}
 }
 
 // GetConfigID returns the unique ID for the config.
@@ -53,6 +58,7 @@ func (t *Factory) Construct(
 		cc.GetListenAddr(),
 		t.bus,
 		cc,
+		t.types...,
 	), nil
 }
 
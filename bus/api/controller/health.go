@@ -0,0 +1,41 @@
+package bus_api_controller
+
+import (
+	"net/http"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	api "github.com/aperturerobotics/controllerbus/bus/api"
+)
+
+// NewHealthHandler builds the http handler for the /healthz and /readyz probes.
+//
+// Responds with the health as json and status 503 if the check failed.
+func NewHealthHandler(b bus.Bus) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeHealth(w, r, b, (*api.GetHealthResponse).GetLive)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		writeHealth(w, r, b, (*api.GetHealthResponse).GetReady)
+	})
+	return mux
+}
+
+// writeHealth checks the bus health and writes the response.
+func writeHealth(w http.ResponseWriter, r *http.Request, b bus.Bus, ok func(*api.GetHealthResponse) bool) {
+	health, err := api.GetBusHealth(r.Context(), b)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	dat, err := health.MarshalJSON()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if !ok(health) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, _ = w.Write(dat)
+}
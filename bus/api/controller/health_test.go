@@ -0,0 +1,101 @@
+package bus_api_controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	api "github.com/aperturerobotics/controllerbus/bus/api"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestHealthHandler tests the liveness and readiness probes.
+func TestHealthHandler(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+
+	_, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+
+	handler := NewHealthHandler(b)
+	get := func(path string) (int, *api.GetHealthResponse) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		health := &api.GetHealthResponse{}
+		if err := health.UnmarshalJSON(rec.Body.Bytes()); err != nil {
+			t.Fatalf("%s: %v: %s", path, err, rec.Body.String())
+		}
+		return rec.Code, health
+	}
+	// waitReady waits for the readiness probe to return the status code and match.
+	waitReady := func(code int, match func(health *api.GetHealthResponse) bool) *api.GetHealthResponse {
+		for {
+			status, health := get("/readyz")
+			if status == code && match(health) {
+				return health
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected status %d but got %d: %v", code, status, health.GetFailingKeys())
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	_, okRef, err := b.AddDirective(configset.NewApplyConfigSet(configset.ConfigSet{
+		"ok": configset.NewControllerConfig(1, &boilerplate_controller.Config{ExampleField: "testing"}),
+	}), nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer okRef.Release()
+	if health := waitReady(http.StatusOK, (*api.GetHealthResponse).GetReady); !health.GetLive() {
+		t.Fatal("expected live")
+	}
+
+	_, invalidRef, err := b.AddDirective(configset.NewApplyConfigSet(configset.ConfigSet{
+		"invalid": configset.NewControllerConfig(1, &boilerplate_controller.Config{}),
+	}), nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer invalidRef.Release()
+	// the key is not running until the config is validated, then has the validation error
+	health := waitReady(http.StatusServiceUnavailable, func(health *api.GetHealthResponse) bool {
+		failing := health.GetFailingKeys()
+		return len(failing) == 1 && failing[0].GetError() != api.ErrControllerNotRunning.Error()
+	})
+	if failing := health.GetFailingKeys(); failing[0].GetConfigKey() != "invalid" {
+		t.Fatalf("unexpected failing keys: %v", failing)
+	}
+	t.Log(strings.TrimSpace(string(health.PrintPrettyStatus())))
+
+	if status, health := get("/healthz"); status != http.StatusOK || !health.GetLive() {
+		t.Fatalf("expected live but got status %d", status)
+	}
+}
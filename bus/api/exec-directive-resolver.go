@@ -0,0 +1,114 @@
+package bus_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// ExecDirectiveResolver resolves a networked directive with ExecDirective.
+//
+// Values resolved on the remote bus are decoded and attached to the local
+// directive instance. Remote values are removed when the resolver exits.
+// Remote resolver errors are reported by a child resolver without ending the
+// stream, so the values stay attached while a remote resolver fails.
+type ExecDirectiveResolver struct {
+	client  SRPCControllerBusServiceClient
+	dirType directive.NetworkedType
+	dir     directive.Networked
+}
+
+// NewExecDirectiveResolver constructs a new ExecDirectiveResolver.
+func NewExecDirectiveResolver(
+	client SRPCControllerBusServiceClient,
+	dirType directive.NetworkedType,
+	dir directive.Networked,
+) *ExecDirectiveResolver {
+	return &ExecDirectiveResolver{client: client, dirType: dirType, dir: dir}
+}
+
+// Resolve resolves the values, emitting them to the handler.
+func (r *ExecDirectiveResolver) Resolve(ctx context.Context, handler directive.ResolverHandler) error {
+	body, err := r.dir.GetNetworkedCodec().Marshal(r.dir)
+	if err != nil {
+		return fmt.Errorf("marshal directive: %w", err)
+	}
+
+	strm, err := r.client.ExecDirective(ctx, &ExecDirectiveRequest{
+		DirectiveTypeId: r.dirType.GetNetworkedTypeID(),
+		DirectiveBody:   body,
+	})
+	if err != nil {
+		return err
+	}
+	defer strm.Close()
+
+	// the remote bus sends the full value set on each call
+	_ = handler.ClearValues()
+	defer handler.ClearValues()
+
+	// valueIDs maps remote value ids to local value ids
+	valueIDs := make(map[uint32]uint32)
+	// remoteErrs are the resolver errors of the last IDLE event
+	var remoteErrs []string
+	// relErrResolver releases the child resolver returning remoteErrs
+	relErrResolver := func() {}
+	defer func() {
+		relErrResolver()
+	}()
+	for {
+		resp, err := strm.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		switch resp.GetEventType() {
+		case ExecDirectiveEventType_ExecDirectiveEventType_VALUE_ADDED:
+			val, err := r.dirType.UnmarshalValue(resp.GetValueBody())
+			if err != nil {
+				return fmt.Errorf("unmarshal value: %w", err)
+			}
+			if id, accepted := handler.AddValue(val); accepted {
+				valueIDs[resp.GetValueId()] = id
+			}
+		case ExecDirectiveEventType_ExecDirectiveEventType_VALUE_REMOVED:
+			if id, ok := valueIDs[resp.GetValueId()]; ok {
+				delete(valueIDs, resp.GetValueId())
+				_, _ = handler.RemoveValue(id)
+			}
+		case ExecDirectiveEventType_ExecDirectiveEventType_IDLE:
+			if errs := resp.GetResolverErrors(); !slices.Equal(errs, remoteErrs) {
+				relErrResolver()
+				relErrResolver, remoteErrs = func() {}, errs
+				if len(errs) != 0 {
+					errsErr := JoinResolverErrors(errs)
+					relErrResolver = handler.AddResolver(directive.NewFuncResolver(func(ctx context.Context, handler directive.ResolverHandler) error {
+						return errsErr
+					}), nil)
+				}
+			}
+			handler.MarkIdle(resp.GetIdle())
+		}
+	}
+}
+
+// JoinResolverErrors joins the resolver errors of an IDLE event.
+//
+// Returns nil if errs is empty.
+func JoinResolverErrors(errs []string) error {
+	joined := make([]error, len(errs))
+	for i, err := range errs {
+		joined[i] = errors.New(err)
+	}
+	return errors.Join(joined...)
+}
+
+// _ is a type assertion
+var _ directive.Resolver = ((*ExecDirectiveResolver)(nil))
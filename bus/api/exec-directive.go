@@ -0,0 +1,103 @@
+package bus_api
+
+import (
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/pkg/errors"
+)
+
+// ExecDirectiveEvents adds a networked directive to the bus and calls cb with
+// value and idle events encoded with dirType.
+//
+// If the directive is disposed or a value cannot be encoded, cb is called
+// once with the error and no further events are emitted.
+// cb should not block.
+// Returns a function to release the directive reference.
+func ExecDirectiveEvents(
+	b bus.Bus,
+	dirType directive.NetworkedType,
+	dir directive.Networked,
+	cb func(ev *ExecDirectiveResponse, err error),
+) (func(), error) {
+	// mtx guards done
+	var mtx sync.Mutex
+	var done bool
+	emit := func(ev *ExecDirectiveResponse, err error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if done {
+			return
+		}
+		done = err != nil
+		cb(ev, err)
+	}
+
+	di, ref, err := b.AddDirective(
+		dir,
+		bus.NewCallbackHandler(
+			func(v directive.AttachedValue) {
+				body, err := dirType.MarshalValue(v.GetValue())
+				if err != nil {
+					emit(nil, errors.Wrap(err, "marshal value"))
+					return
+				}
+				emit(&ExecDirectiveResponse{
+					EventType: ExecDirectiveEventType_ExecDirectiveEventType_VALUE_ADDED,
+					ValueId:   v.GetValueID(),
+					ValueBody: body,
+				}, nil)
+			},
+			func(v directive.AttachedValue) {
+				emit(&ExecDirectiveResponse{
+					EventType: ExecDirectiveEventType_ExecDirectiveEventType_VALUE_REMOVED,
+					ValueId:   v.GetValueID(),
+				}, nil)
+			},
+			func() {
+				emit(nil, directive.ErrDirectiveDisposed)
+			},
+		),
+	)
+	if err != nil {
+		if ref != nil {
+			ref.Release()
+		}
+		return nil, err
+	}
+
+	relIdle := di.AddIdleCallback(func(isIdle bool, errs []error) {
+		emit(NewExecDirectiveIdleResponse(isIdle, errs), nil)
+	})
+	return func() {
+		relIdle()
+		ref.Release()
+	}, nil
+}
+
+// NewExecDirectiveIdleResponse constructs an IDLE event.
+func NewExecDirectiveIdleResponse(isIdle bool, errs []error) *ExecDirectiveResponse {
+	resp := &ExecDirectiveResponse{
+		EventType: ExecDirectiveEventType_ExecDirectiveEventType_IDLE,
+		Idle:      isIdle,
+	}
+	for _, err := range errs {
+		if err != nil {
+			resp.ResolverErrors = append(resp.ResolverErrors, err.Error())
+		}
+	}
+	return resp
+}
+
+// decodeNetworked decodes a networked directive with the type.
+func decodeNetworked(dirType directive.NetworkedType, body []byte) (directive.Networked, error) {
+	dir := dirType.NewNetworked()
+	if err := dir.GetNetworkedCodec().Unmarshal(body, dir); err != nil {
+		return nil, errors.Wrap(err, "unmarshal directive")
+	}
+	if err := dir.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid directive")
+	}
+	return dir, nil
+}
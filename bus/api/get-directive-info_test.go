@@ -0,0 +1,91 @@
+package bus_api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
+	directive_mock "github.com/aperturerobotics/controllerbus/directive/mock"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// TestGetDirectiveInfo tests getting the values of a directive.
+func TestGetDirectiveInfo(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+	_, _, ctrlRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&boilerplate_controller.Config{ExampleField: "testing"}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ctrlRef.Release()
+
+	_, di, resRef, err := bus.ExecOneOff(ctx, b, &boilerplate_v1.Boilerplate{MessageText: "hello world"}, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resRef.Release()
+
+	// a directive with the same ident that is not equivalent
+	otherDi, otherRef, err := b.AddDirective(&directive_mock.MockDirective{}, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer otherRef.Release()
+	sameDi, sameRef, err := b.AddDirective(&directive_mock.MockDirective{}, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer sameRef.Release()
+	if otherDi.GetDirectiveIdent() != sameDi.GetDirectiveIdent() || otherDi.GetInstanceID() == sameDi.GetInstanceID() {
+		t.Fatal("expected two instances with the same ident and different ids")
+	}
+
+	api := NewAPI(b, &Config{})
+	resp, err := api.GetDirectiveInfo(ctx, &GetDirectiveInfoRequest{InstanceId: di.GetInstanceID()})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !resp.GetFound() || resp.GetDirectiveState().GetInfo().GetName() != "Boilerplate" {
+		t.Fatalf("unexpected response: %s", resp.String())
+	}
+	if len(resp.GetValues()) != 1 || resp.GetValues()[0].GetValueType() == "" {
+		t.Fatalf("expected one value: %s", resp.String())
+	}
+
+	// directives with the same ident are selected by instance id
+	for _, inst := range []directive.Instance{otherDi, sameDi} {
+		resp, err = api.GetDirectiveInfo(ctx, &GetDirectiveInfoRequest{InstanceId: inst.GetInstanceID()})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if !resp.GetFound() || resp.GetDirectiveState().GetInstanceId() != inst.GetInstanceID() {
+			t.Fatalf("unexpected response: %s", resp.String())
+		}
+	}
+
+	resp, err = api.GetDirectiveInfo(ctx, &GetDirectiveInfoRequest{InstanceId: sameDi.GetInstanceID() + 100})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.GetFound() {
+		t.Fatal("expected directive not to be found")
+	}
+}
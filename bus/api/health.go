@@ -0,0 +1,93 @@
+package bus_api
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+)
+
+// LivenessTimeout is the time to wait for the bus to respond to a liveness check.
+var LivenessTimeout = 5 * time.Second
+
+// ErrControllerNotRunning is the health error for a configset key without a running controller.
+var ErrControllerNotRunning = errors.New("controller is not running")
+
+// ErrControllerNotReady is the health error for a configset key with a running
+// controller which has not reported it is ready.
+var ErrControllerNotReady = errors.New("controller is not ready")
+
+// CheckLiveness checks that the directive and controller locks of the bus can
+// be acquired within LivenessTimeout.
+//
+// If the bus is wedged the check goroutine is left blocked on the lock.
+func CheckLiveness(ctx context.Context, b bus.Bus) error {
+	done := make(chan struct{})
+	go func() {
+		_ = b.GetDirectives()
+		_ = b.GetControllers()
+		close(done)
+	}()
+
+	timer := time.NewTimer(LivenessTimeout)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return context.Canceled
+	case <-timer.C:
+		return errors.New("bus did not respond within " + LivenessTimeout.String())
+	case <-done:
+		return nil
+	}
+}
+
+// GetBusHealth checks the liveness and readiness of the bus.
+//
+// The bus is ready if every key of the configset controllers has a running
+// controller without an error. Controllers which report readiness must also be
+// ready. Readiness is not checked if the bus is not live.
+func GetBusHealth(ctx context.Context, b bus.Bus) (*GetHealthResponse, error) {
+	resp := &GetHealthResponse{}
+	if err := CheckLiveness(ctx, b); err != nil {
+		if err == context.Canceled {
+			return nil, err
+		}
+		resp.LiveError = err.Error()
+		return resp, nil
+	}
+	resp.Live = true
+
+	for _, ctrl := range b.GetControllers() {
+		csCtrl, ok := ctrl.(configset.Controller)
+		if !ok {
+			continue
+		}
+		for _, st := range csCtrl.GetControllerStates() {
+			err := st.GetError()
+			if err == nil {
+				ctrl := st.GetController()
+				if ctrl == nil {
+					err = ErrControllerNotRunning
+				} else if _, withReady := ctrl.(controller.ControllerWithReady); withReady && !st.GetReady() {
+					err = ErrControllerNotReady
+				}
+			}
+			if err != nil {
+				resp.FailingKeys = append(resp.FailingKeys, &ConfigKeyHealth{
+					ConfigKey: st.GetId(),
+					Error:     err.Error(),
+				})
+			}
+		}
+	}
+	slices.SortFunc(resp.FailingKeys, func(a, b *ConfigKeyHealth) int {
+		return strings.Compare(a.GetConfigKey(), b.GetConfigKey())
+	})
+	resp.Ready = len(resp.FailingKeys) == 0
+	return resp, nil
+}
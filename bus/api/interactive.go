@@ -53,3 +53,33 @@ func (b *GetBusInfoResponse) PrintPrettyStatus() []byte {
 	_, _ = dat.WriteString("\n")
 	return dat.Bytes()
 }
+
+// PrintPrettyStatus prints the health as a pretty status output.
+func (h *GetHealthResponse) PrintPrettyStatus() []byte {
+	var dat bytes.Buffer
+	if h == nil {
+		_, _ = dat.WriteString("● no data\n")
+		return dat.Bytes()
+	}
+
+	if !h.GetLive() {
+		_, _ = dat.WriteString("✗ not live: ")
+		_, _ = dat.WriteString(h.GetLiveError())
+		_, _ = dat.WriteString("\n")
+		return dat.Bytes()
+	}
+	_, _ = dat.WriteString("✓ live\n")
+	if h.GetReady() {
+		_, _ = dat.WriteString("✓ ready\n")
+		return dat.Bytes()
+	}
+	_, _ = dat.WriteString("✗ not ready:")
+	for _, key := range h.GetFailingKeys() {
+		_, _ = dat.WriteString("\n\t")
+		_, _ = dat.WriteString(key.GetConfigKey())
+		_, _ = dat.WriteString(": ")
+		_, _ = dat.WriteString(key.GetError())
+	}
+	_, _ = dat.WriteString("\n")
+	return dat.Bytes()
+}
@@ -0,0 +1,37 @@
+package bus_api
+
+import (
+	"context"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// LinkBus links the local bus with a remote bus for the networked directive
+// types.
+//
+// Directives raised on the local bus are executed on the remote bus with
+// ExecDirective, and directives raised on the remote bus are executed on the
+// local bus with ServeDirectives. Directives added for one bus are not
+// forwarded back to it: the remote api must set NoServeExecDirectives.
+//
+// Blocks until ctx is canceled or the stream fails.
+func LinkBus(
+	ctx context.Context,
+	client SRPCControllerBusServiceClient,
+	b bus.Bus,
+	types ...directive.NetworkedType,
+) error {
+	var served servedDirectives
+	rel, err := b.AddHandler(&ExecDirectiveHandler{
+		client: client,
+		types:  directive.NewNetworkedTypeSet(types...),
+		skip:   &served,
+	})
+	if err != nil {
+		return err
+	}
+	defer rel()
+
+	return serveDirectives(ctx, client, b, &served, types...)
+}
@@ -0,0 +1,115 @@
+package bus_api
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/sirupsen/logrus"
+)
+
+// TestLinkBus tests resolving directives in both directions without loops.
+func TestLinkBus(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	// addHandler adds a handler resolving a value for the message to b.
+	addHandler := func(b bus.Bus, msg string, printedLen uint32) {
+		_, err := b.AddHandler(directive.NewFuncHandler(func(ctx context.Context, di directive.Instance) ([]directive.Resolver, error) {
+			dir, ok := di.GetDirective().(*uncappedBoilerplate)
+			if !ok || dir.GetMessageText() != msg {
+				return nil, nil
+			}
+			return directive.R(directive.NewValueResolver([]*boilerplate_v1.BoilerplateResult{{PrintedLen: printedLen}}), nil)
+		}))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	addHandler(b, "local", 1)
+
+	rb, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	addHandler(rb, "remote", 2)
+	mux := srpc.NewMux()
+	api := NewAPI(rb, &Config{
+		EnableExecDirective:   true,
+		EnableServeDirectives: true,
+		NoServeExecDirectives: true,
+	}, uncappedBoilerplateType)
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	linkErr := make(chan error, 1)
+	go func() {
+		linkErr <- LinkBus(ctx, client, b, uncappedBoilerplateType)
+	}()
+
+	// expectValue expects the directive on b to resolve to exactly one value.
+	expectValue := func(b bus.Bus, msg string, printedLen uint32) {
+		var mtx sync.Mutex
+		var vals []uint32
+		_, ref, err := b.AddDirective(
+			&uncappedBoilerplate{Boilerplate: &boilerplate_v1.Boilerplate{MessageText: msg}},
+			bus.NewCallbackHandler(
+				func(val directive.AttachedValue) {
+					mtx.Lock()
+					vals = append(vals, val.GetValue().(*boilerplate_v1.BoilerplateResult).GetPrintedLen())
+					mtx.Unlock()
+				},
+				nil,
+				nil,
+			),
+		)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer ref.Release()
+
+		// wait for the value, then check no values are forwarded back
+		var settled time.Time
+		for {
+			mtx.Lock()
+			nvals := append([]uint32(nil), vals...)
+			mtx.Unlock()
+			if len(nvals) > 1 || (len(nvals) == 1 && nvals[0] != printedLen) {
+				t.Fatalf("expected value %d for %q but got %v", printedLen, msg, nvals)
+			}
+			if len(nvals) == 1 {
+				if settled.IsZero() {
+					settled = time.Now()
+				} else if time.Since(settled) > 200*time.Millisecond {
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected value %d for %q", printedLen, msg)
+			case err := <-linkErr:
+				t.Fatalf("link exited: %v", err)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	// directives on the local bus are resolved by the remote bus
+	expectValue(b, "remote", 2)
+	// directives on the remote bus are resolved by the local bus
+	expectValue(rb, "local", 1)
+}
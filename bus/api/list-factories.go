@@ -0,0 +1,79 @@
+package bus_api
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/pkg/errors"
+)
+
+// factoryResolverController is a controller exposing a factory resolver.
+type factoryResolverController interface {
+	// GetFactoryResolver returns the underlying factory resolver.
+	GetFactoryResolver() controller.FactoryResolver
+}
+
+// ListBusFactories lists the factories available to the bus.
+//
+// Checks the factory resolver of each resolver controller on the bus. Skips
+// resolvers that do not implement controller.FactoryLister.
+func ListBusFactories(ctx context.Context, b bus.Bus) ([]*FactoryInfo, error) {
+	var infos []*FactoryInfo
+	seen := make(map[[2]string]struct{})
+	for _, ctrl := range b.GetControllers() {
+		resCtrl, ok := ctrl.(factoryResolverController)
+		if !ok {
+			continue
+		}
+		res := resCtrl.GetFactoryResolver()
+		lister, ok := res.(controller.FactoryLister)
+		if !ok {
+			continue
+		}
+		resolverID := res.GetResolverID()
+		factories, err := lister.ListFactories(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list factories from resolver %s", resolverID)
+		}
+		for _, factory := range factories {
+			configID := factory.GetConfigID()
+			key := [2]string{configID, resolverID}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			info, err := NewFactoryInfo(factory, resolverID)
+			if err != nil {
+				return nil, err
+			}
+			infos = append(infos, info)
+		}
+	}
+	slices.SortFunc(infos, func(a, b *FactoryInfo) int {
+		return cmp.Or(
+			cmp.Compare(a.GetConfigId(), b.GetConfigId()),
+			cmp.Compare(a.GetResolverId(), b.GetResolverId()),
+		)
+	})
+	return infos, nil
+}
+
+// NewFactoryInfo builds the FactoryInfo for a factory.
+func NewFactoryInfo(factory controller.Factory, resolverID string) (*FactoryInfo, error) {
+	configID := factory.GetConfigID()
+	schema, err := config.GetConfigSchema(factory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "build config schema for %s", configID)
+	}
+	return &FactoryInfo{
+		ConfigId:     configID,
+		Version:      factory.GetVersion().String(),
+		ResolverId:   resolverID,
+		ConfigSchema: string(schema),
+	}, nil
+}
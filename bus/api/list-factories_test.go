@@ -0,0 +1,72 @@
+package bus_api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aperturerobotics/controllerbus/controller/resolver/static"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/sirupsen/logrus"
+)
+
+// TestListFactories tests listing the factories and config schemas.
+func TestListFactories(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+
+	mux := srpc.NewMux()
+	api := NewAPI(b, &Config{})
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	resp, err := client.ListFactories(ctx, &ListFactoriesRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var info *FactoryInfo
+	for _, factory := range resp.GetFactories() {
+		if factory.GetConfigId() == boilerplate_controller.ConfigID {
+			info = factory
+		}
+	}
+	if info == nil {
+		t.Fatalf("expected %s in factories list", boilerplate_controller.ConfigID)
+	}
+	if info.GetResolverId() != static.ResolverID {
+		t.Fatalf("unexpected resolver id: %s", info.GetResolverId())
+	}
+	if info.GetVersion() != boilerplate_controller.Version.String() {
+		t.Fatalf("unexpected version: %s", info.GetVersion())
+	}
+
+	var schema struct {
+		Title      string `json:"title"`
+		Properties map[string]struct {
+			Type string `json:"type"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(info.GetConfigSchema()), &schema); err != nil {
+		t.Fatal(err.Error())
+	}
+	if schema.Title != boilerplate_controller.ConfigID {
+		t.Fatalf("unexpected schema title: %s", schema.Title)
+	}
+	for _, field := range []string{"exampleField", "failWithErr"} {
+		if schema.Properties[field].Type != "string" {
+			t.Fatalf("expected string field %s in schema: %s", field, info.GetConfigSchema())
+		}
+	}
+}
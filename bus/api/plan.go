@@ -0,0 +1,149 @@
+//go:build !tinygo
+
+package bus_api
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_json "github.com/aperturerobotics/controllerbus/controller/configset/json"
+	"github.com/pkg/errors"
+)
+
+// PlanBusConfigSet computes the changes to the configset controllers on the
+// bus if the YAML configset was applied.
+//
+// If replace is set, keys of the configset controllers which are not in the
+// configset are stopped, otherwise they are unchanged. The changes are
+// planned as if applied with an ApplyConfigSet directive: a config is applied
+// only if its revision is newer than the applied revision. The daemon config
+// file and the configset store apply changed configs with a newer revision,
+// so keys planned as ignored would be restarted by them. Invalid configs and
+// unknown config ids are reported per key.
+func PlanBusConfigSet(ctx context.Context, b bus.Bus, configSetYAML []byte, replace bool) (*PlanConfigSetResponse, error) {
+	ycs, err := configset_json.UnmarshalConfigSetYAML(configSetYAML)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse configset")
+	}
+	cs, confErrs := ycs.Validate(ctx, b)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]configset.ControllerConfig)
+	for _, ctrl := range b.GetControllers() {
+		csCtrl, ok := ctrl.(configset.Controller)
+		if !ok {
+			continue
+		}
+		for key, conf := range csCtrl.GetConfigSet() {
+			if _, exists := current[key]; !exists && conf != nil && conf.GetConfig() != nil {
+				current[key] = conf
+			}
+		}
+	}
+
+	resp := &PlanConfigSetResponse{}
+	for _, confErr := range confErrs {
+		plan := &ConfigKeyPlan{
+			ConfigKey:       confErr.Key,
+			Action:          PlanAction_PlanAction_INVALID,
+			Error:           confErr.Err.Error(),
+			UnknownConfigId: errors.Is(confErr.Err, configset_json.ErrUnknownConfigID),
+		}
+		if yconf := ycs[confErr.Key]; yconf != nil {
+			plan.ConfigId = yconf.Id
+			plan.Rev = yconf.Rev
+		}
+		if err := plan.setCurrent(current[confErr.Key]); err != nil {
+			return nil, err
+		}
+		resp.Keys = append(resp.Keys, plan)
+	}
+	for key, conf := range cs {
+		if key == "" {
+			continue
+		}
+		plan := &ConfigKeyPlan{
+			ConfigKey: key,
+			ConfigId:  conf.GetConfig().GetConfigID(),
+			Rev:       conf.GetRev(),
+		}
+		confYAML, err := marshalConfigYAML(key, conf)
+		if err != nil {
+			return nil, err
+		}
+		plan.ConfigYaml = confYAML
+
+		curr := current[key]
+		if err := plan.setCurrent(curr); err != nil {
+			return nil, err
+		}
+		switch {
+		case curr == nil:
+			plan.Action = PlanAction_PlanAction_START
+		case conf.GetRev() > curr.GetRev():
+			plan.Action = PlanAction_PlanAction_RESTART
+		case equalControllerConfig(conf, curr):
+			plan.Action = PlanAction_PlanAction_UNCHANGED
+		default:
+			plan.Action = PlanAction_PlanAction_IGNORED
+		}
+		resp.Keys = append(resp.Keys, plan)
+	}
+	for key, curr := range current {
+		if _, ok := ycs[key]; ok {
+			continue
+		}
+		plan := &ConfigKeyPlan{
+			ConfigKey: key,
+			Action:    PlanAction_PlanAction_UNCHANGED,
+			ConfigId:  curr.GetConfig().GetConfigID(),
+		}
+		if replace {
+			plan.Action = PlanAction_PlanAction_STOP
+		}
+		if err := plan.setCurrent(curr); err != nil {
+			return nil, err
+		}
+		resp.Keys = append(resp.Keys, plan)
+	}
+
+	slices.SortFunc(resp.Keys, func(a, b *ConfigKeyPlan) int {
+		return strings.Compare(a.GetConfigKey(), b.GetConfigKey())
+	})
+	return resp, nil
+}
+
+// setCurrent sets the applied config fields if curr is not nil.
+func (p *ConfigKeyPlan) setCurrent(curr configset.ControllerConfig) error {
+	if curr == nil {
+		return nil
+	}
+	currYAML, err := marshalConfigYAML(p.GetConfigKey(), curr)
+	if err != nil {
+		return err
+	}
+	p.CurrentRev = curr.GetRev()
+	p.CurrentConfigYaml = currYAML
+	return nil
+}
+
+// marshalConfigYAML marshals the controller config with the key to yaml.
+func marshalConfigYAML(key string, conf configset.ControllerConfig) (string, error) {
+	dat, err := configset_json.MarshalYAML(configset.ConfigSet{key: conf})
+	if err != nil {
+		return "", errors.Wrapf(err, "marshal config %s", key)
+	}
+	return string(dat), nil
+}
+
+// equalControllerConfig checks if the configs are equal ignoring the revision.
+func equalControllerConfig(a, b configset.ControllerConfig) bool {
+	return a.GetConfig().EqualsConfig(b.GetConfig()) &&
+		a.GetRestartPolicy().EqualVT(b.GetRestartPolicy()) &&
+		slices.Equal(a.GetDependsOn(), b.GetDependsOn())
+}
@@ -0,0 +1,136 @@
+package bus_api
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/sirupsen/logrus"
+)
+
+// TestPlanConfigSet tests planning the changes of a configset.
+func TestPlanConfigSet(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+
+	_, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+
+	newConf := func(rev uint64, name string) configset.ControllerConfig {
+		return configset.NewControllerConfig(rev, &boilerplate_controller.Config{ExampleField: name})
+	}
+	_, applyRef, err := b.AddDirective(configset.NewApplyConfigSet(configset.ConfigSet{
+		"ignored":   newConf(2, "before"),
+		"restarted": newConf(1, "before"),
+		"stopped":   newConf(1, "stopped"),
+		"unchanged": newConf(1, "unchanged"),
+	}), nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer applyRef.Release()
+
+	mux := srpc.NewMux()
+	api := NewAPI(b, &Config{})
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	for {
+		resp, err := client.GetBusInfo(ctx, &GetBusInfoRequest{})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(resp.GetConfigSetControllers()) == 4 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal(ctx.Err().Error())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	confID := boilerplate_controller.ConfigID
+	csYAML := strings.Join([]string{
+		"ignored: {id: " + confID + ", rev: 1, config: {exampleField: after}}",
+		"invalid: {id: unknown/config}",
+		"restarted: {id: " + confID + ", rev: 2, config: {exampleField: after}}",
+		"started: {id: " + confID + ", rev: 1, config: {exampleField: started}}",
+		"unchanged: {id: " + confID + ", rev: 1, config: {exampleField: unchanged}}",
+	}, "\n")
+	resp, err := client.PlanConfigSet(ctx, &PlanConfigSetRequest{ConfigSetYaml: csYAML})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// applied keys missing from the configset are unchanged when adding it
+	keys := resp.GetKeys()
+	if len(keys) != 6 || keys[4].GetConfigKey() != "stopped" || keys[4].GetAction() != PlanAction_PlanAction_UNCHANGED {
+		t.Fatalf("unexpected plan: %v", resp.String())
+	}
+
+	// applied keys missing from the configset are stopped when replacing
+	resp, err = client.PlanConfigSet(ctx, &PlanConfigSetRequest{ConfigSetYaml: csYAML, Replace: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := []struct {
+		key    string
+		action PlanAction
+	}{
+		{"ignored", PlanAction_PlanAction_IGNORED},
+		{"invalid", PlanAction_PlanAction_INVALID},
+		{"restarted", PlanAction_PlanAction_RESTART},
+		{"started", PlanAction_PlanAction_START},
+		{"stopped", PlanAction_PlanAction_STOP},
+		{"unchanged", PlanAction_PlanAction_UNCHANGED},
+	}
+	keys = resp.GetKeys()
+	if len(keys) != len(expected) {
+		t.Fatalf("unexpected plan: %v", resp.String())
+	}
+	for i, exp := range expected {
+		if keys[i].GetConfigKey() != exp.key || keys[i].GetAction() != exp.action {
+			t.Fatalf("expected %s to %v but got %v", exp.key, exp.action, keys[i].String())
+		}
+	}
+	if !keys[1].GetUnknownConfigId() || keys[1].GetError() == "" {
+		t.Fatalf("expected unknown config id: %v", keys[1].String())
+	}
+	restarted := keys[2]
+	if restarted.GetCurrentRev() != 1 || restarted.GetRev() != 2 ||
+		!strings.Contains(restarted.GetCurrentConfigYaml(), "before") ||
+		!strings.Contains(restarted.GetConfigYaml(), "after") {
+		t.Fatalf("unexpected restarted plan: %v", restarted.String())
+	}
+	if stopped := keys[4]; stopped.GetConfigId() != confID || stopped.GetConfigYaml() != "" {
+		t.Fatalf("unexpected stopped plan: %v", stopped.String())
+	}
+}
@@ -0,0 +1,16 @@
+//go:build tinygo
+
+package bus_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+)
+
+// PlanBusConfigSet computes the changes to the configset controllers on the
+// bus if the YAML configset was applied.
+func PlanBusConfigSet(ctx context.Context, b bus.Bus, configSetYAML []byte, replace bool) (*PlanConfigSetResponse, error) {
+	return nil, errors.New("yaml controller config sets are unsupported in tinygo")
+}
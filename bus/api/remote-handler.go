@@ -0,0 +1,180 @@
+package bus_api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/pkg/errors"
+)
+
+// remoteHandler forwards directives to a remote process with ServeDirectives.
+type remoteHandler struct {
+	// ctx is canceled when the remote disconnects
+	ctx context.Context
+	// types are the types handled by the remote
+	types directive.NetworkedTypeSet
+	// skip contains directives added for the remote, may be nil
+	skip *servedDirectives
+	// queue contains messages to send to the remote
+	queue *sendQueue[*ServeDirectivesResponse]
+
+	// mtx guards below fields
+	mtx sync.Mutex
+	// resolverID is the id of the last resolver
+	resolverID uint32
+	// resolvers contains the running resolvers by id
+	resolvers map[uint32]*remoteResolverCall
+}
+
+// newRemoteHandler constructs a new remoteHandler.
+func newRemoteHandler(
+	ctx context.Context,
+	types directive.NetworkedTypeSet,
+	skip *servedDirectives,
+	queue *sendQueue[*ServeDirectivesResponse],
+) *remoteHandler {
+	return &remoteHandler{
+		ctx:       ctx,
+		types:     types,
+		skip:      skip,
+		queue:     queue,
+		resolvers: make(map[uint32]*remoteResolverCall),
+	}
+}
+
+// HandleDirective asks if the handler can resolve the directive.
+func (h *remoteHandler) HandleDirective(
+	ctx context.Context,
+	di directive.Instance,
+) ([]directive.Resolver, error) {
+	dir := di.GetDirective()
+	dirType := h.types.GetNetworkedTypeForDirective(dir)
+	if dirType == nil || h.skip.has(dir) {
+		return nil, nil
+	}
+	return directive.R(&remoteResolver{
+		h:       h,
+		dirType: dirType,
+		dir:     dir.(directive.Networked),
+	}, nil)
+}
+
+// handleResolverEvent applies an event from the remote to a resolver.
+// Ignores events for resolvers which are no longer running.
+func (h *remoteHandler) handleResolverEvent(msg *ServeDirectivesRequest) error {
+	h.mtx.Lock()
+	res := h.resolvers[msg.GetResolverId()]
+	h.mtx.Unlock()
+	if res == nil {
+		return nil
+	}
+	return res.handleEvent(msg)
+}
+
+// remoteResolver resolves a directive with a remote handler.
+type remoteResolver struct {
+	h       *remoteHandler
+	dirType directive.NetworkedType
+	dir     directive.Networked
+}
+
+// remoteResolverCall is the state for a single call to Resolve.
+type remoteResolverCall struct {
+	dirType directive.NetworkedType
+	handler directive.ResolverHandler
+	// errCh receives an error from the remote
+	errCh chan error
+
+	// mtx guards valueIDs
+	mtx sync.Mutex
+	// valueIDs maps remote value ids to local value ids
+	valueIDs map[uint32]uint32
+}
+
+// Resolve resolves the values, emitting them to the handler.
+func (r *remoteResolver) Resolve(ctx context.Context, handler directive.ResolverHandler) error {
+	body, err := r.dir.GetNetworkedCodec().Marshal(r.dir)
+	if err != nil {
+		return errors.Wrap(err, "marshal directive")
+	}
+
+	// the remote resolves the full value set on each call
+	_ = handler.ClearValues()
+	defer handler.ClearValues()
+
+	call := &remoteResolverCall{
+		dirType:  r.dirType,
+		handler:  handler,
+		errCh:    make(chan error, 1),
+		valueIDs: make(map[uint32]uint32),
+	}
+
+	h := r.h
+	h.mtx.Lock()
+	h.resolverID++
+	id := h.resolverID
+	h.resolvers[id] = call
+	h.mtx.Unlock()
+
+	h.queue.push(&ServeDirectivesResponse{
+		ResolverId:      id,
+		DirectiveTypeId: r.dirType.GetNetworkedTypeID(),
+		DirectiveBody:   body,
+	})
+	defer func() {
+		h.mtx.Lock()
+		delete(h.resolvers, id)
+		h.mtx.Unlock()
+		h.queue.push(&ServeDirectivesResponse{ResolverId: id, Cancel: true})
+	}()
+
+	select {
+	case <-ctx.Done():
+		return context.Canceled
+	case <-h.ctx.Done():
+		return context.Canceled
+	case err := <-call.errCh:
+		return err
+	}
+}
+
+// handleEvent applies an event from the remote.
+func (r *remoteResolverCall) handleEvent(msg *ServeDirectivesRequest) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if errStr := msg.GetResolverError(); errStr != "" {
+		select {
+		case r.errCh <- errors.New(errStr):
+		default:
+		}
+		return nil
+	}
+
+	ev := msg.GetResolverEvent()
+	switch ev.GetEventType() {
+	case ExecDirectiveEventType_ExecDirectiveEventType_VALUE_ADDED:
+		val, err := r.dirType.UnmarshalValue(ev.GetValueBody())
+		if err != nil {
+			return errors.Wrap(err, "unmarshal value")
+		}
+		if id, accepted := r.handler.AddValue(val); accepted {
+			r.valueIDs[ev.GetValueId()] = id
+		}
+	case ExecDirectiveEventType_ExecDirectiveEventType_VALUE_REMOVED:
+		if id, ok := r.valueIDs[ev.GetValueId()]; ok {
+			delete(r.valueIDs, ev.GetValueId())
+			_, _ = r.handler.RemoveValue(id)
+		}
+	case ExecDirectiveEventType_ExecDirectiveEventType_IDLE:
+		r.handler.MarkIdle(ev.GetIdle())
+	}
+	return nil
+}
+
+// _ is a type assertion
+var (
+	_ directive.Handler  = ((*remoteHandler)(nil))
+	_ directive.Resolver = ((*remoteResolver)(nil))
+)
@@ -0,0 +1,88 @@
+package bus_api
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/aperturerobotics/util/broadcast"
+)
+
+// ErrSendQueueFull is returned if the remote does not keep up with the messages
+// queued for a stream.
+var ErrSendQueueFull = errors.New("send queue is full")
+
+// sendQueueLimit is the maximum number of messages waiting to be sent.
+// Accessed atomically as tests change it while other streams are running.
+var sendQueueLimit atomic.Int32
+
+func init() {
+	sendQueueLimit.Store(4096)
+}
+
+// sendQueue buffers messages for a stream so that bus callbacks don't block.
+//
+// The queue fails with ErrSendQueueFull if more than sendQueueLimit messages
+// are waiting to be sent.
+type sendQueue[T any] struct {
+	// bcast guards below fields
+	bcast broadcast.Broadcast
+	msgs  []T
+	err   error
+}
+
+// push queues a message to be sent.
+// Does nothing if the queue has failed.
+// Fails the queue dropping the pending messages if it is full.
+func (q *sendQueue[T]) push(msg T) {
+	q.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+		if q.err != nil {
+			return
+		}
+		if len(q.msgs) >= int(sendQueueLimit.Load()) {
+			q.msgs, q.err = nil, ErrSendQueueFull
+		} else {
+			q.msgs = append(q.msgs, msg)
+		}
+		broadcast()
+	})
+}
+
+// fail stops the queue with an error once the pending messages are sent.
+func (q *sendQueue[T]) fail(err error) {
+	q.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+		if q.err == nil {
+			q.err = err
+			broadcast()
+		}
+	})
+}
+
+// drain sends queued messages until ctx is canceled or the queue fails.
+func (q *sendQueue[T]) drain(ctx context.Context, send func(T) error) error {
+	for {
+		var msgs []T
+		var err error
+		var waitCh <-chan struct{}
+		q.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+			msgs, q.msgs = q.msgs, nil
+			err = q.err
+			waitCh = getWaitCh()
+		})
+
+		for _, msg := range msgs {
+			if sendErr := send(msg); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case <-waitCh:
+		}
+	}
+}
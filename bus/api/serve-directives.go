@@ -0,0 +1,144 @@
+package bus_api
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/pkg/errors"
+)
+
+// ServeDirectives registers the local bus as a handler on a remote bus for
+// the networked directive types.
+//
+// Directives raised on the remote bus are executed on the local bus, and the
+// resolved values are attached to the remote directive instances. The remote
+// removes the handler and its values when this function returns.
+//
+// Blocks until ctx is canceled or the stream fails.
+func ServeDirectives(
+	ctx context.Context,
+	client SRPCControllerBusServiceClient,
+	b bus.Bus,
+	types ...directive.NetworkedType,
+) error {
+	return serveDirectives(ctx, client, b, nil, types...)
+}
+
+// serveDirectives implements ServeDirectives.
+//
+// Directives executed on the local bus are added to served if set.
+func serveDirectives(
+	ctx context.Context,
+	client SRPCControllerBusServiceClient,
+	b bus.Bus,
+	served *servedDirectives,
+	types ...directive.NetworkedType,
+) error {
+	typeSet := directive.NewNetworkedTypeSet(types...)
+	typeIDs := make([]string, 0, len(typeSet))
+	for typeID := range typeSet {
+		typeIDs = append(typeIDs, typeID)
+	}
+	slices.Sort(typeIDs)
+
+	subCtx, subCtxCancel := context.WithCancel(ctx)
+	defer subCtxCancel()
+
+	strm, err := client.ServeDirectives(subCtx)
+	if err != nil {
+		return err
+	}
+	defer strm.Close()
+
+	if err := strm.Send(&ServeDirectivesRequest{DirectiveTypeIds: typeIDs}); err != nil {
+		return err
+	}
+
+	var queue sendQueue[*ServeDirectivesRequest]
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- queue.drain(subCtx, strm.Send)
+		// end Recv if sending failed
+		subCtxCancel()
+		_ = strm.Close()
+	}()
+
+	// refs contains the directive references by resolver id
+	refs := make(map[uint32]func())
+	defer func() {
+		for _, rel := range refs {
+			rel()
+		}
+	}()
+
+	for {
+		msg, err := strm.Recv()
+		if err != nil {
+			select {
+			case sendErr := <-errCh:
+				if sendErr != context.Canceled {
+					return sendErr
+				}
+			default:
+			}
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		resolverID := msg.GetResolverId()
+		if rel := refs[resolverID]; rel != nil {
+			delete(refs, resolverID)
+			rel()
+		}
+		if msg.GetCancel() {
+			continue
+		}
+
+		rel, err := serveDirective(b, typeSet, served, msg, func(ev *ExecDirectiveResponse, err error) {
+			if err != nil {
+				queue.push(&ServeDirectivesRequest{ResolverId: resolverID, ResolverError: err.Error()})
+			} else {
+				queue.push(&ServeDirectivesRequest{ResolverId: resolverID, ResolverEvent: ev})
+			}
+		})
+		if err != nil {
+			queue.push(&ServeDirectivesRequest{ResolverId: resolverID, ResolverError: err.Error()})
+			continue
+		}
+		refs[resolverID] = rel
+	}
+}
+
+// serveDirective executes a directive requested by the remote bus.
+func serveDirective(
+	b bus.Bus,
+	types directive.NetworkedTypeSet,
+	served *servedDirectives,
+	msg *ServeDirectivesResponse,
+	cb func(ev *ExecDirectiveResponse, err error),
+) (func(), error) {
+	typeID := msg.GetDirectiveTypeId()
+	dirType := types.GetNetworkedType(typeID)
+	if dirType == nil {
+		return nil, errors.Wrap(ErrUnknownNetworkedType, typeID)
+	}
+	dir, err := decodeNetworked(dirType, msg.GetDirectiveBody())
+	if err != nil {
+		return nil, err
+	}
+	relServed := served.add(dir)
+	rel, err := ExecDirectiveEvents(b, dirType, dir, cb)
+	if err != nil {
+		relServed()
+		return nil, err
+	}
+	return func() {
+		rel()
+		relServed()
+	}, nil
+}
@@ -0,0 +1,185 @@
+package bus_api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/example/boilerplate"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/sirupsen/logrus"
+)
+
+// TestServeDirectives tests resolving directives with a remote handler.
+func TestServeDirectives(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	log := logrus.New()
+	log.SetLevel(logrus.DebugLevel)
+	le := logrus.NewEntry(log)
+
+	// daemon bus without the boilerplate controller
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	mux := srpc.NewMux()
+	api := NewAPI(b, &Config{EnableServeDirectives: true}, boilerplate_v1.NetworkedType)
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	// remote bus with the boilerplate controller
+	rb, rsr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	rsr.AddFactory(boilerplate_controller.NewFactory(rb))
+	execDir := resolver.NewLoadControllerWithConfig(&boilerplate_controller.Config{
+		ExampleField: "testing",
+	})
+	_, _, ctrlRef, err := bus.ExecOneOff(ctx, rb, execDir, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ctrlRef.Release()
+
+	serveCtx, serveCtxCancel := context.WithCancel(ctx)
+	defer serveCtxCancel()
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- ServeDirectives(serveCtx, client, rb, boilerplate_v1.NetworkedType)
+	}()
+
+	disposed := make(chan struct{})
+	res, _, resRef, err := bus.ExecOneOff(ctx, b, &boilerplate_v1.Boilerplate{
+		MessageText: "hello world",
+	}, nil, func() {
+		select {
+		case <-disposed:
+		default:
+			close(disposed)
+		}
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resRef.Release()
+	plen := res.GetValue().(boilerplate.BoilerplateResult).GetPrintedLen()
+	if plen != 55 {
+		t.Fatalf("expected length 55 got %d", plen)
+	}
+
+	// disconnecting the remote handler removes the value
+	serveCtxCancel()
+	select {
+	case <-disposed:
+	case <-time.After(time.Second * 5):
+		t.Fatal("expected value to be removed after disconnect")
+	}
+	<-serveErr
+}
+
+// failSendClient is a client with ServeDirectives streams that fail to send
+// after the first message.
+type failSendClient struct {
+	SRPCControllerBusServiceClient
+}
+
+// ServeDirectives registers the caller as a handler for networked directives.
+func (c *failSendClient) ServeDirectives(ctx context.Context) (SRPCControllerBusService_ServeDirectivesClient, error) {
+	strm, err := c.SRPCControllerBusServiceClient.ServeDirectives(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &failSendStream{SRPCControllerBusService_ServeDirectivesClient: strm}, nil
+}
+
+// failSendStream is a ServeDirectives stream that fails to send after the
+// first message.
+type failSendStream struct {
+	SRPCControllerBusService_ServeDirectivesClient
+	sent bool
+}
+
+// errTestSend is returned by failSendStream.
+var errTestSend = errors.New("test send error")
+
+// Send sends the first message and fails afterwards.
+func (s *failSendStream) Send(msg *ServeDirectivesRequest) error {
+	if s.sent {
+		return errTestSend
+	}
+	s.sent = true
+	return s.SRPCControllerBusService_ServeDirectivesClient.Send(msg)
+}
+
+// TestServeDirectivesSendError tests returning when sending to the remote fails.
+func TestServeDirectivesSendError(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	mux := srpc.NewMux()
+	api := NewAPI(b, &Config{EnableServeDirectives: true}, boilerplate_v1.NetworkedType)
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	rb, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// runServe raises a directive on the daemon bus and waits for ServeDirectives
+	// to return the error sending the events.
+	runServe := func(client SRPCControllerBusServiceClient, expected error) {
+		serveErr := make(chan error, 1)
+		go func() {
+			serveErr <- ServeDirectives(ctx, client, rb, boilerplate_v1.NetworkedType)
+		}()
+
+		// raise the directive until the remote handler was registered
+		for {
+			_, ref, err := b.AddDirective(&boilerplate_v1.Boilerplate{MessageText: "hello world"}, nil)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected %v", expected)
+			case err := <-serveErr:
+				ref.Release()
+				// the error may be returned by either side of the stream
+				if err == nil || !strings.Contains(err.Error(), expected.Error()) {
+					t.Fatalf("expected %v but got %v", expected, err)
+				}
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+			ref.Release()
+		}
+	}
+
+	// the stream fails if sending an event fails
+	runServe(&failSendClient{SRPCControllerBusServiceClient: client}, errTestSend)
+
+	// the stream fails if the remote does not keep up with the events
+	prevSendQueueLimit := sendQueueLimit.Swap(0)
+	defer sendQueueLimit.Store(prevSendQueueLimit)
+	runServe(client, ErrSendQueueFull)
+}
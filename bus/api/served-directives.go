@@ -0,0 +1,55 @@
+package bus_api
+
+import (
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// servedDirectives tracks directives added to the bus for a remote bus.
+//
+// Handlers forwarding directives to the same remote skip these directives to
+// avoid forwarding them back to the remote in a loop. A nil set is empty.
+type servedDirectives struct {
+	// mtx guards dirs
+	mtx sync.Mutex
+	// dirs contains the number of times each directive was added
+	dirs map[directive.Directive]int
+}
+
+// add adds a directive to the set.
+// Returns a function to remove it.
+func (s *servedDirectives) add(dir directive.Directive) func() {
+	if s == nil {
+		return func() {}
+	}
+	s.mtx.Lock()
+	if s.dirs == nil {
+		s.dirs = make(map[directive.Directive]int)
+	}
+	s.dirs[dir]++
+	s.mtx.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mtx.Lock()
+			if s.dirs[dir] <= 1 {
+				delete(s.dirs, dir)
+			} else {
+				s.dirs[dir]--
+			}
+			s.mtx.Unlock()
+		})
+	}
+}
+
+// has checks if the directive was added for the remote.
+func (s *servedDirectives) has(dir directive.Directive) bool {
+	if s == nil {
+		return false
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.dirs[dir] != 0
+}
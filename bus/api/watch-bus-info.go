@@ -0,0 +1,317 @@
+package bus_api
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// WatchBusInfoEvents watches the controllers and directives on the bus.
+//
+// Calls cb with a SNAPSHOT event followed by incremental events until ctx is
+// canceled. The SNAPSHOT contains the state of each directive. Every
+// controller and directive added after the snapshot is reported with an added
+// and removed event, even if it is removed before the event is emitted. cb
+// should not block.
+func WatchBusInfoEvents(ctx context.Context, b bus.Bus, cb func(ev *WatchBusInfoResponse)) error {
+	w := &busInfoWatcher{
+		cb:          cb,
+		wake:        make(chan struct{}, 1),
+		controllers: make(map[controller.Controller]uint32),
+		directives:  make(map[directive.Instance]*watchedDirective),
+	}
+	defer w.release()
+
+	// the callbacks are called with the existing controllers and directives
+	relCtrls := b.AddControllersCallback(func(ctrl controller.Controller, added bool) {
+		w.push(busInfoEvent{ctrl: ctrl, added: added})
+	})
+	defer relCtrls()
+	relDirs := b.AddDirectivesCallback(func(di directive.Instance, added bool) {
+		w.push(busInfoEvent{di: di, added: added})
+	})
+	defer relDirs()
+
+	if err := w.process(ctx, w.drain(), true); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case <-w.wake:
+		}
+		if err := w.process(ctx, w.drain(), false); err != nil {
+			return err
+		}
+	}
+}
+
+// busInfoEvent is a controller or directive added or removed event.
+type busInfoEvent struct {
+	// ctrl is the controller if this is a controller event
+	ctrl controller.Controller
+	// di is the directive if this is a directive event
+	di directive.Instance
+	// added indicates if the controller or directive was added or removed
+	added bool
+}
+
+// busInfoWatcher tracks the controllers and directives on a bus.
+type busInfoWatcher struct {
+	cb func(ev *WatchBusInfoResponse)
+	// wake is signaled when events are pushed to the queue
+	wake chan struct{}
+
+	// queueMtx guards queue
+	queueMtx sync.Mutex
+	// queue contains the events in the order they occurred
+	queue []busInfoEvent
+
+	// handleCtr is the last assigned handle
+	handleCtr uint32
+	// controllers contains the handles of the known controllers
+	controllers map[controller.Controller]uint32
+	// directives contains the known directive instances
+	directives map[directive.Instance]*watchedDirective
+
+	// mtx guards calling cb and the watchedDirective state fields
+	mtx sync.Mutex
+}
+
+// watchedDirective is a directive instance tracked by busInfoWatcher.
+type watchedDirective struct {
+	handle uint32
+	// relState releases the state callback
+	relState func()
+	// removed indicates the removed event was emitted
+	removed bool
+	// snapshot is the entry in the SNAPSHOT which is not sent yet, if set
+	// the state callback sets the state of the entry instead of emitting it
+	snapshot *WatchBusInfoDirective
+	// gotState is called with the first state, if set
+	gotState func()
+}
+
+// push pushes an event to the queue.
+//
+// Called while the bus or directive controller is locked.
+func (w *busInfoWatcher) push(ev busInfoEvent) {
+	w.queueMtx.Lock()
+	w.queue = append(w.queue, ev)
+	w.queueMtx.Unlock()
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// drain returns and clears the queued events.
+func (w *busInfoWatcher) drain() []busInfoEvent {
+	w.queueMtx.Lock()
+	evs := w.queue
+	w.queue = nil
+	w.queueMtx.Unlock()
+	return evs
+}
+
+// process emits the queued events in order.
+//
+// If snapshot is set, emits a single SNAPSHOT event with the items which were
+// not removed and the state of the directives. Returns an error if ctx is
+// canceled while waiting for the state.
+func (w *busInfoWatcher) process(ctx context.Context, evs []busInfoEvent, snapshot bool) error {
+	var snapshotCtrls []*WatchBusInfoController
+	var snapshotDirs []*WatchBusInfoDirective
+	// snapshotRemoved contains the handles removed before the SNAPSHOT
+	var snapshotRemoved map[uint32]struct{}
+	var addedDirs []directive.Instance
+	for _, ev := range evs {
+		switch {
+		case ev.ctrl != nil && ev.added:
+			w.handleCtr++
+			w.controllers[ev.ctrl] = w.handleCtr
+			ctrl := &WatchBusInfoController{Handle: w.handleCtr, Info: ev.ctrl.GetControllerInfo()}
+			if snapshot {
+				snapshotCtrls = append(snapshotCtrls, ctrl)
+				continue
+			}
+			w.emit(WatchBusInfoEventType_WatchBusInfoEventType_CONTROLLER_ADDED, ctrl, nil)
+		case ev.ctrl != nil:
+			handle, ok := w.controllers[ev.ctrl]
+			if !ok {
+				continue
+			}
+			delete(w.controllers, ev.ctrl)
+			if snapshot {
+				if snapshotRemoved == nil {
+					snapshotRemoved = make(map[uint32]struct{})
+				}
+				snapshotRemoved[handle] = struct{}{}
+				continue
+			}
+			ctrl := &WatchBusInfoController{Handle: handle, Info: ev.ctrl.GetControllerInfo()}
+			w.emit(WatchBusInfoEventType_WatchBusInfoEventType_CONTROLLER_REMOVED, ctrl, nil)
+		case ev.added:
+			w.handleCtr++
+			wd := &watchedDirective{handle: w.handleCtr}
+			w.directives[ev.di] = wd
+			addedDirs = append(addedDirs, ev.di)
+			dir := &WatchBusInfoDirective{Handle: w.handleCtr, State: directive.NewDirectiveState(ev.di)}
+			if snapshot {
+				wd.snapshot = dir
+				snapshotDirs = append(snapshotDirs, dir)
+				continue
+			}
+			w.emit(WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_ADDED, nil, dir)
+		default:
+			wd, ok := w.directives[ev.di]
+			if !ok {
+				continue
+			}
+			delete(w.directives, ev.di)
+			if snapshot {
+				// the state callback is not attached yet
+				if snapshotRemoved == nil {
+					snapshotRemoved = make(map[uint32]struct{})
+				}
+				snapshotRemoved[wd.handle] = struct{}{}
+				continue
+			}
+			w.mtx.Lock()
+			wd.removed = true
+			w.mtx.Unlock()
+			dir := &WatchBusInfoDirective{Handle: wd.handle, State: directive.NewDirectiveState(ev.di)}
+			w.emit(WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_REMOVED, nil, dir)
+			// release the state callback outside of mtx: it may be running
+			if wd.relState != nil {
+				wd.relState()
+			}
+		}
+	}
+	var snapshotWait sync.WaitGroup
+	if snapshot {
+		snapshotCtrls = slices.DeleteFunc(snapshotCtrls, func(ctrl *WatchBusInfoController) bool {
+			_, removed := snapshotRemoved[ctrl.GetHandle()]
+			return removed
+		})
+		snapshotDirs = slices.DeleteFunc(snapshotDirs, func(dir *WatchBusInfoDirective) bool {
+			_, removed := snapshotRemoved[dir.GetHandle()]
+			return removed
+		})
+		for _, wd := range w.directives {
+			snapshotWait.Add(1)
+			wd.gotState = snapshotWait.Done
+		}
+	}
+
+	// attach the state callbacks after emitting the added events, or before
+	// emitting the SNAPSHOT to include the state in it
+	for _, di := range addedDirs {
+		wd, ok := w.directives[di]
+		if !ok {
+			continue
+		}
+		wd.relState = di.AddStateCallback(func(isIdle bool, errs []error, vals []directive.AttachedValue) {
+			ev := NewWatchBusInfoStateResponse(wd.handle, isIdle, errs, len(vals))
+			w.mtx.Lock()
+			defer w.mtx.Unlock()
+			if wd.removed {
+				return
+			}
+			if wd.snapshot == nil {
+				w.cb(ev)
+				return
+			}
+			state := ev.GetDirectives()[0]
+			wd.snapshot.Idle = state.GetIdle()
+			wd.snapshot.ValueCount = state.GetValueCount()
+			wd.snapshot.ResolverErrors = state.GetResolverErrors()
+			if wd.gotState != nil {
+				wd.gotState()
+				wd.gotState = nil
+			}
+		})
+	}
+	if !snapshot {
+		return nil
+	}
+
+	// wait for the state of the directives in the SNAPSHOT
+	waitCh := make(chan struct{})
+	go func() {
+		snapshotWait.Wait()
+		close(waitCh)
+	}()
+	select {
+	case <-ctx.Done():
+		return context.Canceled
+	case <-waitCh:
+	}
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	w.cb(&WatchBusInfoResponse{
+		EventType:   WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT,
+		Controllers: snapshotCtrls,
+		Directives:  snapshotDirs,
+	})
+	for _, wd := range w.directives {
+		wd.snapshot = nil
+	}
+	return nil
+}
+
+// emit emits an event with a controller or directive.
+func (w *busInfoWatcher) emit(eventType WatchBusInfoEventType, ctrl *WatchBusInfoController, dir *WatchBusInfoDirective) {
+	ev := &WatchBusInfoResponse{EventType: eventType}
+	if ctrl != nil {
+		ev.Controllers = []*WatchBusInfoController{ctrl}
+	}
+	if dir != nil {
+		ev.Directives = []*WatchBusInfoDirective{dir}
+	}
+	w.mtx.Lock()
+	w.cb(ev)
+	w.mtx.Unlock()
+}
+
+// release releases the state callbacks.
+func (w *busInfoWatcher) release() {
+	w.mtx.Lock()
+	for _, wd := range w.directives {
+		wd.removed = true
+		if wd.gotState != nil {
+			wd.gotState()
+			wd.gotState = nil
+		}
+	}
+	w.mtx.Unlock()
+	for _, wd := range w.directives {
+		if wd.relState != nil {
+			wd.relState()
+		}
+	}
+}
+
+// NewWatchBusInfoStateResponse constructs a DIRECTIVE_STATE event.
+func NewWatchBusInfoStateResponse(handle uint32, isIdle bool, errs []error, valueCount int) *WatchBusInfoResponse {
+	dir := &WatchBusInfoDirective{
+		Handle:     handle,
+		Idle:       isIdle,
+		ValueCount: uint32(valueCount), //nolint:gosec
+	}
+	for _, err := range errs {
+		if err != nil {
+			dir.ResolverErrors = append(dir.ResolverErrors, err.Error())
+		}
+	}
+	return &WatchBusInfoResponse{
+		EventType:  WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_STATE,
+		Directives: []*WatchBusInfoDirective{dir},
+	}
+}
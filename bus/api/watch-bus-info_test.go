@@ -0,0 +1,278 @@
+package bus_api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
+	directive_mock "github.com/aperturerobotics/controllerbus/directive/mock"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/sirupsen/logrus"
+)
+
+// TestWatchBusInfo tests streaming controller and directive events.
+func TestWatchBusInfo(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+
+	mux := srpc.NewMux()
+	api := NewAPI(b, &Config{})
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		t.Fatal(err.Error())
+	}
+	client := NewSRPCControllerBusServiceClient(srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux))))
+
+	strm, err := client.WatchBusInfo(ctx, &WatchBusInfoRequest{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer strm.Close()
+
+	ev, err := strm.Recv()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if ev.GetEventType() != WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT {
+		t.Fatalf("expected snapshot got %v", ev.GetEventType())
+	}
+
+	// wait for the event matching the filter
+	waitEvent := func(eventType WatchBusInfoEventType, match func(ev *WatchBusInfoResponse) bool) *WatchBusInfoResponse {
+		for {
+			ev, err := strm.Recv()
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if ev.GetEventType() == eventType && match(ev) {
+				return ev
+			}
+		}
+	}
+
+	_, _, ctrlRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&boilerplate_controller.Config{ExampleField: "testing"}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ctrlRef.Release()
+	waitEvent(WatchBusInfoEventType_WatchBusInfoEventType_CONTROLLER_ADDED, func(ev *WatchBusInfoResponse) bool {
+		for _, ctrl := range ev.GetControllers() {
+			if ctrl.GetInfo().GetId() == boilerplate_controller.ControllerID {
+				return true
+			}
+		}
+		return false
+	})
+
+	_, _, resRef, err := bus.ExecOneOff(ctx, b, &boilerplate_v1.Boilerplate{MessageText: "hello world"}, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var handle uint32
+	waitEvent(WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_ADDED, func(ev *WatchBusInfoResponse) bool {
+		for _, dir := range ev.GetDirectives() {
+			if dir.GetState().GetInfo().GetName() == "Boilerplate" {
+				handle = dir.GetHandle()
+				return true
+			}
+		}
+		return false
+	})
+	waitEvent(WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_STATE, func(ev *WatchBusInfoResponse) bool {
+		dir := ev.GetDirectives()[0]
+		return dir.GetHandle() == handle && dir.GetValueCount() == 1
+	})
+
+	resRef.Release()
+	waitEvent(WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_REMOVED, func(ev *WatchBusInfoResponse) bool {
+		for _, dir := range ev.GetDirectives() {
+			if dir.GetHandle() == handle {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// TestWatchBusInfoEventsShortLived tests that directives added and released
+// before the watcher processes them are reported as added and removed.
+func TestWatchBusInfoEventsShortLived(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// block the watcher after the snapshot until all directives are released
+	evCh := make(chan *WatchBusInfoResponse, 1024)
+	gate := make(chan struct{})
+	watchCtx, watchCtxCancel := context.WithCancel(ctx)
+	defer watchCtxCancel()
+	go func() {
+		_ = WatchBusInfoEvents(watchCtx, b, func(ev *WatchBusInfoResponse) {
+			evCh <- ev
+			if ev.GetEventType() == WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT {
+				<-gate
+			}
+		})
+	}()
+
+	// wait for the snapshot before adding the directives
+	select {
+	case <-ctx.Done():
+		t.Fatal(ctx.Err().Error())
+	case ev := <-evCh:
+		if ev.GetEventType() != WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT {
+			t.Fatalf("expected snapshot got %v", ev.GetEventType())
+		}
+	}
+
+	const count = 100
+	for range count {
+		_, ref, err := b.AddDirective(&directive_mock.MockDirective{}, nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		ref.Release()
+	}
+	close(gate)
+
+	added := make(map[uint32]struct{})
+	var removed int
+	for removed != count {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected %d added and removed events but got %d added and %d removed", count, len(added), removed)
+		case ev := <-evCh:
+			for _, dir := range ev.GetDirectives() {
+				switch ev.GetEventType() {
+				case WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_ADDED:
+					added[dir.GetHandle()] = struct{}{}
+				case WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_REMOVED:
+					if _, ok := added[dir.GetHandle()]; !ok {
+						t.Fatalf("removed event before added event for handle %d", dir.GetHandle())
+					}
+					removed++
+				}
+			}
+		}
+	}
+	if len(added) != count {
+		t.Fatalf("expected %d added events but got %d", count, len(added))
+	}
+}
+
+// TestWatchBusInfoSnapshot tests the SNAPSHOT event contents.
+func TestWatchBusInfoSnapshot(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+	_, _, ctrlRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&boilerplate_controller.Config{ExampleField: "testing"}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ctrlRef.Release()
+	_, _, resRef, err := bus.ExecOneOff(ctx, b, &boilerplate_v1.Boilerplate{MessageText: "hello world"}, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resRef.Release()
+
+	// the snapshot contains the state of the existing directives
+	evCh := make(chan *WatchBusInfoResponse, 1024)
+	watchCtx, watchCtxCancel := context.WithCancel(ctx)
+	defer watchCtxCancel()
+	go func() {
+		_ = WatchBusInfoEvents(watchCtx, b, func(ev *WatchBusInfoResponse) {
+			evCh <- ev
+		})
+	}()
+	var ev *WatchBusInfoResponse
+	select {
+	case <-ctx.Done():
+		t.Fatal(ctx.Err().Error())
+	case ev = <-evCh:
+	}
+	if ev.GetEventType() != WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT {
+		t.Fatalf("expected snapshot got %v", ev.GetEventType())
+	}
+	var found bool
+	for _, dir := range ev.GetDirectives() {
+		if dir.GetState().GetInfo().GetName() == "Boilerplate" {
+			found = true
+			if !dir.GetIdle() || dir.GetValueCount() != 1 {
+				t.Fatalf("expected idle directive with 1 value in snapshot but got idle=%v values=%d", dir.GetIdle(), dir.GetValueCount())
+			}
+		}
+	}
+	if !found {
+		t.Fatal("expected directive in snapshot")
+	}
+
+	// items removed before the snapshot is emitted are not in the snapshot
+	di, ref, err := b.AddDirective(&directive_mock.MockDirective{}, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	ref.Release()
+	ctrl := b.GetControllers()[0]
+	var evs []*WatchBusInfoResponse
+	w := &busInfoWatcher{
+		cb: func(ev *WatchBusInfoResponse) {
+			evs = append(evs, ev)
+		},
+		wake:        make(chan struct{}, 1),
+		controllers: make(map[controller.Controller]uint32),
+		directives:  make(map[directive.Instance]*watchedDirective),
+	}
+	defer w.release()
+	err = w.process(ctx, []busInfoEvent{
+		{di: di, added: true},
+		{ctrl: ctrl, added: true},
+		{di: di},
+		{ctrl: ctrl},
+	}, true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(evs) != 1 || evs[0].GetEventType() != WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT {
+		t.Fatalf("expected only a snapshot event but got %v", evs)
+	}
+	if len(evs[0].GetControllers()) != 0 || len(evs[0].GetDirectives()) != 0 {
+		t.Fatalf("expected empty snapshot but got %v", evs[0])
+	}
+}
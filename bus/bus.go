@@ -8,6 +8,10 @@ import (
 	"github.com/aperturerobotics/util/broadcast"
 )
 
+// ControllersCallback is called when a controller is added to or removed from
+// the bus.
+type ControllersCallback func(ctrl controller.Controller, added bool)
+
 // Bus manages running controllers. It has an attached directive controller,
 // which is used to build declarative state requests between controllers.
 type Bus interface {
@@ -19,6 +23,12 @@ type Bus interface {
 	// GetControllersBroadcast returns the broadcast that is signaled when
 	// controllers are added or removed from the bus.
 	GetControllersBroadcast() *broadcast.Broadcast
+	// AddControllersCallback adds a callback that is called when a controller
+	// is added or removed. Calls cb with the existing controllers when added.
+	// cb is called while the bus is locked: it should not block or call any
+	// bus functions.
+	// Returns a callback release function.
+	AddControllersCallback(cb ControllersCallback) func()
 
 	// AddController adds a controller to the bus and calls Execute().
 	// The controller will exit if ctx is canceled.
@@ -0,0 +1,54 @@
+package bus_debug_controller
+
+import (
+	"runtime/pprof"
+	"slices"
+
+	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/pkg/errors"
+)
+
+// ConfigID is the string used to identify this config object.
+const ConfigID = ControllerID
+
+// pprofHandlers are the pprof handlers other than the runtime profiles.
+var pprofHandlers = []string{"cmdline", "profile", "symbol", "trace"}
+
+// Validate validates the configuration.
+// This is a cursory validation to see if the values "look correct."
+func (c *Config) Validate() error {
+	if c.GetListenAddr() == "" {
+		return errors.New("listen address cannot be empty")
+	}
+	if c.GetBlockProfileRate() < 0 {
+		return errors.New("block profile rate cannot be negative")
+	}
+	if c.GetMutexProfileFraction() < 0 {
+		return errors.New("mutex profile fraction cannot be negative")
+	}
+	for _, name := range c.GetPprofHandlers() {
+		if !isPprofHandler(name) {
+			return errors.Errorf("unknown pprof handler: %s", name)
+		}
+	}
+	return nil
+}
+
+// GetConfigID returns the unique string for this configuration type.
+// This string is stored with the encoded config.
+func (c *Config) GetConfigID() string {
+	return ConfigID
+}
+
+// EqualsConfig checks if the other config is equal.
+func (c *Config) EqualsConfig(other config.Config) bool {
+	return config.EqualsConfig[*Config](c, other)
+}
+
+// isPprofHandler checks if the name is a known pprof handler.
+func isPprofHandler(name string) bool {
+	return slices.Contains(pprofHandlers, name) || pprof.Lookup(name) != nil
+}
+
+// _ is a type assertion
+var _ config.Config = ((*Config)(nil))
@@ -0,0 +1,32 @@
+// @generated
+// This file is @generated by prost-build.
+/// Config configures the debug controller.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct Config {
+    /// ListenAddr is the address to listen on for http connections.
+    /// Example: 127.0.0.1:6060
+    #[prost(string, tag="1")]
+    pub listen_addr: ::prost::alloc::string::String,
+    /// BlockProfileRate is passed to runtime.SetBlockProfileRate while running.
+    /// If zero, the block profile rate is not changed. The runtime does not
+    /// report the previous rate, so it is reset to 0 when the controller exits.
+    #[prost(int32, tag="2")]
+    pub block_profile_rate: i32,
+    /// MutexProfileFraction is passed to runtime.SetMutexProfileFraction while running.
+    /// If zero, the mutex profile fraction is not changed.
+    #[prost(int32, tag="3")]
+    pub mutex_profile_fraction: i32,
+    /// PprofHandlers are the pprof handlers to enable under /debug/pprof/.
+    /// Accepts cmdline, profile, symbol, trace, and runtime profile names like
+    /// goroutine, heap, allocs, block, mutex, and threadcreate.
+    /// If empty, all handlers are enabled.
+    #[prost(string, repeated, tag="4")]
+    pub pprof_handlers: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// DisablePprof disables the pprof handlers.
+    #[prost(bool, tag="5")]
+    pub disable_pprof: bool,
+    /// DisableBusHandlers disables the goroutine and directive handlers under /debug/controllerbus/.
+    #[prost(bool, tag="6")]
+    pub disable_bus_handlers: bool,
+}
+// @@protoc_insertion_point(module)
@@ -0,0 +1,84 @@
+// @generated by protoc-gen-es-lite unknown with parameter "target=ts,ts_nocheck=false"
+// @generated from file github.com/aperturerobotics/controllerbus/bus/debug/controller/config.proto (package bus.debug.controller, syntax proto3)
+/* eslint-disable */
+
+import type { MessageType, PartialFieldInfo } from '@aptre/protobuf-es-lite'
+import { createMessageType, ScalarType } from '@aptre/protobuf-es-lite'
+
+export const protobufPackage = 'bus.debug.controller'
+
+/**
+ * Config configures the debug controller.
+ *
+ * @generated from message bus.debug.controller.Config
+ */
+export interface Config {
+  /**
+   * ListenAddr is the address to listen on for http connections.
+   * Example: 127.0.0.1:6060
+   *
+   * @generated from field: string listen_addr = 1;
+   */
+  listenAddr?: string
+  /**
+   * BlockProfileRate is passed to runtime.SetBlockProfileRate while running.
+   * If zero, the block profile rate is not changed. The runtime does not
+   * report the previous rate, so it is reset to 0 when the controller exits.
+   *
+   * @generated from field: int32 block_profile_rate = 2;
+   */
+  blockProfileRate?: number
+  /**
+   * MutexProfileFraction is passed to runtime.SetMutexProfileFraction while running.
+   * If zero, the mutex profile fraction is not changed.
+   *
+   * @generated from field: int32 mutex_profile_fraction = 3;
+   */
+  mutexProfileFraction?: number
+  /**
+   * PprofHandlers are the pprof handlers to enable under /debug/pprof/.
+   * Accepts cmdline, profile, symbol, trace, and runtime profile names like
+   * goroutine, heap, allocs, block, mutex, and threadcreate.
+   * If empty, all handlers are enabled.
+   *
+   * @generated from field: repeated string pprof_handlers = 4;
+   */
+  pprofHandlers?: string[]
+  /**
+   * DisablePprof disables the pprof handlers.
+   *
+   * @generated from field: bool disable_pprof = 5;
+   */
+  disablePprof?: boolean
+  /**
+   * DisableBusHandlers disables the goroutine and directive handlers under /debug/controllerbus/.
+   *
+   * @generated from field: bool disable_bus_handlers = 6;
+   */
+  disableBusHandlers?: boolean
+}
+
+// Config contains the message type declaration for Config.
+export const Config: MessageType<Config> = createMessageType({
+  typeName: 'bus.debug.controller.Config',
+  fields: [
+    { no: 1, name: 'listen_addr', kind: 'scalar', T: ScalarType.STRING },
+    { no: 2, name: 'block_profile_rate', kind: 'scalar', T: ScalarType.INT32 },
+    {
+      no: 3,
+      name: 'mutex_profile_fraction',
+      kind: 'scalar',
+      T: ScalarType.INT32,
+    },
+    {
+      no: 4,
+      name: 'pprof_handlers',
+      kind: 'scalar',
+      T: ScalarType.STRING,
+      repeated: true,
+    },
+    { no: 5, name: 'disable_pprof', kind: 'scalar', T: ScalarType.BOOL },
+    { no: 6, name: 'disable_bus_handlers', kind: 'scalar', T: ScalarType.BOOL },
+  ] as readonly PartialFieldInfo[],
+  packedByDefault: true,
+})
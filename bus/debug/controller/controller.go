@@ -0,0 +1,161 @@
+package bus_debug_controller
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	runtime_pprof "runtime/pprof"
+	"slices"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	bus_debug "github.com/aperturerobotics/controllerbus/bus/debug"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/aperturerobotics/util/ccontainer"
+	"github.com/sirupsen/logrus"
+)
+
+// Version is the debug controller version.
+var Version = controller.MustParseVersion("0.0.1")
+
+// pprofPath is the path prefix of the pprof handlers.
+const pprofPath = "/debug/pprof/"
+
+// Controller implements the debug controller. The controller sets the runtime
+// profile rates and serves the pprof and bus debug handlers over http.
+type Controller struct {
+	// le is the logger
+	le *logrus.Entry
+	// bus is the controller bus
+	bus bus.Bus
+	// conf is the config
+	conf *Config
+	// ready is set while the listener is accepting connections
+	ready *ccontainer.CContainer[bool]
+}
+
+// NewController constructs a new debug controller.
+func NewController(le *logrus.Entry, bus bus.Bus, conf *Config) *Controller {
+	return &Controller{
+		le:    le,
+		bus:   bus,
+		conf:  conf,
+		ready: ccontainer.NewCContainer(false),
+	}
+}
+
+// GetControllerInfo returns information about the controller.
+func (c *Controller) GetControllerInfo() *controller.Info {
+	return controller.NewInfo(
+		ControllerID,
+		Version,
+		"debug and profiling controller",
+	)
+}
+
+// Execute executes the debug controller and the listener.
+// Returning nil ends execution.
+// Returning an error triggers a retry with backoff.
+func (c *Controller) Execute(ctx context.Context) error {
+	if rate := c.conf.GetBlockProfileRate(); rate != 0 {
+		runtime.SetBlockProfileRate(int(rate))
+		// the previous rate cannot be read back: reset to the default of 0
+		defer runtime.SetBlockProfileRate(0)
+	}
+	if frac := c.conf.GetMutexProfileFraction(); frac != 0 {
+		prev := runtime.SetMutexProfileFraction(int(frac))
+		defer runtime.SetMutexProfileFraction(prev)
+	}
+
+	listenAddr := c.conf.GetListenAddr()
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+	c.le.Infof("debug handlers listening on: %s", listenAddr)
+
+	srv := &http.Server{
+		Handler:           c.buildMux(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(lis)
+	}()
+
+	c.ready.SetValue(true)
+	defer c.ready.SetValue(false)
+
+	select {
+	case <-ctx.Done():
+		_ = srv.Close()
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+// WaitReady waits for the listener to accept connections.
+func (c *Controller) WaitReady(ctx context.Context) error {
+	_, err := c.ready.WaitValue(ctx, nil)
+	return err
+}
+
+// buildMux builds the http handlers enabled by the config.
+func (c *Controller) buildMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	if !c.conf.GetDisablePprof() {
+		enabled := c.conf.GetPprofHandlers()
+		isEnabled := func(name string) bool {
+			return len(enabled) == 0 || slices.Contains(enabled, name)
+		}
+
+		// pprof.Index serves any runtime profile: only use it for the index page.
+		mux.HandleFunc(pprofPath, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != pprofPath {
+				http.NotFound(w, r)
+				return
+			}
+			pprof.Index(w, r)
+		})
+		for name, handler := range map[string]http.HandlerFunc{
+			"cmdline": pprof.Cmdline,
+			"profile": pprof.Profile,
+			"symbol":  pprof.Symbol,
+			"trace":   pprof.Trace,
+		} {
+			if isEnabled(name) {
+				mux.HandleFunc(pprofPath+name, handler)
+			}
+		}
+		for _, prof := range runtime_pprof.Profiles() {
+			if name := prof.Name(); isEnabled(name) {
+				mux.Handle(pprofPath+name, pprof.Handler(name))
+			}
+		}
+	}
+	if !c.conf.GetDisableBusHandlers() {
+		bus_debug.RegisterHandlers(mux, c.bus)
+	}
+	return mux
+}
+
+// HandleDirective asks if the handler can resolve the directive.
+// If it can, it returns a resolver. If not, returns nil.
+// Any unexpected errors are returned for logging.
+// It is safe to add a reference to the directive during this call.
+func (c *Controller) HandleDirective(ctx context.Context, di directive.Instance) ([]directive.Resolver, error) {
+	return nil, nil
+}
+
+// Close releases any resources used by the controller.
+// Error indicates any issue encountered releasing.
+func (c *Controller) Close() error {
+	return nil
+}
+
+// _ is a type assertion
+var _ controller.ControllerWithReady = ((*Controller)(nil))
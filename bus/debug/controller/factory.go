@@ -0,0 +1,55 @@
+package bus_debug_controller
+
+import (
+	"context"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/aperturerobotics/controllerbus/controller"
+)
+
+// ControllerID identifies the debug controller.
+const ControllerID = "controllerbus/bus/debug"
+
+// Factory constructs a debug controller.
+type Factory struct {
+	// bus is the controller bus
+	bus bus.Bus
+}
+
+// NewFactory builds a debug controller factory.
+func NewFactory(bus bus.Bus) *Factory {
+	return &Factory{bus: bus}
+}
+
+// GetConfigID returns the unique ID for the config.
+func (t *Factory) GetConfigID() string {
+	return ConfigID
+}
+
+// GetControllerID returns the unique ID for the controller.
+func (t *Factory) GetControllerID() string {
+	return ControllerID
+}
+
+// ConstructConfig constructs an instance of the controller configuration.
+func (t *Factory) ConstructConfig() config.Config {
+	return &Config{}
+}
+
+// Construct constructs the associated controller given configuration.
+func (t *Factory) Construct(
+	ctx context.Context,
+	conf config.Config,
+	opts controller.ConstructOpts,
+) (controller.Controller, error) {
+	return NewController(opts.GetLogger(), t.bus, conf.(*Config)), nil
+}
+
+// GetVersion returns the version of this controller.
+func (t *Factory) GetVersion() controller.Version {
+	return Version
+}
+
+// _ is a type assertion
+var _ controller.Factory = ((*Factory)(nil))
@@ -0,0 +1,106 @@
+package bus_debug
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/sirupsen/logrus"
+)
+
+// TestDebugHandlers tests the goroutine and directive tree handlers.
+func TestDebugHandlers(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+
+	mux := http.NewServeMux()
+	RegisterHandlers(mux, b)
+	get := func(path string) string {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: unexpected status %d: %s", path, rec.Code, rec.Body.String())
+		}
+		return rec.Body.String()
+	}
+
+	groups, err := ListGoroutines()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var found bool
+	for _, g := range groups {
+		if g.GetControllerID() == configset_controller.ControllerID {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected goroutine labeled with the configset controller id")
+	}
+
+	goroutines := get(GoroutinesPath + "?controller=" + configset_controller.ControllerID)
+	t.Log(goroutines)
+	if !strings.Contains(goroutines, "controller="+configset_controller.ControllerID) {
+		t.Fatal("expected configset controller goroutines")
+	}
+
+	tree := get(DirectivesPath)
+	t.Log(tree)
+	if !strings.Contains(tree, configset_controller.ControllerID) ||
+		!strings.Contains(tree, "LoadControllerWithConfig (1)") {
+		t.Fatal("expected configset controller and directive in tree")
+	}
+}
+
+// TestParseGoroutines tests parsing the goroutine profile text format.
+func TestParseGoroutines(t *testing.T) {
+	groups := parseGoroutines([]byte(`goroutine profile: total 3
+2 @ 0x1 0x2
+# labels: {"controller":"example/ctrl", "directive":"Example"}
+#	0x1	main.wait+0x1	/main.go:10
+
+1 @ 0x3
+#	0x3	main.main+0x1	/main.go:5
+
+`))
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups but got %d", len(groups))
+	}
+	if groups[0].Count != 2 || groups[0].GetControllerID() != "example/ctrl" || groups[0].Labels["directive"] != "Example" {
+		t.Fatalf("unexpected group: %#v", groups[0])
+	}
+	if groups[1].Count != 1 || groups[1].GetControllerID() != "" {
+		t.Fatalf("unexpected group: %#v", groups[1])
+	}
+
+	var sb strings.Builder
+	WriteGoroutines(&sb, groups, "")
+	if !strings.HasPrefix(sb.String(), "goroutines by controller: total 3\n       2 example/ctrl\n       1 (none)\n") {
+		t.Fatalf("unexpected output: %s", sb.String())
+	}
+}
@@ -0,0 +1,86 @@
+package bus_debug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// WriteDirectiveTree writes the running controllers and the directive tree.
+//
+// Directives are grouped by name with the values and resolver errors of each
+// directive instance below it.
+func WriteDirectiveTree(ctx context.Context, w io.Writer, b bus.Bus) error {
+	ctrls := b.GetControllers()
+	ctrlIDs := make([]string, len(ctrls))
+	for i, ctrl := range ctrls {
+		ctrlIDs[i] = ctrl.GetControllerInfo().GetId()
+	}
+	slices.Sort(ctrlIDs)
+	fmt.Fprintf(w, "controllers: %d\n", len(ctrlIDs))
+	for _, id := range ctrlIDs {
+		fmt.Fprintf(w, "  %s\n", id)
+	}
+
+	dirs := b.GetDirectives()
+	infos := make([]*bus_api.GetDirectiveInfoResponse, 0, len(dirs))
+	for _, di := range dirs {
+		info, err := bus_api.NewGetDirectiveInfoResponse(ctx, di)
+		if err != nil {
+			return err
+		}
+		infos = append(infos, info)
+	}
+	slices.SortFunc(infos, func(a, b *bus_api.GetDirectiveInfoResponse) int {
+		if c := strings.Compare(a.GetDirectiveState().GetInfo().GetName(), b.GetDirectiveState().GetInfo().GetName()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetDirectiveState().GetIdent(), b.GetDirectiveState().GetIdent())
+	})
+
+	fmt.Fprintf(w, "\ndirectives: %d\n", len(infos))
+	for i, info := range infos {
+		name := info.GetDirectiveState().GetInfo().GetName()
+		if i == 0 || infos[i-1].GetDirectiveState().GetInfo().GetName() != name {
+			var count int
+			for _, other := range infos[i:] {
+				if other.GetDirectiveState().GetInfo().GetName() != name {
+					break
+				}
+				count++
+			}
+			fmt.Fprintf(w, "  %s (%d)\n", name, count)
+		}
+
+		state := "active"
+		if info.GetIdle() {
+			state = "idle"
+		}
+		fmt.Fprintf(w, "    %s [%s]\n", info.GetDirectiveState().GetIdent(), state)
+		for _, val := range info.GetValues() {
+			fmt.Fprintf(w, "      value %d: %s%s\n", val.GetValueId(), val.GetValueType(), formatDebugVals(val.GetDebugVals()))
+		}
+		for _, rerr := range info.GetResolverErrors() {
+			fmt.Fprintf(w, "      error: %s\n", rerr)
+		}
+	}
+	return nil
+}
+
+// formatDebugVals formats the debug values as key=values pairs.
+func formatDebugVals(vals []*directive.ProtoDebugValue) string {
+	var sb strings.Builder
+	for _, val := range vals {
+		sb.WriteByte(' ')
+		sb.WriteString(val.GetKey())
+		sb.WriteByte('=')
+		sb.WriteString(strings.Join(val.GetValues(), ","))
+	}
+	return sb.String()
+}
@@ -0,0 +1,137 @@
+package bus_debug
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime/pprof"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aperturerobotics/controllerbus/controller"
+)
+
+// labelsPrefix is the prefix of the labels line in the goroutine profile.
+const labelsPrefix = "# labels: "
+
+// GoroutineGroup is a group of goroutines with the same stack and labels.
+type GoroutineGroup struct {
+	// Count is the number of goroutines in the group.
+	Count int
+	// Labels are the pprof labels of the goroutines.
+	Labels map[string]string
+	// Stack is the text of the stack trace.
+	Stack string
+}
+
+// GetControllerID returns the controller id label.
+func (g *GoroutineGroup) GetControllerID() string {
+	return g.Labels[controller.PprofLabel]
+}
+
+// ListGoroutines lists the goroutine groups in the goroutine profile.
+func ListGoroutines() ([]*GoroutineGroup, error) {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		return nil, err
+	}
+	return parseGoroutines(buf.Bytes()), nil
+}
+
+// parseGoroutines parses the debug=1 text format of the goroutine profile.
+//
+// Each group starts with a "count @ pc..." line followed by an optional
+// labels line and the stack, and ends with a blank line.
+func parseGoroutines(data []byte) []*GoroutineGroup {
+	var groups []*GoroutineGroup
+	var curr *GoroutineGroup
+	var stack strings.Builder
+	flush := func() {
+		if curr != nil {
+			curr.Stack = stack.String()
+			groups = append(groups, curr)
+		}
+		curr = nil
+		stack.Reset()
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			flush()
+		case curr == nil:
+			countStr, _, ok := strings.Cut(line, " @ ")
+			if !ok {
+				// profile header
+				continue
+			}
+			count, err := strconv.Atoi(countStr)
+			if err != nil {
+				continue
+			}
+			curr = &GoroutineGroup{Count: count}
+		case strings.HasPrefix(line, labelsPrefix):
+			// the labels are formatted as a json-compatible object
+			_ = json.Unmarshal([]byte(line[len(labelsPrefix):]), &curr.Labels)
+		default:
+			stack.WriteString(line)
+			stack.WriteByte('\n')
+		}
+	}
+	flush()
+	return groups
+}
+
+// WriteGoroutines writes the goroutine counts by controller id and the stacks.
+//
+// If controllerID is set, only writes the stacks of that controller.
+func WriteGoroutines(w io.Writer, groups []*GoroutineGroup, controllerID string) {
+	var total int
+	counts := make(map[string]int)
+	for _, g := range groups {
+		total += g.Count
+		counts[g.GetControllerID()] += g.Count
+	}
+	ids := make([]string, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		if c := cmp.Compare(counts[b], counts[a]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+
+	fmt.Fprintf(w, "goroutines by controller: total %d\n", total)
+	for _, id := range ids {
+		name := id
+		if name == "" {
+			name = "(none)"
+		}
+		fmt.Fprintf(w, "%8d %s\n", counts[id], name)
+	}
+
+	for _, g := range groups {
+		if controllerID != "" && g.GetControllerID() != controllerID {
+			continue
+		}
+		fmt.Fprintf(w, "\n%d goroutine(s)", g.Count)
+		labelKeys := make([]string, 0, len(g.Labels))
+		for k := range g.Labels {
+			labelKeys = append(labelKeys, k)
+		}
+		slices.Sort(labelKeys)
+		for _, k := range labelKeys {
+			fmt.Fprintf(w, " %s=%s", k, g.Labels[k])
+		}
+		fmt.Fprintf(w, "\n%s", g.Stack)
+	}
+}
@@ -0,0 +1,35 @@
+package bus_debug
+
+import (
+	"net/http"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+)
+
+// GoroutinesPath is the path of the goroutines handler.
+const GoroutinesPath = "/debug/controllerbus/goroutines"
+
+// DirectivesPath is the path of the directive tree handler.
+const DirectivesPath = "/debug/controllerbus/directives"
+
+// RegisterHandlers registers the goroutines and directive tree handlers.
+//
+// The goroutines handler accepts a controller query parameter to only write
+// the stacks of the goroutines labeled with that controller id.
+func RegisterHandlers(mux *http.ServeMux, b bus.Bus) {
+	mux.HandleFunc(GoroutinesPath, func(w http.ResponseWriter, r *http.Request) {
+		groups, err := ListGoroutines()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		WriteGoroutines(w, groups, r.URL.Query().Get("controller"))
+	})
+	mux.HandleFunc(DirectivesPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := WriteDirectiveTree(r.Context(), w, b); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
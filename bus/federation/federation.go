@@ -0,0 +1,264 @@
+package bus_federation
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// DefaultMaxHops is the default maximum number of times a directive is forwarded.
+const DefaultMaxHops = 8
+
+var (
+	// ErrEmptyBusID is returned if the bus id is empty.
+	ErrEmptyBusID = errors.New("bus id cannot be empty")
+	// ErrDuplicateBusID is returned if a bus with the id already joined.
+	ErrDuplicateBusID = errors.New("bus id already joined the federation")
+)
+
+// ForwardInfo contains information about a forwarded directive.
+type ForwardInfo struct {
+	// OriginBusID is the id of the bus the directive was first added to.
+	OriginBusID string
+	// Hops is the number of times the directive was forwarded.
+	Hops uint32
+}
+
+// forwardEntry is a directive forwarded to a target bus.
+type forwardEntry struct {
+	// key is the forwarded directive and target bus id
+	key forwardKey
+	// info is the forward info on the target bus
+	info ForwardInfo
+	// visited contains the ids of the buses the directive was forwarded through
+	// including the origin and target bus.
+	visited map[string]struct{}
+	// di is the directive instance on the target bus, nil until added
+	di directive.Instance
+}
+
+// forwardKey is the key of a forward waiting for AddDirective to return.
+type forwardKey struct {
+	// dir is the forwarded directive
+	dir directive.Directive
+	// busID is the target bus id
+	busID string
+}
+
+// forwardState tracks the forwards to a directive instance.
+//
+// The target bus may deduplicate forwarded directives with an equivalent
+// directive instance, so one instance can have multiple forwards.
+type forwardState struct {
+	// local indicates the instance was added on the bus before it was forwarded to
+	local bool
+	// entries contains the forwards to the instance, oldest first
+	entries []*forwardEntry
+}
+
+// Federation connects multiple buses in a process with directive routes.
+//
+// Directives forwarded between buses carry the origin bus id and hop count.
+// A directive is never forwarded to a bus it was already forwarded to, which
+// prevents loops with ring or bidirectional routes. Values resolved on other
+// buses can be traced back to the bus that resolved them.
+type Federation struct {
+	// maxHops is the maximum number of hops
+	maxHops uint32
+
+	// mtx guards below fields
+	mtx sync.Mutex
+	// members contains the joined buses by id
+	members map[string]*Member
+	// forwarded contains the forwards by directive instance on the target bus
+	forwarded map[directive.Instance]*forwardState
+	// pending contains the forwards waiting for AddDirective to return
+	pending map[forwardKey][]*forwardEntry
+}
+
+// NewFederation constructs a new Federation.
+//
+// If maxHops is 0, uses DefaultMaxHops.
+func NewFederation(maxHops uint32) *Federation {
+	if maxHops == 0 {
+		maxHops = DefaultMaxHops
+	}
+	return &Federation{
+		maxHops:   maxHops,
+		members:   make(map[string]*Member),
+		forwarded: make(map[directive.Instance]*forwardState),
+		pending:   make(map[forwardKey][]*forwardEntry),
+	}
+}
+
+// Join adds a bus to the federation with a list of outgoing routes.
+//
+// Adds a controller to the bus to forward directives matching the routes.
+// Call the returned function to remove the bus from the federation.
+func (f *Federation) Join(
+	ctx context.Context,
+	busID string,
+	b bus.Bus,
+	routes ...*Route,
+) (*Member, func(), error) {
+	if busID == "" {
+		return nil, nil, ErrEmptyBusID
+	}
+
+	subCtx, subCtxCancel := context.WithCancel(ctx)
+	m := newMember(subCtx, f, busID, b, routes)
+	f.mtx.Lock()
+	if _, exists := f.members[busID]; exists {
+		f.mtx.Unlock()
+		subCtxCancel()
+		return nil, nil, ErrDuplicateBusID
+	}
+	f.members[busID] = m
+	f.mtx.Unlock()
+
+	relCtrl, err := b.AddController(subCtx, m, nil)
+	if err != nil {
+		f.removeMember(m)
+		subCtxCancel()
+		return nil, nil, err
+	}
+
+	var relOnce sync.Once
+	return m, func() {
+		relOnce.Do(func() {
+			f.removeMember(m)
+			subCtxCancel()
+			relCtrl()
+		})
+	}, nil
+}
+
+// GetMember returns the member with the bus id or nil if not found.
+func (f *Federation) GetMember(busID string) *Member {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return f.members[busID]
+}
+
+// removeMember removes the member if it is still joined.
+func (f *Federation) removeMember(m *Member) {
+	f.mtx.Lock()
+	if f.members[m.id] == m {
+		delete(f.members, m.id)
+	}
+	f.mtx.Unlock()
+}
+
+// getForwardInfo returns the forward info for a directive instance on a bus.
+//
+// If the directive was not forwarded to the bus, it originated on the bus.
+func (f *Federation) getForwardInfo(di directive.Instance, busID string) ForwardInfo {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	info, _ := f.getForwardLocked(di, busID)
+	return info
+}
+
+// getForwardLocked returns the forward info and the visited bus ids for a
+// directive instance on a bus.
+func (f *Federation) getForwardLocked(di directive.Instance, busID string) (ForwardInfo, map[string]struct{}) {
+	info := ForwardInfo{OriginBusID: busID}
+	visited := map[string]struct{}{busID: {}}
+	st := f.forwarded[di]
+	entries := f.pending[forwardKey{dir: di.GetDirective(), busID: busID}]
+	if st != nil {
+		entries = st.entries
+	}
+	for i, e := range entries {
+		if i == 0 && (st == nil || !st.local) {
+			info = e.info
+		}
+		for id := range e.visited {
+			visited[id] = struct{}{}
+		}
+	}
+	return info, visited
+}
+
+// addForward marks a directive instance as being forwarded from a bus to a
+// target bus.
+//
+// Returns false if the directive already visited the target bus or if the
+// maximum number of hops would be exceeded. Call setForwardInstance with the
+// instance on the target bus once added, and releaseForward when done.
+func (f *Federation) addForward(di directive.Instance, fromBusID, targetBusID string) (*forwardEntry, bool) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	info, visited := f.getForwardLocked(di, fromBusID)
+	if _, ok := visited[targetBusID]; ok {
+		return nil, false
+	}
+	if info.Hops >= f.maxHops {
+		return nil, false
+	}
+	visited[targetBusID] = struct{}{}
+	e := &forwardEntry{
+		key:     forwardKey{dir: di.GetDirective(), busID: targetBusID},
+		info:    ForwardInfo{OriginBusID: info.OriginBusID, Hops: info.Hops + 1},
+		visited: visited,
+	}
+	f.pending[e.key] = append(f.pending[e.key], e)
+	return e, true
+}
+
+// setForwardInstance sets the directive instance a forward was added to.
+//
+// If the target bus deduplicated the directive with an equivalent instance,
+// the forward is tracked with the existing instance.
+func (f *Federation) setForwardInstance(e *forwardEntry, di directive.Instance) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.removePendingLocked(e)
+	e.di = di
+	st := f.forwarded[di]
+	if st == nil {
+		st = &forwardState{}
+		// an existing instance not added by a forward originated on the bus
+		if di.GetDirective() != e.key.dir && len(f.pending[forwardKey{dir: di.GetDirective(), busID: e.key.busID}]) == 0 {
+			st.local = true
+		}
+		f.forwarded[di] = st
+	}
+	st.entries = append(st.entries, e)
+}
+
+// releaseForward releases a forward added with addForward.
+func (f *Federation) releaseForward(e *forwardEntry) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if e.di == nil {
+		f.removePendingLocked(e)
+		return
+	}
+	st := f.forwarded[e.di]
+	if st == nil {
+		return
+	}
+	st.entries = slices.DeleteFunc(st.entries, func(se *forwardEntry) bool {
+		return se == e
+	})
+	if len(st.entries) == 0 {
+		delete(f.forwarded, e.di)
+	}
+}
+
+// removePendingLocked removes a forward from the pending forwards.
+func (f *Federation) removePendingLocked(e *forwardEntry) {
+	entries := slices.DeleteFunc(f.pending[e.key], func(pe *forwardEntry) bool {
+		return pe == e
+	})
+	if len(entries) == 0 {
+		delete(f.pending, e.key)
+	} else {
+		f.pending[e.key] = entries
+	}
+}
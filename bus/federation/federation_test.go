@@ -0,0 +1,228 @@
+package bus_federation
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/aperturerobotics/controllerbus/example/boilerplate"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// testFederation constructs a set of buses joined to a federation.
+//
+// links contains the routes as from, to pairs.
+// The boilerplate controller runs on the bus with the id boilerplateBusID.
+func testFederation(
+	t *testing.T,
+	ctx context.Context,
+	links [][2]string,
+	boilerplateBusID string,
+	directiveNames ...string,
+) map[string]*Member {
+	le := logrus.NewEntry(logrus.New())
+	routes := make(map[string][]*Route)
+	var ids []string
+	for _, link := range links {
+		for _, id := range link {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		routes[link[0]] = append(routes[link[0]], NewRoute(link[1], directiveNames...))
+	}
+
+	fed := NewFederation(0)
+	members := make(map[string]*Member, len(ids))
+	for _, id := range ids {
+		b, sr, err := core.NewCoreBus(ctx, le.WithField("bus-id", id))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if id == boilerplateBusID {
+			sr.AddFactory(boilerplate_controller.NewFactory(b))
+			_, _, ctrlRef, err := bus.ExecOneOff(
+				ctx,
+				b,
+				resolver.NewLoadControllerWithConfig(&boilerplate_controller.Config{ExampleField: "testing"}),
+				nil,
+				nil,
+			)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			t.Cleanup(ctrlRef.Release)
+		}
+
+		m, rel, err := fed.Join(ctx, id, b, routes[id]...)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		t.Cleanup(rel)
+		members[id] = m
+	}
+	return members
+}
+
+// execBoilerplate executes the boilerplate directive and checks the result.
+//
+// Returns the id of the bus that resolved the value.
+func execBoilerplate(t *testing.T, ctx context.Context, m *Member) string {
+	res, di, resRef, err := bus.ExecOneOff(ctx, m.GetBus(), &boilerplate_v1.Boilerplate{
+		MessageText: "hello world",
+	}, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	t.Cleanup(resRef.Release)
+	plen := res.GetValue().(boilerplate.BoilerplateResult).GetPrintedLen()
+	if plen != 55 {
+		t.Fatalf("expected length 55 got %d", plen)
+	}
+	return m.GetValueOrigin(di, res.GetValueID())
+}
+
+// findBoilerplate finds the boilerplate directive instance on a bus.
+func findBoilerplate(b bus.Bus) directive.Instance {
+	for _, di := range b.GetDirectives() {
+		if di.GetDirective().GetName() == "Boilerplate" {
+			return di
+		}
+	}
+	return nil
+}
+
+// TestFederationRing tests forwarding a directive around a ring of buses.
+func TestFederationRing(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	members := testFederation(t, ctx, [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}}, "c", "Boilerplate")
+	if origin := execBoilerplate(t, ctx, members["a"]); origin != "c" {
+		t.Fatalf("expected value origin c got %q", origin)
+	}
+
+	cdi := findBoilerplate(members["c"].GetBus())
+	if cdi == nil {
+		t.Fatal("expected directive to be forwarded to c")
+	}
+	info := members["c"].GetForwardInfo(cdi)
+	if info.OriginBusID != "a" || info.Hops != 2 {
+		t.Fatalf("unexpected forward info on c: %#v", info)
+	}
+
+	// c must not forward the directive back to a
+	for _, di := range members["a"].GetBus().GetDirectives() {
+		if di.GetDirective().GetName() == "Boilerplate" && di.GetDirective() != cdi.GetDirective() {
+			t.Fatal("directive was forwarded back to the origin bus")
+		}
+	}
+}
+
+// TestFederationBidirectional tests a pair of buses routing to each other.
+func TestFederationBidirectional(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	members := testFederation(t, ctx, [][2]string{{"a", "b"}, {"b", "a"}}, "b", "Boilerplate")
+	if origin := execBoilerplate(t, ctx, members["a"]); origin != "b" {
+		t.Fatalf("expected value origin b got %q", origin)
+	}
+	bdi := findBoilerplate(members["b"].GetBus())
+	if bdi == nil {
+		t.Fatal("expected directive to be forwarded to b")
+	}
+	info := members["b"].GetForwardInfo(bdi)
+	if info.OriginBusID != "a" || info.Hops != 1 {
+		t.Fatalf("unexpected forward info on b: %#v", info)
+	}
+}
+
+// TestFederationRouteByName tests that routes only forward matching directive names.
+func TestFederationRouteByName(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	members := testFederation(t, ctx, [][2]string{{"a", "b"}, {"b", "a"}}, "b", "OtherDirective")
+	res, _, ref, err := bus.ExecOneOff(
+		ctx,
+		members["a"].GetBus(),
+		&boilerplate_v1.Boilerplate{MessageText: "hello world"},
+		bus.ReturnWhenIdle(),
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if ref != nil {
+		defer ref.Release()
+	}
+	if res != nil {
+		t.Fatal("expected no value to be resolved")
+	}
+	if findBoilerplate(members["b"].GetBus()) != nil {
+		t.Fatal("expected directive not to be forwarded to b")
+	}
+}
+
+// TestFederationEquivalent tests two members forwarding equivalent directives
+// to a bus which deduplicates them into one instance.
+func TestFederationEquivalent(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	members := testFederation(t, ctx, [][2]string{{"a", "b"}, {"c", "b"}}, "b", "Boilerplate")
+	exec := func(m *Member) directive.Reference {
+		res, di, ref, err := bus.ExecOneOff(ctx, m.GetBus(), &boilerplate_v1.Boilerplate{
+			MessageText: "hello world",
+		}, nil, nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if origin := m.GetValueOrigin(di, res.GetValueID()); origin != "b" {
+			t.Fatalf("expected value origin b got %q", origin)
+		}
+		return ref
+	}
+	refA := exec(members["a"])
+	refC := exec(members["c"])
+	defer refC.Release()
+
+	bdi := findBoilerplate(members["b"].GetBus())
+	if bdi == nil {
+		t.Fatal("expected directive to be forwarded to b")
+	}
+	if info := members["b"].GetForwardInfo(bdi); info.OriginBusID != "a" || info.Hops != 1 {
+		t.Fatalf("unexpected forward info on b: %#v", info)
+	}
+
+	// the forward from c keeps the instance on b after a releases it
+	refA.Release()
+	deadline := time.Now().Add(5 * time.Second)
+	for findBoilerplate(members["a"].GetBus()) != nil {
+		if time.Now().After(deadline) {
+			t.Fatal("expected directive to be released on a")
+		}
+		<-time.After(10 * time.Millisecond)
+	}
+	for {
+		info := members["b"].GetForwardInfo(bdi)
+		if info.OriginBusID == "c" && info.Hops == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected forward info on b: %#v", info)
+		}
+		<-time.After(10 * time.Millisecond)
+	}
+	if findBoilerplate(members["b"].GetBus()) != bdi {
+		t.Fatal("expected instance on b to be kept")
+	}
+}
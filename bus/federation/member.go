@@ -0,0 +1,222 @@
+package bus_federation
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/aperturerobotics/util/broadcast"
+)
+
+// ControllerID is the controller identifier.
+const ControllerID = "controllerbus/bus/federation"
+
+// Version is the API version.
+var Version = controller.MustParseVersion("0.0.1")
+
+// valueOrigin tracks the bus a forwarded value was resolved on.
+type valueOrigin struct {
+	// target is the member the value was forwarded from
+	target *Member
+	// targetDi is the directive instance on the target bus
+	targetDi *targetInstance
+	// targetValueID is the value id on the target bus
+	targetValueID uint32
+}
+
+// targetInstance holds a directive instance on a target bus.
+//
+// The instance is set after AddDirective returns, values may arrive before.
+type targetInstance struct {
+	mtx sync.Mutex
+	di  directive.Instance
+}
+
+// get returns the directive instance or nil if not set yet.
+func (t *targetInstance) get() directive.Instance {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	return t.di
+}
+
+// set sets the directive instance.
+func (t *targetInstance) set(di directive.Instance) {
+	t.mtx.Lock()
+	t.di = di
+	t.mtx.Unlock()
+}
+
+// Member is a bus that joined a Federation.
+//
+// Member is the controller on the bus that forwards directives to other
+// members along the configured routes.
+type Member struct {
+	// ctx is canceled when the member leaves the federation
+	ctx context.Context
+	// fed is the federation
+	fed *Federation
+	// id is the bus id
+	id string
+	// bus is the member bus
+	bus bus.Bus
+	// routes are the outgoing routes
+	routes []*Route
+
+	// bcast guards below fields and is broadcast when they change
+	bcast broadcast.Broadcast
+	// origins contains the origins of forwarded values by instance and value id
+	origins map[directive.Instance]map[uint32]*valueOrigin
+	// adding counts the forwarded values being added by instance
+	adding map[directive.Instance]int
+}
+
+// newMember constructs a new Member.
+func newMember(ctx context.Context, fed *Federation, id string, b bus.Bus, routes []*Route) *Member {
+	return &Member{
+		ctx:     ctx,
+		fed:     fed,
+		id:      id,
+		bus:     b,
+		routes:  routes,
+		origins: make(map[directive.Instance]map[uint32]*valueOrigin),
+		adding:  make(map[directive.Instance]int),
+	}
+}
+
+// GetBusID returns the id of the bus in the federation.
+func (m *Member) GetBusID() string {
+	return m.id
+}
+
+// GetBus returns the member bus.
+func (m *Member) GetBus() bus.Bus {
+	return m.bus
+}
+
+// GetForwardInfo returns the origin and hop count of a directive on the bus.
+//
+// Directives that were not forwarded have 0 hops and originate on the bus.
+func (m *Member) GetForwardInfo(di directive.Instance) ForwardInfo {
+	return m.fed.getForwardInfo(di, m.id)
+}
+
+// GetValueOrigin returns the id of the bus that resolved a value.
+//
+// valueID is the id of the value on the directive instance on this bus.
+// Returns the id of this bus if the value was not forwarded.
+//
+// Waits for any forwarded values being added to the instance. Do not call
+// from within a value callback on the instance.
+func (m *Member) GetValueOrigin(di directive.Instance, valueID uint32) string {
+	var vo *valueOrigin
+	for {
+		var waitCh <-chan struct{}
+		m.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+			vo = m.origins[di][valueID]
+			if vo == nil && m.adding[di] != 0 {
+				waitCh = getWaitCh()
+			}
+		})
+		if waitCh == nil {
+			break
+		}
+		<-waitCh
+	}
+	if vo == nil {
+		return m.id
+	}
+	targetDi := vo.targetDi.get()
+	if targetDi == nil {
+		return vo.target.id
+	}
+	return vo.target.GetValueOrigin(targetDi, vo.targetValueID)
+}
+
+// GetControllerInfo returns information about the controller.
+func (m *Member) GetControllerInfo() *controller.Info {
+	return controller.NewInfo(ControllerID, Version, "federation member "+m.id)
+}
+
+// Execute executes the controller goroutine.
+// Returning nil ends execution.
+// Returning an error triggers a retry with backoff.
+func (m *Member) Execute(ctx context.Context) error {
+	return nil
+}
+
+// HandleDirective asks if the handler can resolve the directive.
+// If it can, it returns a resolver. If not, returns nil.
+// Any unexpected errors are returned for logging.
+// It is safe to add a reference to the directive during this call.
+// The context passed is canceled when the directive instance expires.
+func (m *Member) HandleDirective(ctx context.Context, di directive.Instance) ([]directive.Resolver, error) {
+	var res []directive.Resolver
+	for _, route := range m.routes {
+		if route == nil || route.TargetBusID == m.id {
+			continue
+		}
+		match, err := route.Matches(di)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			continue
+		}
+		target := m.fed.GetMember(route.TargetBusID)
+		if target == nil {
+			continue
+		}
+		res = append(res, newForwardResolver(m, target, di))
+	}
+	return res, nil
+}
+
+// Close releases any resources used by the controller.
+// Error indicates any issue encountered releasing.
+func (m *Member) Close() error {
+	return nil
+}
+
+// addValue adds a forwarded value to a handler and sets the value origin.
+func (m *Member) addValue(
+	di directive.Instance,
+	handler directive.ResolverHandler,
+	val directive.Value,
+	vo *valueOrigin,
+) (uint32, bool) {
+	m.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+		m.adding[di]++
+	})
+	id, accepted := handler.AddValue(val)
+	m.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+		if accepted {
+			vals := m.origins[di]
+			if vals == nil {
+				vals = make(map[uint32]*valueOrigin)
+				m.origins[di] = vals
+			}
+			vals[id] = vo
+		}
+		if m.adding[di]--; m.adding[di] <= 0 {
+			delete(m.adding, di)
+		}
+		broadcast()
+	})
+	return id, accepted
+}
+
+// clearValueOrigin clears the origin of a forwarded value.
+func (m *Member) clearValueOrigin(di directive.Instance, valueID uint32) {
+	m.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+		vals := m.origins[di]
+		delete(vals, valueID)
+		if len(vals) == 0 {
+			delete(m.origins, di)
+		}
+	})
+}
+
+// _ is a type assertion
+var _ controller.Controller = ((*Member)(nil))
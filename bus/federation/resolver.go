@@ -0,0 +1,110 @@
+package bus_federation
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// forwardResolver resolves a directive by forwarding to another member bus.
+type forwardResolver struct {
+	// from is the member the directive is forwarded from
+	from *Member
+	// target is the member the directive is forwarded to
+	target *Member
+	// di is the directive instance on the from bus
+	di directive.Instance
+}
+
+// newForwardResolver constructs a new forwardResolver.
+func newForwardResolver(from, target *Member, di directive.Instance) *forwardResolver {
+	return &forwardResolver{from: from, target: target, di: di}
+}
+
+// Resolve resolves the values, emitting them to the handler.
+func (r *forwardResolver) Resolve(ctx context.Context, handler directive.ResolverHandler) error {
+	dir := r.di.GetDirective()
+	fwd, ok := r.from.fed.addForward(r.di, r.from.id, r.target.id)
+	if !ok {
+		return nil
+	}
+	defer r.from.fed.releaseForward(fwd)
+
+	subCtx, subCtxCancel := context.WithCancel(ctx)
+	defer subCtxCancel()
+
+	// mtx guards vmap
+	var mtx sync.Mutex
+	// vmap maps target value ids to local value ids
+	vmap := make(map[uint32]uint32)
+	targetDi := &targetInstance{}
+	defer func() {
+		mtx.Lock()
+		for _, id := range vmap {
+			r.from.clearValueOrigin(r.di, id)
+		}
+		mtx.Unlock()
+	}()
+
+	di, diRef, err := r.target.bus.AddDirective(
+		dir,
+		bus.NewCallbackHandler(
+			func(av directive.AttachedValue) {
+				// value added
+				targetID := av.GetValueID()
+				id, accepted := r.from.addValue(r.di, handler, av.GetValue(), &valueOrigin{
+					target:        r.target,
+					targetDi:      targetDi,
+					targetValueID: targetID,
+				})
+				if !accepted {
+					return
+				}
+				mtx.Lock()
+				vmap[targetID] = id
+				mtx.Unlock()
+			}, func(av directive.AttachedValue) {
+				// value removed
+				targetID := av.GetValueID()
+				mtx.Lock()
+				id, ok := vmap[targetID]
+				if ok {
+					delete(vmap, targetID)
+				}
+				mtx.Unlock()
+				if ok {
+					handler.RemoveValue(id)
+					r.from.clearValueOrigin(r.di, id)
+				}
+			},
+			subCtxCancel,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	defer diRef.Release()
+	targetDi.set(di)
+	r.from.fed.setForwardInstance(fwd, di)
+
+	// mirror the idle state of the target
+	relIdle := di.AddIdleCallback(func(isIdle bool, errs []error) {
+		handler.MarkIdle(isIdle)
+	})
+	defer relIdle()
+
+	// wait for ctx cancel or the target to leave, then release
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-subCtx.Done():
+	case <-r.target.ctx.Done():
+	}
+	handler.ClearValues()
+	return nil
+}
+
+// _ is a type assertion
+var _ directive.Resolver = ((*forwardResolver)(nil))
@@ -0,0 +1,37 @@
+package bus_federation
+
+import (
+	"slices"
+
+	bus_bridge "github.com/aperturerobotics/controllerbus/bus/bridge"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// Route forwards matching directives to another bus in the federation.
+type Route struct {
+	// TargetBusID is the id of the bus to forward to.
+	TargetBusID string
+	// DirectiveNames limits the route to directives with the given names.
+	// Matches the value returned by GetName on the directive.
+	// If empty, all directive types are forwarded. Note that this includes the
+	// directives used to load and execute controllers.
+	DirectiveNames []string
+	// FilterFn optionally filters the directives to forward.
+	FilterFn bus_bridge.FilterFn
+}
+
+// NewRoute constructs a new route to a target bus for the directive names.
+func NewRoute(targetBusID string, directiveNames ...string) *Route {
+	return &Route{TargetBusID: targetBusID, DirectiveNames: directiveNames}
+}
+
+// Matches checks if the directive instance should be forwarded by the route.
+func (r *Route) Matches(di directive.Instance) (bool, error) {
+	if len(r.DirectiveNames) != 0 && !slices.Contains(r.DirectiveNames, di.GetDirective().GetName()) {
+		return false, nil
+	}
+	if r.FilterFn != nil {
+		return r.FilterFn(di)
+	}
+	return true, nil
+}
@@ -3,6 +3,7 @@ package inmem
 import (
 	"context"
 	"runtime/debug"
+	"runtime/pprof"
 	"sync"
 
 	"github.com/aperturerobotics/controllerbus/bus"
@@ -23,6 +24,13 @@ type Bus struct {
 	mtx sync.Mutex
 	// controllers is the set of attached controllers
 	controllers []*attachedCtrl
+	// ctrlCbs contains the controller added and removed callbacks
+	ctrlCbs []*ctrlCallback
+}
+
+// ctrlCallback is a controller added and removed callback.
+type ctrlCallback struct {
+	cb bus.ControllersCallback
 }
 
 // NewBus constructs a new in-memory Bus with a directive controller.
@@ -47,6 +55,42 @@ func (b *Bus) GetControllersBroadcast() *broadcast.Broadcast {
 	return &b.bcast
 }
 
+// AddControllersCallback adds a callback that is called when a controller
+// is added or removed. Calls cb with the existing controllers when added.
+// cb is called while the bus is locked: it should not block or call any
+// bus functions.
+// Returns a callback release function.
+func (b *Bus) AddControllersCallback(cb bus.ControllersCallback) func() {
+	cbCtr := &ctrlCallback{cb: cb}
+	b.mtx.Lock()
+	b.ctrlCbs = append(b.ctrlCbs, cbCtr)
+	for _, ci := range b.controllers {
+		cb(ci.ctrl, true)
+	}
+	b.mtx.Unlock()
+
+	var relOnce sync.Once
+	return func() {
+		relOnce.Do(func() {
+			b.mtx.Lock()
+			for i, ci := range b.ctrlCbs {
+				if ci == cbCtr {
+					b.ctrlCbs = append(b.ctrlCbs[:i], b.ctrlCbs[i+1:]...)
+					break
+				}
+			}
+			b.mtx.Unlock()
+		})
+	}
+}
+
+// callControllersCallbacksLocked calls the controller added or removed callbacks.
+func (b *Bus) callControllersCallbacksLocked(ctrl controller.Controller, added bool) {
+	for _, ci := range b.ctrlCbs {
+		ci.cb(ctrl, added)
+	}
+}
+
 // AddController adds a controller to the bus and calls Execute().
 // Returns a release function for the controller instance.
 // Any fatal error in the controller is written to the callback.
@@ -75,7 +119,7 @@ func (b *Bus) AddController(ctx context.Context, ctrl controller.Controller, cb
 				}
 			}
 		}()
-		err = ctrl.Execute(subCtx)
+		err = executeController(subCtx, ctrl)
 	}()
 	return relFunc, nil
 }
@@ -111,7 +155,16 @@ func (b *Bus) ExecuteController(ctx context.Context, c controller.Controller) (e
 		}
 	}()
 
-	return c.Execute(ctx)
+	return executeController(ctx, c)
+}
+
+// executeController calls Execute with the controller id pprof label.
+func executeController(ctx context.Context, c controller.Controller) (err error) {
+	labels := pprof.Labels(controller.PprofLabel, c.GetControllerInfo().GetId())
+	pprof.Do(ctx, labels, func(ctx context.Context) {
+		err = c.Execute(ctx)
+	})
+	return err
 }
 
 // RemoveController removes the controller from the bus.
@@ -128,6 +181,7 @@ func (b *Bus) addController(c controller.Controller) error {
 			ctrl: c,
 			rel:  rel,
 		})
+		b.callControllersCallbacksLocked(c, true)
 	}
 	b.mtx.Unlock()
 	if err == nil {
@@ -148,6 +202,7 @@ func (b *Bus) removeController(c controller.Controller) {
 			b.controllers[len(b.controllers)-1] = nil
 			b.controllers = b.controllers[:len(b.controllers)-1]
 			ci.rel()
+			b.callControllersCallbacksLocked(c, false)
 			removed = true
 			break
 		}
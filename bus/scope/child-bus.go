@@ -0,0 +1,149 @@
+package bus_scope
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/resolver/static"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/sirupsen/logrus"
+)
+
+// Version is the API version.
+var Version = controller.MustParseVersion("0.0.1")
+
+// ChildBus is a bus in a child scope of a parent bus.
+//
+// Controllers in the child bus see the directives in the child first. The
+// Policy decides which child directives fall through to the parent, and
+// which parent directives the child may resolve. The child bus has its own
+// loader and factory resolver, so the controllers running in the child are
+// isolated from the parent except for what the policy allows.
+type ChildBus struct {
+	bus.Bus
+
+	// ctx is canceled when the child bus is closed
+	ctx context.Context
+	// ctxCancel cancels ctx
+	ctxCancel context.CancelFunc
+	// le is the logger
+	le *logrus.Entry
+	// scopeID is the identifier of the child scope
+	scopeID string
+	// parent is the parent bus
+	parent bus.Bus
+	// policy is the scope policy
+	policy *Policy
+	// relControllers releases the fall-through and export controllers
+	relControllers []func()
+
+	// mtx guards below fields
+	mtx sync.Mutex
+	// fellThrough contains child directives forwarded to the parent
+	fellThrough map[directive.Directive]int
+	// exported contains parent directives forwarded to the child
+	exported map[directive.Directive]int
+}
+
+// NewChildBus constructs a new child bus of a parent bus.
+//
+// scopeID identifies the scope in controller info and logs.
+// Returns the static resolver for the child bus to add factories to.
+// Close the ChildBus to detach it from the parent.
+func NewChildBus(
+	ctx context.Context,
+	le *logrus.Entry,
+	parent bus.Bus,
+	scopeID string,
+	policy *Policy,
+) (*ChildBus, *static.Resolver, error) {
+	if policy == nil {
+		policy = &Policy{}
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	le = le.WithField("bus-scope", scopeID)
+	subCtx, subCtxCancel := context.WithCancel(ctx)
+	b, sr, err := core.NewCoreBus(subCtx, le)
+	if err != nil {
+		subCtxCancel()
+		return nil, nil, err
+	}
+
+	c := &ChildBus{
+		Bus:         b,
+		ctx:         subCtx,
+		ctxCancel:   subCtxCancel,
+		le:          le,
+		scopeID:     scopeID,
+		parent:      parent,
+		policy:      policy,
+		fellThrough: make(map[directive.Directive]int),
+		exported:    make(map[directive.Directive]int),
+	}
+
+	relFallThrough, err := b.AddController(subCtx, newFallThroughController(c), nil)
+	if err != nil {
+		subCtxCancel()
+		return nil, nil, err
+	}
+	relExport, err := parent.AddController(subCtx, newExportController(c), nil)
+	if err != nil {
+		relFallThrough()
+		subCtxCancel()
+		return nil, nil, err
+	}
+	c.relControllers = []func(){relFallThrough, relExport}
+	return c, sr, nil
+}
+
+// GetScopeID returns the identifier of the child scope.
+func (c *ChildBus) GetScopeID() string {
+	return c.scopeID
+}
+
+// GetParent returns the parent bus.
+func (c *ChildBus) GetParent() bus.Bus {
+	return c.parent
+}
+
+// GetPolicy returns the scope policy.
+func (c *ChildBus) GetPolicy() *Policy {
+	return c.policy
+}
+
+// Close detaches the child bus from the parent and stops the child bus.
+func (c *ChildBus) Close() {
+	c.ctxCancel()
+	for _, rel := range c.relControllers {
+		rel()
+	}
+}
+
+// markDirective increments the reference count of a directive in a set.
+//
+// Returns a function to decrement the reference count.
+func (c *ChildBus) markDirective(set map[directive.Directive]int, dir directive.Directive) func() {
+	c.mtx.Lock()
+	set[dir]++
+	c.mtx.Unlock()
+	return func() {
+		c.mtx.Lock()
+		if set[dir]--; set[dir] <= 0 {
+			delete(set, dir)
+		}
+		c.mtx.Unlock()
+	}
+}
+
+// isMarked checks if a directive is in a set.
+func (c *ChildBus) isMarked(set map[directive.Directive]int, dir directive.Directive) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return set[dir] != 0
+}
@@ -0,0 +1,73 @@
+package bus_scope
+
+import (
+	"context"
+
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// ExportControllerID is the id of the controller on the parent bus.
+const ExportControllerID = "controllerbus/bus/scope/export"
+
+// exportController runs on the parent bus and forwards exported directives to
+// the child bus.
+type exportController struct {
+	c *ChildBus
+}
+
+// newExportController constructs a new exportController.
+func newExportController(c *ChildBus) *exportController {
+	return &exportController{c: c}
+}
+
+// GetControllerInfo returns information about the controller.
+func (e *exportController) GetControllerInfo() *controller.Info {
+	return controller.NewInfo(ExportControllerID, Version, "scope export "+e.c.scopeID)
+}
+
+// Execute executes the controller goroutine.
+// Returning nil ends execution.
+// Returning an error triggers a retry with backoff.
+func (e *exportController) Execute(ctx context.Context) error {
+	return nil
+}
+
+// HandleDirective asks if the handler can resolve the directive.
+// If it can, it returns a resolver. If not, returns nil.
+// Any unexpected errors are returned for logging.
+// It is safe to add a reference to the directive during this call.
+// The context passed is canceled when the directive instance expires.
+func (e *exportController) HandleDirective(ctx context.Context, di directive.Instance) ([]directive.Resolver, error) {
+	dir := di.GetDirective()
+	if !e.c.policy.AllowExport(dir) || e.c.isMarked(e.c.fellThrough, dir) {
+		return nil, nil
+	}
+	return directive.R(&exportResolver{c: e.c, dir: dir}, nil)
+}
+
+// Close releases any resources used by the controller.
+// Error indicates any issue encountered releasing.
+func (e *exportController) Close() error {
+	return nil
+}
+
+// exportResolver resolves a parent directive with the child bus.
+type exportResolver struct {
+	c   *ChildBus
+	dir directive.Directive
+}
+
+// Resolve resolves the values, emitting them to the handler.
+func (r *exportResolver) Resolve(ctx context.Context, handler directive.ResolverHandler) error {
+	// mark before adding to the child so the child does not forward it back
+	rel := r.c.markDirective(r.c.exported, r.dir)
+	defer rel()
+	return forwardDirective(ctx, r.c.Bus, r.dir, handler, nil)
+}
+
+// _ is a type assertion
+var (
+	_ controller.Controller = ((*exportController)(nil))
+	_ directive.Resolver    = ((*exportResolver)(nil))
+)
@@ -0,0 +1,202 @@
+package bus_scope
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// FallThroughControllerID is the id of the controller on the child bus.
+const FallThroughControllerID = "controllerbus/bus/scope/fall-through"
+
+// fallThroughController runs on the child bus and forwards directives to the
+// parent bus if the child could not resolve them.
+type fallThroughController struct {
+	c *ChildBus
+}
+
+// newFallThroughController constructs a new fallThroughController.
+func newFallThroughController(c *ChildBus) *fallThroughController {
+	return &fallThroughController{c: c}
+}
+
+// GetControllerInfo returns information about the controller.
+func (f *fallThroughController) GetControllerInfo() *controller.Info {
+	return controller.NewInfo(FallThroughControllerID, Version, "scope fall-through "+f.c.scopeID)
+}
+
+// Execute executes the controller goroutine.
+// Returning nil ends execution.
+// Returning an error triggers a retry with backoff.
+func (f *fallThroughController) Execute(ctx context.Context) error {
+	return nil
+}
+
+// HandleDirective asks if the handler can resolve the directive.
+// If it can, it returns a resolver. If not, returns nil.
+// Any unexpected errors are returned for logging.
+// It is safe to add a reference to the directive during this call.
+// The context passed is canceled when the directive instance expires.
+func (f *fallThroughController) HandleDirective(ctx context.Context, di directive.Instance) ([]directive.Resolver, error) {
+	dir := di.GetDirective()
+	if !f.c.policy.AllowFallThrough(dir) || f.c.isMarked(f.c.exported, dir) {
+		return nil, nil
+	}
+	return directive.R(&fallThroughResolver{c: f.c, di: di}, nil)
+}
+
+// Close releases any resources used by the controller.
+// Error indicates any issue encountered releasing.
+func (f *fallThroughController) Close() error {
+	return nil
+}
+
+// fallThroughResolver forwards a child directive to the parent bus.
+//
+// Waits for the other resolvers on the child instance to become idle without
+// any values before forwarding. Stops forwarding and removes the values from
+// the parent if a child resolver adds a value. Forwards again if the parent
+// instance is disposed while the child instance is still referenced.
+type fallThroughResolver struct {
+	c  *ChildBus
+	di directive.Instance
+}
+
+// Resolve resolves the values, emitting them to the handler.
+func (r *fallThroughResolver) Resolve(ctx context.Context, handler directive.ResolverHandler) error {
+	// wakeCh is signaled when the state of the child instance changes
+	wakeCh := make(chan struct{}, 1)
+	wake := func() {
+		select {
+		case wakeCh <- struct{}{}:
+		default:
+		}
+	}
+
+	// mtx guards below fields
+	var mtx sync.Mutex
+	// siblingsIdle indicates the other resolvers are idle
+	var siblingsIdle bool
+	// vals contains the values on the child instance
+	var vals []directive.AttachedValue
+	// ours contains the ids of values added by this resolver
+	ours := make(map[uint32]struct{})
+	// adding counts values being added by this resolver
+	var adding int
+
+	relState := r.di.AddStateCallback(func(isIdle bool, errs []error, attachedVals []directive.AttachedValue) {
+		mtx.Lock()
+		vals = attachedVals
+		mtx.Unlock()
+		wake()
+	})
+	defer relState()
+
+	relSiblings := handler.AddSiblingsIdleCallback(func(isIdle bool, errs []error) {
+		mtx.Lock()
+		siblingsIdle = isIdle
+		mtx.Unlock()
+		wake()
+	})
+	defer relSiblings()
+
+	addValue := func(val directive.Value) (uint32, bool) {
+		mtx.Lock()
+		adding++
+		mtx.Unlock()
+		id, accepted := handler.AddValue(val)
+		mtx.Lock()
+		if accepted {
+			ours[id] = struct{}{}
+		}
+		adding--
+		mtx.Unlock()
+		wake()
+		return id, accepted
+	}
+
+	var fwdCancel context.CancelFunc
+	var fwdDone chan struct{}
+	// fwdErr is the error forwarding, set before fwdDone is closed
+	var fwdErr error
+	stopForward := func() {
+		if fwdCancel == nil {
+			return
+		}
+		fwdCancel()
+		<-fwdDone
+		fwdCancel, fwdDone = nil, nil
+		handler.ClearValues()
+		mtx.Lock()
+		clear(ours)
+		mtx.Unlock()
+		handler.MarkIdle(true)
+	}
+	defer stopForward()
+
+	dir := r.di.GetDirective()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wakeCh:
+		}
+
+		// forwarding stops if the parent instance was disposed: forward again
+		if fwdDone != nil {
+			select {
+			case <-fwdDone:
+				if fwdErr != nil {
+					r.c.le.WithError(fwdErr).Warn("unable to forward directive to parent bus")
+					return fwdErr
+				}
+				fwdCancel()
+				fwdCancel, fwdDone = nil, nil
+			default:
+			}
+		}
+
+		mtx.Lock()
+		if adding != 0 {
+			// woken again when the value is added
+			mtx.Unlock()
+			continue
+		}
+		isIdle, hasLocal := siblingsIdle, false
+		for _, val := range vals {
+			if _, ok := ours[val.GetValueID()]; !ok {
+				hasLocal = true
+				break
+			}
+		}
+		mtx.Unlock()
+
+		switch {
+		case fwdCancel == nil && isIdle && !hasLocal:
+			r.c.le.Debugf("falling through to parent bus: %s", dir.GetName())
+			fwdCtx, cancel := context.WithCancel(ctx)
+			fwdCancel, fwdDone = cancel, make(chan struct{})
+			go func(done chan struct{}) {
+				defer wake()
+				defer close(done)
+				// mark before adding to the parent so the parent does not export it back
+				rel := r.c.markDirective(r.c.fellThrough, dir)
+				defer rel()
+				fwdErr = forwardDirective(fwdCtx, r.c.parent, dir, handler, addValue)
+			}(fwdDone)
+		case fwdCancel != nil && hasLocal:
+			r.c.le.Debugf("resolved in child bus, stopping fall through: %s", dir.GetName())
+			stopForward()
+		case fwdCancel == nil && hasLocal:
+			handler.MarkIdle(true)
+		}
+	}
+}
+
+// _ is a type assertion
+var (
+	_ controller.Controller = ((*fallThroughController)(nil))
+	_ directive.Resolver    = ((*fallThroughResolver)(nil))
+)
@@ -0,0 +1,80 @@
+package bus_scope
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// forwardDirective adds a directive to the target bus and mirrors the values
+// and the idle state of the target instance to the handler.
+//
+// addValue adds a value to the handler, if nil uses handler.AddValue.
+// Returns when ctx is canceled or the target instance is disposed.
+func forwardDirective(
+	ctx context.Context,
+	target bus.Bus,
+	dir directive.Directive,
+	handler directive.ResolverHandler,
+	addValue func(val directive.Value) (uint32, bool),
+) error {
+	if addValue == nil {
+		addValue = handler.AddValue
+	}
+
+	subCtx, subCtxCancel := context.WithCancel(ctx)
+	defer subCtxCancel()
+
+	// mtx guards vmap
+	var mtx sync.Mutex
+	// vmap maps target value ids to local value ids
+	vmap := make(map[uint32]uint32)
+
+	di, diRef, err := target.AddDirective(
+		dir,
+		bus.NewCallbackHandler(
+			func(av directive.AttachedValue) {
+				// value added
+				id, accepted := addValue(av.GetValue())
+				if !accepted {
+					return
+				}
+				mtx.Lock()
+				vmap[av.GetValueID()] = id
+				mtx.Unlock()
+			}, func(av directive.AttachedValue) {
+				// value removed
+				mtx.Lock()
+				id, ok := vmap[av.GetValueID()]
+				if ok {
+					delete(vmap, av.GetValueID())
+				}
+				mtx.Unlock()
+				if ok {
+					handler.RemoveValue(id)
+				}
+			},
+			subCtxCancel,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	defer diRef.Release()
+
+	// mirror the idle state of the target
+	relIdle := di.AddIdleCallback(func(isIdle bool, errs []error) {
+		handler.MarkIdle(isIdle)
+	})
+	defer relIdle()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-subCtx.Done():
+	}
+	handler.ClearValues()
+	return nil
+}
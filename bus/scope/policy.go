@@ -0,0 +1,36 @@
+package bus_scope
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// ErrEmptyDirectiveName is returned if a policy contains an empty directive name.
+var ErrEmptyDirectiveName = errors.New("directive name cannot be empty")
+
+// Validate validates the policy.
+func (p *Policy) Validate() error {
+	for _, name := range p.GetFallThrough() {
+		if name == "" {
+			return ErrEmptyDirectiveName
+		}
+	}
+	for _, name := range p.GetExport() {
+		if name == "" {
+			return ErrEmptyDirectiveName
+		}
+	}
+	return nil
+}
+
+// AllowFallThrough checks if a child directive can be forwarded to the parent.
+func (p *Policy) AllowFallThrough(dir directive.Directive) bool {
+	return p.GetFallThroughAll() || slices.Contains(p.GetFallThrough(), dir.GetName())
+}
+
+// AllowExport checks if a parent directive can be resolved by the child.
+func (p *Policy) AllowExport(dir directive.Directive) bool {
+	return p.GetExportAll() || slices.Contains(p.GetExport(), dir.GetName())
+}
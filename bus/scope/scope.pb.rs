@@ -0,0 +1,20 @@
+// @generated
+// This file is @generated by prost-build.
+/// Policy declares which directives cross between a child bus and its parent.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct Policy {
+    /// FallThrough is the list of child directive names forwarded to the parent.
+    /// Directives are forwarded only if the child did not resolve a value.
+    #[prost(string, repeated, tag="1")]
+    pub fall_through: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// FallThroughAll forwards all child directives to the parent.
+    #[prost(bool, tag="2")]
+    pub fall_through_all: bool,
+    /// Export is the list of parent directive names the child may resolve.
+    #[prost(string, repeated, tag="3")]
+    pub export: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// ExportAll allows the child to resolve all parent directives.
+    #[prost(bool, tag="4")]
+    pub export_all: bool,
+}
+// @@protoc_insertion_point(module)
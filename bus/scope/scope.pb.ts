@@ -0,0 +1,65 @@
+// @generated by protoc-gen-es-lite unknown with parameter "target=ts,ts_nocheck=false"
+// @generated from file github.com/aperturerobotics/controllerbus/bus/scope/scope.proto (package bus.scope, syntax proto3)
+/* eslint-disable */
+
+import type { MessageType, PartialFieldInfo } from '@aptre/protobuf-es-lite'
+import { createMessageType, ScalarType } from '@aptre/protobuf-es-lite'
+
+export const protobufPackage = 'bus.scope'
+
+/**
+ * Policy declares which directives cross between a child bus and its parent.
+ *
+ * @generated from message bus.scope.Policy
+ */
+export interface Policy {
+  /**
+   * FallThrough is the list of child directive names forwarded to the parent.
+   * Directives are forwarded only if the child did not resolve a value.
+   *
+   * @generated from field: repeated string fall_through = 1;
+   */
+  fallThrough?: string[]
+  /**
+   * FallThroughAll forwards all child directives to the parent.
+   *
+   * @generated from field: bool fall_through_all = 2;
+   */
+  fallThroughAll?: boolean
+  /**
+   * Export is the list of parent directive names the child may resolve.
+   *
+   * @generated from field: repeated string export = 3;
+   */
+  export?: string[]
+  /**
+   * ExportAll allows the child to resolve all parent directives.
+   *
+   * @generated from field: bool export_all = 4;
+   */
+  exportAll?: boolean
+}
+
+// Policy contains the message type declaration for Policy.
+export const Policy: MessageType<Policy> = createMessageType({
+  typeName: 'bus.scope.Policy',
+  fields: [
+    {
+      no: 1,
+      name: 'fall_through',
+      kind: 'scalar',
+      T: ScalarType.STRING,
+      repeated: true,
+    },
+    { no: 2, name: 'fall_through_all', kind: 'scalar', T: ScalarType.BOOL },
+    {
+      no: 3,
+      name: 'export',
+      kind: 'scalar',
+      T: ScalarType.STRING,
+      repeated: true,
+    },
+    { no: 4, name: 'export_all', kind: 'scalar', T: ScalarType.BOOL },
+  ] as readonly PartialFieldInfo[],
+  packedByDefault: true,
+})
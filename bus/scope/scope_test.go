@@ -0,0 +1,190 @@
+package bus_scope
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/controller/resolver/static"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/aperturerobotics/controllerbus/example/boilerplate"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// testScope constructs a parent bus and a child bus with the policy.
+func testScope(t *testing.T, ctx context.Context, policy *Policy) (bus.Bus, *static.Resolver, *ChildBus, *static.Resolver) {
+	le := logrus.NewEntry(logrus.New())
+	parent, parentSr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	child, childSr, err := NewChildBus(ctx, le, parent, "test-scope", policy)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	t.Cleanup(child.Close)
+	return parent, parentSr, child, childSr
+}
+
+// runBoilerplate runs the boilerplate controller on the bus.
+func runBoilerplate(t *testing.T, ctx context.Context, b bus.Bus, sr *static.Resolver) {
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+	_, _, ctrlRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&boilerplate_controller.Config{ExampleField: "testing"}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	t.Cleanup(ctrlRef.Release)
+}
+
+// execBoilerplate executes the boilerplate directive on the bus.
+//
+// Returns nil if the directive became idle without a value.
+func execBoilerplate(t *testing.T, ctx context.Context, b bus.Bus) directive.AttachedValue {
+	res, _, resRef, err := bus.ExecOneOff(ctx, b, &boilerplate_v1.Boilerplate{
+		MessageText: "hello world",
+	}, bus.ReturnWhenIdle(), nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resRef != nil {
+		t.Cleanup(resRef.Release)
+	}
+	if res != nil {
+		plen := res.GetValue().(boilerplate.BoilerplateResult).GetPrintedLen()
+		if plen != 55 {
+			t.Fatalf("expected length 55 got %d", plen)
+		}
+	}
+	return res
+}
+
+// findBoilerplate finds the boilerplate directive instance on a bus.
+func findBoilerplate(b bus.Bus) directive.Instance {
+	for _, di := range b.GetDirectives() {
+		if di.GetDirective().GetName() == "Boilerplate" {
+			return di
+		}
+	}
+	return nil
+}
+
+// TestChildBusFallThrough tests resolving a child directive with the parent.
+func TestChildBusFallThrough(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	parent, parentSr, child, _ := testScope(t, ctx, &Policy{FallThrough: []string{"Boilerplate"}})
+	runBoilerplate(t, ctx, parent, parentSr)
+	if execBoilerplate(t, ctx, child) == nil {
+		t.Fatal("expected value from parent bus")
+	}
+}
+
+// TestChildBusFallThroughDisposed tests forwarding again after the parent
+// instance is disposed while the child instance is still referenced.
+func TestChildBusFallThroughDisposed(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	parent, parentSr, child, _ := testScope(t, ctx, &Policy{FallThrough: []string{"Boilerplate"}})
+	runBoilerplate(t, ctx, parent, parentSr)
+	if execBoilerplate(t, ctx, child) == nil {
+		t.Fatal("expected value from parent bus")
+	}
+	childDi, parentDi := findBoilerplate(child), findBoilerplate(parent)
+	if childDi == nil || parentDi == nil {
+		t.Fatal("expected directive on child and parent bus")
+	}
+	var nvals atomic.Int32
+	relState := childDi.AddStateCallback(func(isIdle bool, errs []error, vals []directive.AttachedValue) {
+		nvals.Store(int32(len(vals)))
+	})
+	defer relState()
+	parentDi.Close()
+
+	// the child still references the directive: it must fall through again
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		nextDi := findBoilerplate(parent)
+		if nextDi != nil && nextDi != parentDi && nvals.Load() == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected directive to fall through to parent again")
+		}
+		<-time.After(10 * time.Millisecond)
+	}
+}
+
+// TestChildBusNoFallThrough tests that the policy blocks other directives.
+func TestChildBusNoFallThrough(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	parent, parentSr, child, _ := testScope(t, ctx, &Policy{FallThrough: []string{"OtherDirective"}})
+	runBoilerplate(t, ctx, parent, parentSr)
+	if execBoilerplate(t, ctx, child) != nil {
+		t.Fatal("expected no value to be resolved")
+	}
+	if findBoilerplate(parent) != nil {
+		t.Fatal("expected directive not to fall through to parent")
+	}
+}
+
+// TestChildBusExport tests resolving a parent directive with the child.
+func TestChildBusExport(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	parent, _, child, childSr := testScope(t, ctx, &Policy{
+		FallThroughAll: true,
+		Export:         []string{"Boilerplate"},
+	})
+	runBoilerplate(t, ctx, child, childSr)
+	if execBoilerplate(t, ctx, parent) == nil {
+		t.Fatal("expected value from child bus")
+	}
+}
+
+// TestChildBusChildFirst tests that child values take precedence over the parent.
+func TestChildBusChildFirst(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	// loading the controller must not fall through: the child controller
+	// has to run before executing the directive
+	parent, parentSr, child, childSr := testScope(t, ctx, &Policy{FallThrough: []string{"Boilerplate"}})
+	runBoilerplate(t, ctx, parent, parentSr)
+	runBoilerplate(t, ctx, child, childSr)
+	if execBoilerplate(t, ctx, child) == nil {
+		t.Fatal("expected value from child bus")
+	}
+
+	// the child resolved the directive: it must stop falling through
+	deadline := time.Now().Add(5 * time.Second)
+	for findBoilerplate(parent) != nil {
+		if time.Now().After(deadline) {
+			t.Fatal("expected directive to be released on parent")
+		}
+		<-time.After(10 * time.Millisecond)
+	}
+}
+
+// TestPolicyValidate tests validating a policy.
+func TestPolicyValidate(t *testing.T) {
+	if err := (&Policy{Export: []string{""}}).Validate(); err != ErrEmptyDirectiveName {
+		t.Fatalf("expected empty name error got %v", err)
+	}
+}
@@ -2,7 +2,9 @@ package bus
 
 import (
 	"context"
+	"slices"
 
+	"github.com/aperturerobotics/controllerbus/controller"
 	"github.com/aperturerobotics/controllerbus/directive"
 )
 
@@ -52,3 +54,25 @@ func ExecWaitValue[T directive.Value](
 	// note: type is already asserted above
 	return av.GetValue().(T), avDi, avRef, nil
 }
+
+// WaitControllerRemoved waits for the controller to be removed from the bus.
+//
+// Returns false if ctx was canceled before the controller was removed.
+func WaitControllerRemoved(ctx context.Context, b Bus, ctrl controller.Controller) bool {
+	for {
+		var found bool
+		var waitCh <-chan struct{}
+		b.GetControllersBroadcast().HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+			found = slices.Contains(b.GetControllers(), ctrl)
+			waitCh = getWaitCh()
+		})
+		if !found {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-waitCh:
+		}
+	}
+}
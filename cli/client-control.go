@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"os"
+	"strings"
+
+	"github.com/aperturerobotics/cli"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+)
+
+// buildControllerTarget builds the controller target from the flags.
+//
+// The first argument is used as the config key if the flag is not set.
+func (a *ClientArgs) buildControllerTarget(c *cli.Context) *bus_api.ControllerTarget {
+	configKey := a.ControlConfigKey
+	if configKey == "" {
+		configKey = c.Args().First()
+	}
+	return &bus_api.ControllerTarget{
+		ConfigKey:    configKey,
+		ControllerId: a.ControlControllerID,
+	}
+}
+
+// RunStopController runs the stop controller command.
+func (a *ClientArgs) RunStopController(c *cli.Context) error {
+	ctx := a.GetContext()
+	client, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.StopController(ctx, &bus_api.StopControllerRequest{Target: a.buildControllerTarget(c)})
+	if err != nil {
+		return err
+	}
+	printControlledKeys("stopped", resp.GetConfigKeys())
+	return nil
+}
+
+// RunRestartController runs the restart controller command.
+func (a *ClientArgs) RunRestartController(c *cli.Context) error {
+	ctx := a.GetContext()
+	client, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RestartController(ctx, &bus_api.RestartControllerRequest{Target: a.buildControllerTarget(c)})
+	if err != nil {
+		return err
+	}
+	printControlledKeys("restarted", resp.GetConfigKeys())
+	return nil
+}
+
+// RunRemoveController runs the remove controller command.
+func (a *ClientArgs) RunRemoveController(c *cli.Context) error {
+	ctx := a.GetContext()
+	client, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.RemoveController(ctx, &bus_api.RemoveControllerRequest{Target: a.buildControllerTarget(c)})
+	if err != nil {
+		return err
+	}
+	printControlledKeys("removed", resp.GetConfigKeys())
+	return nil
+}
+
+// printControlledKeys prints the configset keys affected by a command.
+func printControlledKeys(action string, keys []string) {
+	os.Stdout.WriteString(action)
+	os.Stdout.WriteString(": ")
+	os.Stdout.WriteString(strings.Join(keys, ", "))
+	os.Stdout.WriteString("\n")
+}
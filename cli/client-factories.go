@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/aperturerobotics/cli"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+)
+
+// RunFactories runs the list factories command.
+func (a *ClientArgs) RunFactories(_ *cli.Context) error {
+	ctx := a.GetContext()
+	c, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.ListFactories(ctx, &bus_api.ListFactoriesRequest{})
+	if err != nil {
+		return err
+	}
+
+	if a.Interactive {
+		_, _ = os.Stdout.Write(printFactories(resp.GetFactories()))
+		return nil
+	}
+	dat, err := resp.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	os.Stdout.Write(dat)
+	os.Stdout.WriteString("\n")
+	return nil
+}
+
+// printFactories pretty-prints the list of factories with the config fields.
+func printFactories(factories []*bus_api.FactoryInfo) []byte {
+	var dat bytes.Buffer
+	if len(factories) == 0 {
+		_, _ = dat.WriteString("● no factories\n")
+		return dat.Bytes()
+	}
+
+	for _, factory := range factories {
+		_, _ = dat.WriteString(factory.GetConfigId())
+		_, _ = dat.WriteString(" ")
+		_, _ = dat.WriteString(factory.GetVersion())
+		_, _ = dat.WriteString("\n\tresolver: ")
+		_, _ = dat.WriteString(factory.GetResolverId())
+
+		var schema struct {
+			Properties map[string]struct {
+				Type any `json:"type"`
+			} `json:"properties"`
+		}
+		if err := json.Unmarshal([]byte(factory.GetConfigSchema()), &schema); err == nil {
+			fields := make([]string, 0, len(schema.Properties))
+			for name := range schema.Properties {
+				fields = append(fields, name)
+			}
+			slices.Sort(fields)
+			for _, name := range fields {
+				_, _ = dat.WriteString("\n\t")
+				_, _ = dat.WriteString(name)
+				switch typ := schema.Properties[name].Type.(type) {
+				case string:
+					_, _ = dat.WriteString(": ")
+					_, _ = dat.WriteString(typ)
+				case []any:
+					var types []string
+					for _, t := range typ {
+						if ts, ok := t.(string); ok {
+							types = append(types, ts)
+						}
+					}
+					_, _ = dat.WriteString(": ")
+					_, _ = dat.WriteString(strings.Join(types, " | "))
+				}
+			}
+		}
+		_, _ = dat.WriteString("\n")
+	}
+	return dat.Bytes()
+}
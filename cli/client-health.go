@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/aperturerobotics/cli"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+)
+
+// RunHealth runs the health command.
+//
+// Returns an error if the bus is not live or not ready.
+func (a *ClientArgs) RunHealth(_ *cli.Context) error {
+	ctx := a.GetContext()
+	c, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+
+	health, err := c.GetHealth(ctx, &bus_api.GetHealthRequest{})
+	if err != nil {
+		return err
+	}
+
+	if a.Interactive {
+		_, _ = os.Stdout.Write(health.PrintPrettyStatus())
+	} else {
+		dat, err := json.MarshalIndent(health, "", "\t")
+		if err != nil {
+			return err
+		}
+		os.Stdout.WriteString(string(dat))
+		os.Stdout.WriteString("\n")
+	}
+
+	if !health.GetLive() {
+		return errors.New("bus is not live")
+	}
+	if !health.GetReady() {
+		return errors.New("bus is not ready")
+	}
+	return nil
+}
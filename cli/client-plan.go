@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aperturerobotics/cli"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	cbyaml "github.com/aperturerobotics/controllerbus/yaml"
+	"github.com/pkg/errors"
+	gdiff "github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// RunPlan runs the plan configset command.
+//
+// Returns an error if any config in the configset is invalid.
+func (a *ClientArgs) RunPlan(_ *cli.Context) error {
+	ctx := a.GetContext()
+
+	csPath := a.PlanConfigSetPath
+	if csPath == "" {
+		return errors.New("config set file must be specified")
+	}
+	data, err := os.ReadFile(csPath)
+	if err != nil {
+		return err
+	}
+	jdat, err := cbyaml.YAMLToJSON(data)
+	if err != nil {
+		return errors.Wrap(err, "parse configset file")
+	}
+
+	c, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+	plan, err := c.PlanConfigSet(ctx, &bus_api.PlanConfigSetRequest{
+		ConfigSetYaml: string(jdat),
+		Replace:       a.PlanReplace,
+	})
+	if err != nil {
+		return err
+	}
+
+	if a.Interactive {
+		_, _ = os.Stdout.Write(printPlan(plan.GetKeys()))
+	} else {
+		dat, err := json.MarshalIndent(plan, "", "\t")
+		if err != nil {
+			return err
+		}
+		os.Stdout.WriteString(string(dat))
+		os.Stdout.WriteString("\n")
+	}
+
+	var invalid int
+	for _, key := range plan.GetKeys() {
+		if key.GetAction() == bus_api.PlanAction_PlanAction_INVALID {
+			invalid++
+		}
+	}
+	if invalid != 0 {
+		return errors.Errorf("%d invalid config(s)", invalid)
+	}
+	return nil
+}
+
+// printPlan pretty-prints the planned changes with a diff of each config.
+func printPlan(keys []*bus_api.ConfigKeyPlan) []byte {
+	var dat bytes.Buffer
+	counts := make(map[bus_api.PlanAction]int)
+	for _, key := range keys {
+		action := key.GetAction()
+		counts[action]++
+
+		var prefix, desc string
+		switch action {
+		case bus_api.PlanAction_PlanAction_START:
+			prefix, desc = "+", "start"
+		case bus_api.PlanAction_PlanAction_STOP:
+			prefix, desc = "-", "stop"
+		case bus_api.PlanAction_PlanAction_RESTART:
+			prefix, desc = "~", "restart rev "+strconv.FormatUint(key.GetCurrentRev(), 10)+" -> "+strconv.FormatUint(key.GetRev(), 10)
+		case bus_api.PlanAction_PlanAction_IGNORED:
+			prefix, desc = "!", "ignored: rev "+strconv.FormatUint(key.GetRev(), 10)+" is not newer than applied rev "+strconv.FormatUint(key.GetCurrentRev(), 10)+"; store put and config reload would restart it"
+		case bus_api.PlanAction_PlanAction_INVALID:
+			prefix, desc = "✗", "invalid: "+key.GetError()
+			if key.GetUnknownConfigId() {
+				desc = "unknown config id"
+			}
+		default:
+			prefix, desc = "=", "unchanged"
+		}
+		_, _ = dat.WriteString(prefix)
+		_, _ = dat.WriteString(" ")
+		_, _ = dat.WriteString(key.GetConfigKey())
+		if configID := key.GetConfigId(); configID != "" {
+			_, _ = dat.WriteString(" (")
+			_, _ = dat.WriteString(configID)
+			_, _ = dat.WriteString(")")
+		}
+		_, _ = dat.WriteString(" ")
+		_, _ = dat.WriteString(desc)
+		_, _ = dat.WriteString("\n")
+
+		switch action {
+		case bus_api.PlanAction_PlanAction_START,
+			bus_api.PlanAction_PlanAction_STOP,
+			bus_api.PlanAction_PlanAction_RESTART,
+			bus_api.PlanAction_PlanAction_IGNORED:
+			writeLineDiff(&dat, key.GetCurrentConfigYaml(), key.GetConfigYaml())
+		}
+	}
+
+	if len(keys) == 0 {
+		_, _ = dat.WriteString("● no configs\n")
+		return dat.Bytes()
+	}
+	_, _ = dat.WriteString(strings.Join([]string{
+		strconv.Itoa(counts[bus_api.PlanAction_PlanAction_START]) + " to start",
+		strconv.Itoa(counts[bus_api.PlanAction_PlanAction_RESTART]) + " to restart",
+		strconv.Itoa(counts[bus_api.PlanAction_PlanAction_STOP]) + " to stop",
+		strconv.Itoa(counts[bus_api.PlanAction_PlanAction_UNCHANGED]) + " unchanged",
+		strconv.Itoa(counts[bus_api.PlanAction_PlanAction_IGNORED]) + " ignored",
+		strconv.Itoa(counts[bus_api.PlanAction_PlanAction_INVALID]) + " invalid",
+	}, ", "))
+	_, _ = dat.WriteString("\n")
+	return dat.Bytes()
+}
+
+// writeLineDiff writes a line diff from prev to next indented with a tab.
+func writeLineDiff(dat *bytes.Buffer, prev, next string) {
+	dmp := gdiff.New()
+	prevChars, nextChars, lines := dmp.DiffLinesToChars(prev, next)
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(prevChars, nextChars, false), lines)
+	for _, diff := range diffs {
+		prefix := "  "
+		switch diff.Type {
+		case gdiff.DiffInsert:
+			prefix = "+ "
+		case gdiff.DiffDelete:
+			prefix = "- "
+		}
+		for _, line := range strings.SplitAfter(diff.Text, "\n") {
+			if line == "" {
+				continue
+			}
+			_, _ = dat.WriteString("\t")
+			_, _ = dat.WriteString(prefix)
+			_, _ = dat.WriteString(line)
+			if !strings.HasSuffix(line, "\n") {
+				_, _ = dat.WriteString("\n")
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aperturerobotics/cli"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	configset_store "github.com/aperturerobotics/controllerbus/controller/configset/store"
+	cbyaml "github.com/aperturerobotics/controllerbus/yaml"
+	"github.com/pkg/errors"
+)
+
+// buildStoreCommand builds the configset store command.
+func (a *ClientArgs) buildStoreCommand() *cli.Command {
+	keyFlag := &cli.StringFlag{
+		Name:        "key",
+		Aliases:     []string{"k"},
+		Usage:       "key of the stored configset",
+		Destination: &a.StoreKey,
+	}
+	sourceFlag := &cli.StringFlag{
+		Name:        "source",
+		Usage:       "description of who or what stored the revision",
+		Value:       "cli",
+		Destination: &a.StoreSource,
+	}
+	return &cli.Command{
+		Name:  "store",
+		Usage: "manage configsets in the daemon configset store",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "history",
+				Usage:  "list the revisions of a stored configset, or the stored keys if no key is set",
+				Action: a.RunStoreHistory,
+				Flags: []cli.Flag{
+					keyFlag,
+					&cli.BoolFlag{
+						Name:        "interactive",
+						Usage:       "print interactive (pretty print) output",
+						Destination: &a.Interactive,
+						Value:       true,
+						EnvVars:     []string{"CONTROLLER_BUS_INTERACTIVE"},
+					},
+				},
+			},
+			{
+				Name:   "put",
+				Usage:  "store a configset as a new revision and apply it",
+				Action: a.RunStorePut,
+				Flags: []cli.Flag{
+					keyFlag,
+					sourceFlag,
+					&cli.StringFlag{
+						Name:        "config-set-file",
+						Aliases:     []string{"f"},
+						Usage:       "path to config set json or yaml file",
+						Destination: &a.StoreConfigSetPath,
+					},
+				},
+			},
+			{
+				Name:   "rollback",
+				Usage:  "store a copy of an earlier revision as a new revision and apply it",
+				Action: a.RunStoreRollback,
+				Flags: []cli.Flag{
+					keyFlag,
+					sourceFlag,
+					&cli.Uint64Flag{
+						Name:        "rev",
+						Usage:       "revision to roll back to",
+						Destination: &a.StoreRev,
+					},
+				},
+			},
+		},
+	}
+}
+
+// RunStoreHistory runs the configset store history command.
+func (a *ClientArgs) RunStoreHistory(_ *cli.Context) error {
+	ctx := a.GetContext()
+	c, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.GetConfigSetHistory(ctx, &bus_api.GetConfigSetHistoryRequest{StoreKey: a.StoreKey})
+	if err != nil {
+		return err
+	}
+
+	if a.Interactive {
+		_, _ = os.Stdout.Write(printStoreHistory(resp))
+		return nil
+	}
+	dat, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		return err
+	}
+	os.Stdout.WriteString(string(dat))
+	os.Stdout.WriteString("\n")
+	return nil
+}
+
+// RunStorePut runs the configset store put command.
+func (a *ClientArgs) RunStorePut(_ *cli.Context) error {
+	ctx := a.GetContext()
+
+	csPath := a.StoreConfigSetPath
+	if csPath == "" {
+		return errors.New("config set file must be specified")
+	}
+	data, err := os.ReadFile(csPath)
+	if err != nil {
+		return err
+	}
+	jdat, err := cbyaml.YAMLToJSON(data)
+	if err != nil {
+		return errors.Wrap(err, "parse configset file")
+	}
+
+	c, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+	resp, err := c.PutConfigSet(ctx, &bus_api.PutConfigSetRequest{
+		StoreKey:      a.StoreKey,
+		ConfigSetYaml: string(jdat),
+		Source:        a.StoreSource,
+	})
+	if err != nil {
+		return err
+	}
+	os.Stdout.WriteString("stored " + a.StoreKey + " revision " + strconv.FormatUint(resp.GetRevision().GetRev(), 10) + "\n")
+	return nil
+}
+
+// RunStoreRollback runs the configset store rollback command.
+func (a *ClientArgs) RunStoreRollback(_ *cli.Context) error {
+	ctx := a.GetContext()
+	if a.StoreRev == 0 {
+		return errors.New("revision must be specified")
+	}
+
+	c, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+	resp, err := c.RollbackConfigSet(ctx, &bus_api.RollbackConfigSetRequest{
+		StoreKey: a.StoreKey,
+		Rev:      a.StoreRev,
+		Source:   a.StoreSource,
+	})
+	if err != nil {
+		return err
+	}
+	os.Stdout.WriteString(
+		"rolled back " + a.StoreKey + " to revision " + strconv.FormatUint(a.StoreRev, 10) +
+			" as revision " + strconv.FormatUint(resp.GetRevision().GetRev(), 10) + "\n",
+	)
+	return nil
+}
+
+// printStoreHistory pretty-prints the stored keys or the revisions of a key.
+func printStoreHistory(resp *bus_api.GetConfigSetHistoryResponse) []byte {
+	var dat bytes.Buffer
+	if keys := resp.GetStoreKeys(); len(keys) != 0 {
+		for _, key := range keys {
+			_, _ = dat.WriteString(key)
+			_, _ = dat.WriteString("\n")
+		}
+		return dat.Bytes()
+	}
+	revs := resp.GetRevisions()
+	if len(revs) == 0 {
+		_, _ = dat.WriteString("● no revisions\n")
+		return dat.Bytes()
+	}
+	for _, rev := range revs {
+		_, _ = dat.WriteString(strconv.FormatUint(rev.GetRev(), 10))
+		_, _ = dat.WriteString("\t")
+		_, _ = dat.WriteString(rev.GetTimestamp().Format(time.RFC3339))
+		_, _ = dat.WriteString("\t")
+		_, _ = dat.WriteString(rev.GetSource())
+		_, _ = dat.WriteString("\t")
+		_, _ = dat.WriteString(printStoredKeys(rev))
+		if rollbackRev := rev.GetRollbackRev(); rollbackRev != 0 {
+			_, _ = dat.WriteString(" (rollback to ")
+			_, _ = dat.WriteString(strconv.FormatUint(rollbackRev, 10))
+			_, _ = dat.WriteString(")")
+		}
+		_, _ = dat.WriteString("\n")
+	}
+	return dat.Bytes()
+}
+
+// printStoredKeys returns the sorted configset keys of the revision.
+func printStoredKeys(rev *configset_store.ConfigSetRevision) string {
+	configs := rev.GetConfigSet().GetConfigs()
+	keys := make([]string, 0, len(configs))
+	for key := range configs {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	var out bytes.Buffer
+	_, _ = out.WriteString(strconv.Itoa(len(keys)))
+	_, _ = out.WriteString(" configs")
+	for i, key := range keys {
+		if i == 0 {
+			_, _ = out.WriteString(": ")
+		} else {
+			_, _ = out.WriteString(", ")
+		}
+		_, _ = out.WriteString(key)
+	}
+	return out.String()
+}
@@ -0,0 +1,452 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aperturerobotics/cli"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	controller_exec "github.com/aperturerobotics/controllerbus/controller/exec"
+)
+
+// topSortFields are the fields the directives can be sorted by.
+var topSortFields = []string{"name", "values", "state", "errors"}
+
+// topHelp is the help line for the top commands.
+const topHelp = "commands: s <name|values|state|errors> sort, f <text> filter, d <#> details, b back, q quit"
+
+// RunTop runs the live bus dashboard command.
+func (a *ClientArgs) RunTop(_ *cli.Context) error {
+	if !slices.Contains(topSortFields, a.TopSort) {
+		return fmt.Errorf("unknown sort field %q: expected one of %s", a.TopSort, strings.Join(topSortFields, ", "))
+	}
+	refresh := a.TopRefresh
+	if refresh <= 0 {
+		refresh = time.Second
+	}
+
+	ctx, ctxCancel := context.WithCancel(a.GetContext())
+	defer ctxCancel()
+	c, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+
+	m := newTopModel(a.TopSort, a.TopFilter)
+	strm, err := c.WatchBusInfo(ctx, &bus_api.WatchBusInfoRequest{})
+	if err != nil {
+		return err
+	}
+	defer strm.Close()
+
+	// errCh receives the error that stopped the dashboard
+	errCh := make(chan error, 2)
+	snapshotCh := make(chan struct{})
+	go func() {
+		var snapshotOnce sync.Once
+		for {
+			ev, err := strm.Recv()
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				errCh <- err
+				return
+			}
+			m.applyEvent(ev)
+			snapshotOnce.Do(func() { close(snapshotCh) })
+		}
+	}()
+
+	// wait for the snapshot, which contains the state of the directives
+	select {
+	case <-ctx.Done():
+		return context.Canceled
+	case err := <-errCh:
+		return err
+	case <-snapshotCh:
+	}
+
+	if a.TopOnce {
+		if err := m.refresh(ctx, c); err != nil {
+			return err
+		}
+		_, _ = os.Stdout.Write(m.render())
+		return nil
+	}
+
+	// read commands from stdin
+	go func() {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if m.handleCommand(scanner.Text()) {
+				errCh <- nil
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+	for {
+		if err := m.refresh(ctx, c); err != nil {
+			return err
+		}
+		_, _ = os.Stdout.WriteString("\033[H\033[2J")
+		_, _ = os.Stdout.Write(m.render())
+		_, _ = os.Stdout.WriteString(topHelp + "\n")
+
+		select {
+		case <-ctx.Done():
+			return context.Canceled
+		case err := <-errCh:
+			return err
+		case <-ticker.C:
+		case <-m.wakeCh:
+		}
+	}
+}
+
+// topDirective is a directive tracked by the dashboard.
+type topDirective struct {
+	handle     uint32
+	instanceID uint32
+	ident      string
+	idle       bool
+	valueCount uint32
+	errs       []string
+}
+
+// topModel contains the dashboard state.
+type topModel struct {
+	// wakeCh is signaled when a command changes the view
+	wakeCh chan struct{}
+
+	// mtx guards below fields
+	mtx sync.Mutex
+	// sortField is the directive sort field
+	sortField string
+	// filter filters the controllers and directives by substring
+	filter string
+	// message is a status message to display
+	message string
+	// controllers contains the running controllers by handle
+	controllers map[uint32]*bus_api.WatchBusInfoController
+	// directives contains the directives by handle
+	directives map[uint32]*topDirective
+	// configStates contains the configset controller states
+	configStates []*controller_exec.ExecControllerResponse
+	// detail is the handle of the directive to show details for, if set
+	detail uint32
+	// detailInfo is the directive info for detail
+	detailInfo *bus_api.GetDirectiveInfoResponse
+}
+
+// newTopModel constructs a new topModel.
+func newTopModel(sortField, filter string) *topModel {
+	return &topModel{
+		wakeCh:      make(chan struct{}, 1),
+		sortField:   sortField,
+		filter:      filter,
+		controllers: make(map[uint32]*bus_api.WatchBusInfoController),
+		directives:  make(map[uint32]*topDirective),
+	}
+}
+
+// applyEvent applies a WatchBusInfo event to the model.
+func (m *topModel) applyEvent(ev *bus_api.WatchBusInfoResponse) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	switch ev.GetEventType() {
+	case bus_api.WatchBusInfoEventType_WatchBusInfoEventType_CONTROLLER_REMOVED:
+		for _, ctrl := range ev.GetControllers() {
+			delete(m.controllers, ctrl.GetHandle())
+		}
+	case bus_api.WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_REMOVED:
+		for _, dir := range ev.GetDirectives() {
+			delete(m.directives, dir.GetHandle())
+		}
+	case bus_api.WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_STATE:
+		for _, dir := range ev.GetDirectives() {
+			if td := m.directives[dir.GetHandle()]; td != nil {
+				td.idle = dir.GetIdle()
+				td.valueCount = dir.GetValueCount()
+				td.errs = dir.GetResolverErrors()
+			}
+		}
+	default:
+		for _, ctrl := range ev.GetControllers() {
+			m.controllers[ctrl.GetHandle()] = ctrl
+		}
+		// the snapshot contains the directive state
+		for _, dir := range ev.GetDirectives() {
+			m.directives[dir.GetHandle()] = &topDirective{
+				handle:     dir.GetHandle(),
+				instanceID: dir.GetState().GetInstanceId(),
+				ident:      dir.GetState().GetIdent(),
+				idle:       dir.GetIdle(),
+				valueCount: dir.GetValueCount(),
+				errs:       dir.GetResolverErrors(),
+			}
+		}
+	}
+}
+
+// refresh fetches the configset states and the detail directive info.
+func (m *topModel) refresh(ctx context.Context, c bus_api.SRPCControllerBusServiceClient) error {
+	info, err := c.GetBusInfo(ctx, &bus_api.GetBusInfoRequest{})
+	if err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	m.configStates = info.GetConfigSetControllers()
+	var detailID uint32
+	if td := m.directives[m.detail]; td != nil {
+		detailID = td.instanceID
+	}
+	m.mtx.Unlock()
+
+	var detailInfo *bus_api.GetDirectiveInfoResponse
+	if detailID != 0 {
+		detailInfo, err = c.GetDirectiveInfo(ctx, &bus_api.GetDirectiveInfoRequest{InstanceId: detailID})
+		if err != nil {
+			return err
+		}
+	}
+
+	m.mtx.Lock()
+	m.detailInfo = detailInfo
+	m.mtx.Unlock()
+	return nil
+}
+
+// handleCommand handles a command line from the user.
+// Returns true if the dashboard should exit.
+func (m *topModel) handleCommand(line string) bool {
+	cmd, arg, _ := strings.Cut(strings.TrimSpace(line), " ")
+	arg = strings.TrimSpace(arg)
+
+	m.mtx.Lock()
+	m.message = ""
+	switch cmd {
+	case "":
+	case "q", "quit":
+		m.mtx.Unlock()
+		return true
+	case "s", "sort":
+		if slices.Contains(topSortFields, arg) {
+			m.sortField = arg
+		} else {
+			m.message = "unknown sort field: " + arg
+		}
+	case "f", "filter":
+		m.filter = arg
+	case "d", "detail":
+		handle, err := strconv.ParseUint(strings.TrimPrefix(arg, "#"), 10, 32)
+		if err != nil || m.directives[uint32(handle)] == nil {
+			m.message = "unknown directive: " + arg
+		} else {
+			m.detail, m.detailInfo = uint32(handle), nil
+		}
+	case "b", "back":
+		m.detail, m.detailInfo = 0, nil
+	default:
+		m.message = "unknown command: " + cmd
+	}
+	m.mtx.Unlock()
+
+	select {
+	case m.wakeCh <- struct{}{}:
+	default:
+	}
+	return false
+}
+
+// render renders the dashboard.
+func (m *topModel) render() []byte {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	var dat bytes.Buffer
+	if m.detail != 0 {
+		m.renderDetail(&dat)
+	} else {
+		m.renderTables(&dat)
+	}
+	if m.message != "" {
+		_, _ = dat.WriteString(m.message)
+		_, _ = dat.WriteString("\n")
+	}
+	return dat.Bytes()
+}
+
+// renderTables renders the controller and directive tables.
+func (m *topModel) renderTables(dat *bytes.Buffer) {
+	type ctrlRow struct {
+		key, status, id, version, errInfo string
+	}
+	var ctrls []ctrlRow
+	// managed contains the ids of the controllers managed by the configset
+	managed := make(map[string]int)
+	for _, st := range m.configStates {
+		info := st.GetControllerInfo()
+		managed[info.GetId()]++
+		ctrls = append(ctrls, ctrlRow{
+			key:     st.GetId(),
+			status:  strings.TrimPrefix(st.GetStatus().String(), "ControllerStatus_"),
+			id:      info.GetId(),
+			version: info.GetVersion(),
+			errInfo: st.GetErrorInfo(),
+		})
+	}
+	for _, ctrl := range m.controllers {
+		info := ctrl.GetInfo()
+		if managed[info.GetId()] != 0 {
+			managed[info.GetId()]--
+			continue
+		}
+		ctrls = append(ctrls, ctrlRow{
+			key:     "-",
+			status:  "RUNNING",
+			id:      info.GetId(),
+			version: info.GetVersion(),
+		})
+	}
+	ctrls = slices.DeleteFunc(ctrls, func(r ctrlRow) bool {
+		return !m.matchesFilter(r.key, r.id)
+	})
+	slices.SortFunc(ctrls, func(a, b ctrlRow) int {
+		return cmp.Or(strings.Compare(a.key, b.key), strings.Compare(a.id, b.id))
+	})
+
+	dirs := make([]*topDirective, 0, len(m.directives))
+	for _, dir := range m.directives {
+		if m.matchesFilter(dir.ident) {
+			dirs = append(dirs, dir)
+		}
+	}
+	slices.SortFunc(dirs, m.compareDirectives)
+
+	fmt.Fprintf(
+		dat,
+		"controllerbus top: %d controllers, %d directives, sort: %s, filter: %q\n\n",
+		len(m.controllers),
+		len(m.directives),
+		m.sortField,
+		m.filter,
+	)
+
+	tw := tabwriter.NewWriter(dat, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "KEY\tSTATUS\tCONTROLLER\tVERSION\tERROR")
+	for _, r := range ctrls {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", r.key, r.status, r.id, r.version, r.errInfo)
+	}
+	_ = tw.Flush()
+	_, _ = dat.WriteString("\n")
+
+	tw = tabwriter.NewWriter(dat, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "#\tDIRECTIVE\tSTATE\tVALUES\tERRORS")
+	for _, dir := range dirs {
+		state := "resolving"
+		if dir.idle {
+			state = "idle"
+		}
+		_, _ = fmt.Fprintf(
+			tw,
+			"%d\t%s\t%s\t%d\t%s\n",
+			dir.handle,
+			dir.ident,
+			state,
+			dir.valueCount,
+			strings.Join(dir.errs, "; "),
+		)
+	}
+	_ = tw.Flush()
+}
+
+// renderDetail renders the details of the selected directive.
+func (m *topModel) renderDetail(dat *bytes.Buffer) {
+	dir := m.directives[m.detail]
+	if dir == nil {
+		_, _ = dat.WriteString("directive was removed\n")
+		return
+	}
+	fmt.Fprintf(dat, "directive #%d %s", dir.handle, dir.ident)
+
+	info := m.detailInfo
+	if info == nil {
+		_, _ = dat.WriteString("\nloading...\n")
+		return
+	}
+	if !info.GetFound() {
+		_, _ = dat.WriteString("\ndirective not found\n")
+		return
+	}
+	writeDebugVals(dat, info.GetDirectiveState().GetInfo().GetDebugVals())
+	if info.GetIdle() {
+		_, _ = dat.WriteString("\n\nstate: idle\n")
+	} else {
+		_, _ = dat.WriteString("\n\nstate: resolving\n")
+	}
+	for _, errStr := range info.GetResolverErrors() {
+		_, _ = dat.WriteString("error: ")
+		_, _ = dat.WriteString(errStr)
+		_, _ = dat.WriteString("\n")
+	}
+
+	fmt.Fprintf(dat, "values: %d\n", len(info.GetValues()))
+	for _, val := range info.GetValues() {
+		fmt.Fprintf(dat, "  [%d] %s", val.GetValueId(), val.GetValueType())
+		var buf bytes.Buffer
+		writeDebugVals(&buf, val.GetDebugVals())
+		_, _ = dat.WriteString(strings.ReplaceAll(buf.String(), "\n\t", "\n\t  "))
+		_, _ = dat.WriteString("\n")
+	}
+}
+
+// matchesFilter checks if any of the fields contain the filter.
+func (m *topModel) matchesFilter(fields ...string) bool {
+	if m.filter == "" {
+		return true
+	}
+	for _, field := range fields {
+		if strings.Contains(field, m.filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareDirectives compares two directives by the sort field.
+func (m *topModel) compareDirectives(a, b *topDirective) int {
+	var res int
+	switch m.sortField {
+	case "values":
+		res = cmp.Compare(b.valueCount, a.valueCount)
+	case "state":
+		// resolving directives first
+		if a.idle != b.idle {
+			if a.idle {
+				res = 1
+			} else {
+				res = -1
+			}
+		}
+	case "errors":
+		res = cmp.Compare(len(b.errs), len(a.errs))
+	}
+	return cmp.Or(res, strings.Compare(a.ident, b.ident), cmp.Compare(a.handle, b.handle))
+}
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	"github.com/aperturerobotics/controllerbus/controller"
+	controller_exec "github.com/aperturerobotics/controllerbus/controller/exec"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// newTestTopModel builds a topModel with a few controllers and directives.
+func newTestTopModel() *topModel {
+	m := newTopModel("name", "")
+	m.applyEvent(&bus_api.WatchBusInfoResponse{
+		EventType: bus_api.WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT,
+		Controllers: []*bus_api.WatchBusInfoController{
+			{Handle: 1, Info: &controller.Info{Id: "test/managed", Version: "0.0.1"}},
+			{Handle: 2, Info: &controller.Info{Id: "test/other", Version: "0.0.2"}},
+		},
+		// the snapshot contains the state of the directives
+		Directives: []*bus_api.WatchBusInfoDirective{
+			{Handle: 3, State: &directive.DirectiveState{Ident: "Alpha", InstanceId: 10}, Idle: true, ValueCount: 1},
+			{Handle: 4, State: &directive.DirectiveState{Ident: "Beta", InstanceId: 11}},
+			{Handle: 5, State: &directive.DirectiveState{Ident: "Gamma", InstanceId: 12}},
+		},
+	})
+	for _, dir := range []*bus_api.WatchBusInfoDirective{
+		{Handle: 4, Idle: false, ValueCount: 3, ResolverErrors: []string{"err1", "err2"}},
+		{Handle: 5, Idle: true, ValueCount: 2, ResolverErrors: []string{"err3"}},
+	} {
+		m.applyEvent(&bus_api.WatchBusInfoResponse{
+			EventType:  bus_api.WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_STATE,
+			Directives: []*bus_api.WatchBusInfoDirective{dir},
+		})
+	}
+	m.configStates = []*controller_exec.ExecControllerResponse{{
+		Id:             "managed",
+		Status:         controller_exec.ControllerStatus_ControllerStatus_RUNNING,
+		ControllerInfo: &controller.Info{Id: "test/managed", Version: "0.0.1"},
+	}}
+	return m
+}
+
+// renderedDirectives returns the directive idents in the rendered order.
+func renderedDirectives(t *testing.T, m *topModel) []string {
+	out := string(m.render())
+	_, table, ok := strings.Cut(out, "#  ")
+	if !ok {
+		t.Fatalf("directive table not found in output:\n%s", out)
+	}
+	var idents []string
+	for _, line := range strings.Split(table, "\n")[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		idents = append(idents, fields[1])
+	}
+	return idents
+}
+
+// TestTopModelSort tests sorting the directives by each sort field.
+func TestTopModelSort(t *testing.T) {
+	m := newTestTopModel()
+	expected := map[string][]string{
+		"name":   {"Alpha", "Beta", "Gamma"},
+		"values": {"Beta", "Gamma", "Alpha"},
+		"state":  {"Beta", "Alpha", "Gamma"},
+		"errors": {"Beta", "Gamma", "Alpha"},
+	}
+	for _, field := range topSortFields {
+		if m.handleCommand("s " + field) {
+			t.Fatal("expected sort not to exit")
+		}
+		if idents := renderedDirectives(t, m); !slices.Equal(idents, expected[field]) {
+			t.Fatalf("sort %s: expected %v but got %v", field, expected[field], idents)
+		}
+	}
+
+	m.handleCommand("s unknown")
+	if out := string(m.render()); !strings.Contains(out, "unknown sort field: unknown") || m.sortField != "errors" {
+		t.Fatalf("expected unknown sort field message:\n%s", out)
+	}
+}
+
+// TestTopModelFilter tests filtering the controllers and directives.
+func TestTopModelFilter(t *testing.T) {
+	m := newTestTopModel()
+	m.handleCommand("f eta")
+	out := string(m.render())
+	if idents := renderedDirectives(t, m); !slices.Equal(idents, []string{"Beta"}) {
+		t.Fatalf("expected only Beta but got %v", idents)
+	}
+	if strings.Contains(out, "test/managed") || strings.Contains(out, "test/other") {
+		t.Fatalf("expected controllers to be filtered:\n%s", out)
+	}
+
+	// the managed controller is listed once with its configset key
+	m.handleCommand("f test/")
+	out = string(m.render())
+	if strings.Count(out, "test/managed") != 1 || !strings.Contains(out, "managed  RUNNING") {
+		t.Fatalf("expected managed controller once with its key:\n%s", out)
+	}
+	if !strings.Contains(out, "test/other") || len(renderedDirectives(t, m)) != 0 {
+		t.Fatalf("expected other controller and no directives:\n%s", out)
+	}
+
+	m.handleCommand("f")
+	if idents := renderedDirectives(t, m); len(idents) != 3 {
+		t.Fatalf("expected all directives but got %v", idents)
+	}
+}
+
+// TestTopModelDetail tests rendering the details of a directive.
+func TestTopModelDetail(t *testing.T) {
+	m := newTestTopModel()
+	m.handleCommand("d #99")
+	if out := string(m.render()); !strings.Contains(out, "unknown directive: #99") {
+		t.Fatalf("expected unknown directive message:\n%s", out)
+	}
+
+	m.handleCommand("d #4")
+	if out := string(m.render()); !strings.Contains(out, "directive #4 Beta") || !strings.Contains(out, "loading...") {
+		t.Fatalf("expected loading detail:\n%s", out)
+	}
+	m.detailInfo = &bus_api.GetDirectiveInfoResponse{
+		Found:          true,
+		DirectiveState: &directive.DirectiveState{Ident: "Beta", InstanceId: 11},
+		ResolverErrors: []string{"err1"},
+		Values:         []*directive.ValueInfo{{ValueId: 7, ValueType: "string"}},
+	}
+	out := string(m.render())
+	for _, expected := range []string{"state: resolving", "error: err1", "values: 1", "[7] string"} {
+		if !strings.Contains(out, expected) {
+			t.Fatalf("expected %q in detail:\n%s", expected, out)
+		}
+	}
+
+	// the detail is cleared when the directive is removed
+	m.applyEvent(&bus_api.WatchBusInfoResponse{
+		EventType:  bus_api.WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_REMOVED,
+		Directives: []*bus_api.WatchBusInfoDirective{{Handle: 4}},
+	})
+	if out := string(m.render()); !strings.Contains(out, "directive was removed") {
+		t.Fatalf("expected removed message:\n%s", out)
+	}
+
+	m.handleCommand("b")
+	if idents := renderedDirectives(t, m); !slices.Equal(idents, []string{"Alpha", "Gamma"}) {
+		t.Fatalf("expected remaining directives but got %v", idents)
+	}
+	if !m.handleCommand("q") {
+		t.Fatal("expected quit to exit")
+	}
+}
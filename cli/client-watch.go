@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aperturerobotics/cli"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// RunWatch runs the watch bus info command.
+func (a *ClientArgs) RunWatch(_ *cli.Context) error {
+	ctx := a.GetContext()
+	c, err := a.BuildClient()
+	if err != nil {
+		return err
+	}
+
+	strm, err := c.WatchBusInfo(ctx, &bus_api.WatchBusInfoRequest{})
+	if err != nil {
+		return err
+	}
+	defer strm.Close()
+
+	// names contains the directive names by handle
+	names := make(map[uint32]string)
+	for {
+		ev, err := strm.Recv()
+		if err != nil {
+			if err == io.EOF || err == context.Canceled {
+				err = nil
+			}
+			return err
+		}
+
+		if a.Interactive {
+			_, _ = os.Stdout.Write(printWatchEvent(ev, names))
+			continue
+		}
+		data, err := ev.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		os.Stdout.Write(data)
+		os.Stdout.WriteString("\n")
+	}
+}
+
+// writeWatchDirectiveState writes the idle state, value count and errors of a
+// directive.
+func writeWatchDirectiveState(dat *bytes.Buffer, dir *bus_api.WatchBusInfoDirective) {
+	if dir.GetIdle() {
+		_, _ = dat.WriteString(": idle")
+	} else {
+		_, _ = dat.WriteString(": resolving")
+	}
+	_, _ = dat.WriteString(", values = ")
+	_, _ = dat.WriteString(strconv.FormatUint(uint64(dir.GetValueCount()), 10))
+	for _, errStr := range dir.GetResolverErrors() {
+		_, _ = dat.WriteString("\n\terror: ")
+		_, _ = dat.WriteString(errStr)
+	}
+}
+
+// printWatchEvent pretty-prints a WatchBusInfo event.
+//
+// names tracks the directive names by handle for state events.
+func printWatchEvent(ev *bus_api.WatchBusInfoResponse, names map[uint32]string) []byte {
+	var dat bytes.Buffer
+	prefix := "+ "
+	switch ev.GetEventType() {
+	case bus_api.WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT:
+		_, _ = dat.WriteString("✓ controller-bus running\n")
+	case bus_api.WatchBusInfoEventType_WatchBusInfoEventType_CONTROLLER_REMOVED,
+		bus_api.WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_REMOVED:
+		prefix = "- "
+	case bus_api.WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_STATE:
+		prefix = "~ "
+	}
+
+	for _, ctrl := range ev.GetControllers() {
+		info := ctrl.GetInfo()
+		_, _ = dat.WriteString(prefix)
+		_, _ = dat.WriteString("controller ")
+		_, _ = dat.WriteString(info.GetId())
+		_, _ = dat.WriteString(" ")
+		_, _ = dat.WriteString(info.GetVersion())
+		_, _ = dat.WriteString("\n")
+	}
+
+	for _, dir := range ev.GetDirectives() {
+		handle := dir.GetHandle()
+		if name := dir.GetState().GetInfo().GetName(); name != "" {
+			names[handle] = name
+		}
+		_, _ = dat.WriteString(prefix)
+		_, _ = dat.WriteString("directive #")
+		_, _ = dat.WriteString(strconv.FormatUint(uint64(handle), 10))
+		_, _ = dat.WriteString(" ")
+		_, _ = dat.WriteString(names[handle])
+
+		switch ev.GetEventType() {
+		case bus_api.WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_STATE:
+			writeWatchDirectiveState(&dat, dir)
+		case bus_api.WatchBusInfoEventType_WatchBusInfoEventType_SNAPSHOT:
+			writeWatchDirectiveState(&dat, dir)
+			writeDebugVals(&dat, dir.GetState().GetInfo().GetDebugVals())
+		case bus_api.WatchBusInfoEventType_WatchBusInfoEventType_DIRECTIVE_REMOVED:
+			delete(names, handle)
+		default:
+			writeDebugVals(&dat, dir.GetState().GetInfo().GetDebugVals())
+		}
+		_, _ = dat.WriteString("\n")
+	}
+	return dat.Bytes()
+}
+
+// writeDebugVals writes the directive debug values.
+func writeDebugVals(dat *bytes.Buffer, debugVals []*directive.ProtoDebugValue) {
+	for _, val := range debugVals {
+		_, _ = dat.WriteString("\n\t")
+		_, _ = dat.WriteString(val.GetKey())
+		if nvals := val.GetValues(); len(nvals) != 0 {
+			_, _ = dat.WriteString(" = ")
+			_, _ = dat.WriteString(strings.Join(nvals, ", "))
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net"
+	"time"
 
 	"github.com/aperturerobotics/cli"
 	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
@@ -27,6 +28,34 @@ type ClientArgs struct {
 
 	// ExecConfigSetPath is the path to the exec controller request to execute.
 	ExecConfigSetPath string
+
+	// PlanConfigSetPath is the path to the configset to plan.
+	PlanConfigSetPath string
+	// PlanReplace plans replacing the applied configs with the configset.
+	PlanReplace bool
+
+	// StoreKey is the key of the stored configset.
+	StoreKey string
+	// StoreConfigSetPath is the path to the configset to store.
+	StoreConfigSetPath string
+	// StoreSource describes who or what stored the revision.
+	StoreSource string
+	// StoreRev is the revision to roll back to.
+	StoreRev uint64
+
+	// ControlConfigKey is the configset key for stop, restart, and remove.
+	ControlConfigKey string
+	// ControlControllerID is the controller id for stop, restart, and remove.
+	ControlControllerID string
+
+	// TopSort is the field to sort the top directives by.
+	TopSort string
+	// TopFilter filters the top controllers and directives.
+	TopFilter string
+	// TopRefresh is the top refresh interval.
+	TopRefresh time.Duration
+	// TopOnce prints the top output once and exits.
+	TopOnce bool
 }
 
 // BuildFlags attaches the flags to a flag set.
@@ -58,6 +87,77 @@ func (a *ClientArgs) BuildCommands() []*cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "health",
+			Usage:  "returns the bus liveness and readiness, exits with an error if not ready",
+			Action: a.RunHealth,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:        "interactive",
+					Usage:       "print interactive (pretty print) output",
+					Destination: &a.Interactive,
+					Value:       true,
+					EnvVars:     []string{"CONTROLLER_BUS_INTERACTIVE"},
+				},
+			},
+		},
+		{
+			Name:   "factories",
+			Usage:  "lists the available controller factories and config schemas",
+			Action: a.RunFactories,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:        "interactive",
+					Usage:       "print interactive (pretty print) output",
+					Destination: &a.Interactive,
+					Value:       true,
+					EnvVars:     []string{"CONTROLLER_BUS_INTERACTIVE"},
+				},
+			},
+		},
+		{
+			Name:   "watch",
+			Usage:  "streams bus controller and directive events",
+			Action: a.RunWatch,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:        "interactive",
+					Usage:       "print interactive (pretty print) output",
+					Destination: &a.Interactive,
+					Value:       true,
+					EnvVars:     []string{"CONTROLLER_BUS_INTERACTIVE"},
+				},
+			},
+		},
+		{
+			Name:   "top",
+			Usage:  "live dashboard of bus controllers and directives",
+			Action: a.RunTop,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "sort",
+					Usage:       "sort directives by: name, values, state, errors",
+					Destination: &a.TopSort,
+					Value:       "name",
+				},
+				&cli.StringFlag{
+					Name:        "filter",
+					Usage:       "only show controllers and directives containing the text",
+					Destination: &a.TopFilter,
+				},
+				&cli.DurationFlag{
+					Name:        "refresh",
+					Usage:       "refresh interval",
+					Destination: &a.TopRefresh,
+					Value:       time.Second,
+				},
+				&cli.BoolFlag{
+					Name:        "once",
+					Usage:       "print once and exit",
+					Destination: &a.TopOnce,
+				},
+			},
+		},
 		{
 			Name:   "exec",
 			Usage:  "execute a controller configset",
@@ -72,6 +172,60 @@ func (a *ClientArgs) BuildCommands() []*cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "plan",
+			Usage:  "show the changes applying a controller configset would make without applying it",
+			Action: a.RunPlan,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "config-set-file",
+					Aliases:     []string{"f"},
+					Usage:       "path to config set json or yaml file",
+					EnvVars:     []string{"CONTROLLER_BUS_PLAN_CONFIG_SET_FILE"},
+					Destination: &a.PlanConfigSetPath,
+				},
+				&cli.BoolFlag{
+					Name:        "replace",
+					Usage:       "plan replacing the applied configs: applied keys missing from the configset are stopped",
+					EnvVars:     []string{"CONTROLLER_BUS_PLAN_REPLACE"},
+					Destination: &a.PlanReplace,
+				},
+				&cli.BoolFlag{
+					Name:        "interactive",
+					Usage:       "print interactive (pretty print) output",
+					Destination: &a.Interactive,
+					Value:       true,
+					EnvVars:     []string{"CONTROLLER_BUS_INTERACTIVE"},
+				},
+			},
+		},
+		a.buildStoreCommand(),
+		a.buildControlCommand("stop", "stop a configset controller and release the configset references", a.RunStopController),
+		a.buildControlCommand("restart", "restart a configset controller with the current config, clearing any quarantine", a.RunRestartController),
+		a.buildControlCommand("remove", "stop a configset controller and release all references including persistent ones", a.RunRemoveController),
+	}
+}
+
+// buildControlCommand builds a command that controls a configset controller.
+func (a *ClientArgs) buildControlCommand(name, usage string, action cli.ActionFunc) *cli.Command {
+	return &cli.Command{
+		Name:      name,
+		Usage:     usage,
+		ArgsUsage: "[config-key]",
+		Action:    action,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "key",
+				Aliases:     []string{"k"},
+				Usage:       "configset key of the controller",
+				Destination: &a.ControlConfigKey,
+			},
+			&cli.StringFlag{
+				Name:        "controller-id",
+				Usage:       "controller id of the running controller",
+				Destination: &a.ControlControllerID,
+			},
+		},
 	}
 }
 
@@ -1,26 +1,63 @@
 package cli
 
 import (
+	"time"
+
 	"github.com/aperturerobotics/cli"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	configset_json "github.com/aperturerobotics/controllerbus/controller/configset/json"
 )
 
 // DaemonArgs contains common flags for controller-bus daemons.
 type DaemonArgs struct {
-	WriteConfig bool
-	ConfigPath  string
-	APIListen   string
+	WriteConfig  bool
+	WatchConfig  bool
+	ExpandVars   bool
+	ConfigPaths  cli.StringSlice
+	APIListen    string
+	HealthListen string
+	ProfListen   string
+
+	ProfBlockRate     int
+	ProfMutexFraction int
+
+	ConfigSetStore string
+
+	ShutdownTimeout time.Duration
+	ApplyTimeout    time.Duration
+
+	QuarantineExits  uint
+	QuarantineWindow time.Duration
+}
+
+// BuildConfigSetConfig builds the configset controller config from the flags.
+func (a *DaemonArgs) BuildConfigSetConfig() *configset_controller.Config {
+	conf := &configset_controller.Config{QuarantineExits: uint32(a.QuarantineExits)} //nolint:gosec
+	if a.QuarantineWindow != 0 {
+		conf.QuarantineWindowDur = a.QuarantineWindow.String()
+	}
+	return conf
+}
+
+// BuildExpandOpts builds the options for expanding variable references in
+// the config files, nil if disabled.
+func (a *DaemonArgs) BuildExpandOpts() *configset_json.ExpandOpts {
+	if !a.ExpandVars {
+		return nil
+	}
+	return &configset_json.ExpandOpts{}
 }
 
 // BuildFlags attaches the flags to a flag set.
 func (a *DaemonArgs) BuildFlags() []cli.Flag {
 	return []cli.Flag{
-		&cli.StringFlag{
+		&cli.StringSliceFlag{
 			Name:        "config",
 			Aliases:     []string{"c"},
-			Usage:       "path to configuration yaml file",
+			Usage:       "path to configuration yaml file or directory, can be repeated, later files override earlier ones",
 			EnvVars:     []string{"CONTROLLER_BUS_CONFIG"},
-			Value:       "controllerbus_daemon.yaml",
-			Destination: &a.ConfigPath,
+			Value:       cli.NewStringSlice("controllerbus_daemon.yaml"),
+			Destination: &a.ConfigPaths,
 		},
 		&cli.BoolFlag{
 			Name:        "write-config",
@@ -28,6 +65,18 @@ func (a *DaemonArgs) BuildFlags() []cli.Flag {
 			EnvVars:     []string{"CONTROLLER_BUS_WRITE_CONFIG"},
 			Destination: &a.WriteConfig,
 		},
+		&cli.BoolFlag{
+			Name:        "watch-config",
+			Usage:       "reload the daemon config file when it changes",
+			EnvVars:     []string{"CONTROLLER_BUS_WATCH_CONFIG"},
+			Destination: &a.WatchConfig,
+		},
+		&cli.BoolFlag{
+			Name:        "expand-vars",
+			Usage:       "expand ${VAR}, ${VAR:-default} and ${file:path} references and top-level vars in the config files",
+			EnvVars:     []string{"CONTROLLER_BUS_EXPAND_VARS"},
+			Destination: &a.ExpandVars,
+		},
 		&cli.StringFlag{
 			Name:        "api-listen",
 			Usage:       "if set, will listen on address for API connections, ex :5110",
@@ -35,5 +84,62 @@ func (a *DaemonArgs) BuildFlags() []cli.Flag {
 			Value:       ":5110",
 			Destination: &a.APIListen,
 		},
+		&cli.StringFlag{
+			Name:        "health-listen",
+			Usage:       "if set, will listen on address for http /healthz and /readyz probes, ex :5111",
+			EnvVars:     []string{"CONTROLLER_BUS_HEALTH_LISTEN"},
+			Destination: &a.HealthListen,
+		},
+		&cli.StringFlag{
+			Name:        "prof-listen",
+			Usage:       "if set, will listen on address for pprof and bus debug handlers, ex :6060",
+			EnvVars:     []string{"CONTROLLER_BUS_PROF_LISTEN"},
+			Destination: &a.ProfListen,
+		},
+		&cli.IntFlag{
+			Name:        "prof-block-rate",
+			Usage:       "if set, block profile rate to set while listening on prof-listen, reset to 0 on exit",
+			EnvVars:     []string{"CONTROLLER_BUS_PROF_BLOCK_RATE"},
+			Destination: &a.ProfBlockRate,
+		},
+		&cli.IntFlag{
+			Name:        "prof-mutex-fraction",
+			Usage:       "if set, mutex profile fraction to set while listening on prof-listen",
+			EnvVars:     []string{"CONTROLLER_BUS_PROF_MUTEX_FRACTION"},
+			Destination: &a.ProfMutexFraction,
+		},
+		&cli.StringFlag{
+			Name:        "configset-store",
+			Usage:       "if set, stores configsets put with the api in this directory and applies them on startup",
+			EnvVars:     []string{"CONTROLLER_BUS_CONFIGSET_STORE"},
+			Destination: &a.ConfigSetStore,
+		},
+		&cli.DurationFlag{
+			Name:        "shutdown-timeout",
+			Usage:       "time to wait for controllers to stop on shutdown",
+			EnvVars:     []string{"CONTROLLER_BUS_SHUTDOWN_TIMEOUT"},
+			Value:       10 * time.Second,
+			Destination: &a.ShutdownTimeout,
+		},
+		&cli.DurationFlag{
+			Name:        "apply-timeout",
+			Usage:       "if set, apply config reloads transactionally: roll back if any changed controller does not start within the timeout",
+			EnvVars:     []string{"CONTROLLER_BUS_APPLY_TIMEOUT"},
+			Destination: &a.ApplyTimeout,
+		},
+		&cli.UintFlag{
+			Name:        "quarantine-exits",
+			Usage:       "quarantine a configset controller after it exits with an error this many times within the quarantine window, 0 to disable",
+			EnvVars:     []string{"CONTROLLER_BUS_QUARANTINE_EXITS"},
+			Value:       10,
+			Destination: &a.QuarantineExits,
+		},
+		&cli.DurationFlag{
+			Name:        "quarantine-window",
+			Usage:       "window to count configset controller exits within, 0 to count all exits",
+			EnvVars:     []string{"CONTROLLER_BUS_QUARANTINE_WINDOW"},
+			Value:       time.Minute,
+			Destination: &a.QuarantineWindow,
+		},
 	}
 }
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/aperturerobotics/cli"
+	configset_json "github.com/aperturerobotics/controllerbus/controller/configset/json"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// validateArgs are the config validate arguments.
+var validateArgs struct {
+	// ConfigPaths are the paths to the configset files and dirs.
+	ConfigPaths cli.StringSlice
+	// PluginDir is the path to the plugin dir to load factories from.
+	// The plugins are loaded and executed to decode their configs.
+	PluginDir string
+	// ExpandVars expands the variable references in the files.
+	ExpandVars bool
+}
+
+func init() {
+	commands = append(
+		commands,
+		&cli.Command{
+			Name:  "config",
+			Usage: "configset sub-commands",
+			Subcommands: []*cli.Command{{
+				Name:   "validate",
+				Usage:  "validate configset yaml files without starting any controllers",
+				Action: runConfigValidate,
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:        "file",
+						Aliases:     []string{"f"},
+						Usage:       "path to configset yaml file or directory, can be repeated",
+						EnvVars:     []string{"CONTROLLER_BUS_CONFIG"},
+						Value:       cli.NewStringSlice("controllerbus_daemon.yaml"),
+						Destination: &validateArgs.ConfigPaths,
+					},
+					&cli.StringFlag{
+						Name:        "plugin-dir",
+						Usage:       "path to dir to load plugin factories from: runs the plugin code, opening shared-library plugins and starting subprocess plugins",
+						Destination: &validateArgs.PluginDir,
+					},
+					&cli.BoolFlag{
+						Name:        "expand-vars",
+						Usage:       "expand ${VAR}, ${VAR:-default} and ${file:path} references and top-level vars in the files",
+						EnvVars:     []string{"CONTROLLER_BUS_EXPAND_VARS"},
+						Destination: &validateArgs.ExpandVars,
+					},
+				},
+			}},
+		},
+	)
+}
+
+// runConfigValidate validates the configset yaml files.
+func runConfigValidate(c *cli.Context) error {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	log := logrus.New()
+	log.SetLevel(logrus.WarnLevel)
+	le := logrus.NewEntry(log)
+
+	files, err := configset_json.ListConfigFiles(validateArgs.ConfigPaths.Value()...)
+	if err != nil {
+		return errors.Wrap(err, "load config")
+	}
+
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		return err
+	}
+	addBuiltInFactories(b, sr)
+	if validateArgs.PluginDir != "" {
+		relPlugins, err := loadPluginDir(ctx, le, b, sr, validateArgs.PluginDir)
+		if err != nil {
+			return err
+		}
+		defer relPlugins()
+	}
+
+	var expand *configset_json.ExpandOpts
+	if validateArgs.ExpandVars {
+		expand = &configset_json.ExpandOpts{}
+	}
+	csFiles, confErrs, err := configset_json.ValidateConfigSetFiles(ctx, b, expand, files...)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal config yaml")
+	}
+	for _, confErr := range confErrs {
+		os.Stderr.WriteString(confErr.Error())
+		os.Stderr.WriteString("\n")
+	}
+	if len(confErrs) != 0 {
+		return errors.Errorf("%d invalid controller config(s)", len(confErrs))
+	}
+	merged, conflicts := configset_json.MergeConfigSetFiles(csFiles...)
+	for _, conflict := range conflicts {
+		os.Stdout.WriteString(conflict.String())
+		os.Stdout.WriteString("\n")
+	}
+	if err := merged.CheckDependencies(); err != nil {
+		return err
+	}
+	for _, file := range files {
+		os.Stdout.WriteString(file)
+		os.Stdout.WriteString(": ok\n")
+	}
+	return nil
+}
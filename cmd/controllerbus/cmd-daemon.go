@@ -3,18 +3,26 @@ package main
 import (
 	"context"
 	"os"
+	"os/signal"
+	"strings"
 
 	"github.com/aperturerobotics/cli"
+	"github.com/aperturerobotics/controllerbus/bus"
 	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
 	api_controller "github.com/aperturerobotics/controllerbus/bus/api/controller"
+	bus_debug_controller "github.com/aperturerobotics/controllerbus/bus/debug/controller"
 	cbcli "github.com/aperturerobotics/controllerbus/cli"
 	"github.com/aperturerobotics/controllerbus/controller/configset"
-	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
 	configset_json "github.com/aperturerobotics/controllerbus/controller/configset/json"
+	configset_store "github.com/aperturerobotics/controllerbus/controller/configset/store"
+	configset_store_controller "github.com/aperturerobotics/controllerbus/controller/configset/store/controller"
 	"github.com/aperturerobotics/controllerbus/controller/loader"
 	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/controller/resolver/static"
 	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
 	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -28,7 +36,7 @@ func init() {
 	dflags = append(dflags, (&daemonFlags).BuildFlags()...)
 	dflags = append(dflags, &cli.StringFlag{
 		Name:        "hot-load-dir",
-		Usage:       "path to dir to hot-load shared-object plugins",
+		Usage:       "path to dir to hot-load shared-object (.so) and sub-process (.cbus) plugins",
 		Value:       pluginDir,
 		Destination: &pluginDir,
 	})
@@ -43,35 +51,51 @@ func init() {
 	)
 }
 
+// addBuiltInFactories adds the factories compiled into the binary.
+func addBuiltInFactories(b bus.Bus, sr *static.Resolver) {
+	sr.AddFactory(api_controller.NewFactory(b, boilerplate_v1.NetworkedType))
+	sr.AddFactory(bus_debug_controller.NewFactory(b))
+	sr.AddFactory(configset_store_controller.NewFactory(b))
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+}
+
 // runDaemon runs the daemon.
+//
+// Runs until a shutdown signal is received, then releases the configset
+// controllers and returns an error if any failed to stop in time.
 func runDaemon(c *cli.Context) error {
-	ctx := context.Background()
+	// busCtx is canceled after the daemon has shut down
+	busCtx, busCtxCancel := context.WithCancel(context.Background())
+	defer busCtxCancel()
+	// ctx is canceled when a shutdown signal is received
+	ctx, ctxCancel := signal.NotifyContext(busCtx, shutdownSignals...)
+	defer ctxCancel()
+
 	log := logrus.New()
 	log.SetLevel(logrus.DebugLevel)
 	le := logrus.NewEntry(log)
 
 	// TODO: add hot loading controller factories here.
-	b, sr, err := core.NewCoreBus(ctx, le)
+	b, sr, err := core.NewCoreBus(busCtx, le)
 	if err != nil {
 		return err
 	}
-	sr.AddFactory(api_controller.NewFactory(b))
-	sr.AddFactory(boilerplate_controller.NewFactory(b))
+	addBuiltInFactories(b, sr)
 
 	// Construct hot loader
 	if pluginDir != "" {
-		hlRef, err := addHotLoader(b, sr)
+		relHotLoader, err := addHotLoader(b, sr)
 		if err != nil {
 			return err
 		}
-		if hlRef != nil {
-			defer hlRef.Release()
+		if relHotLoader != nil {
+			defer relHotLoader()
 		}
 	}
 
 	// ConfigSet controller
 	_, csRef, err := b.AddDirective(
-		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		resolver.NewLoadControllerWithConfig(daemonFlags.BuildConfigSetConfig()),
 		nil,
 	)
 	if err != nil {
@@ -79,64 +103,98 @@ func runDaemon(c *cli.Context) error {
 	}
 	defer csRef.Release()
 
-	// Construct config set.
-	confSet := configset.ConfigSet{}
-
-	// Load config file
-	configLe := le.WithField("config", daemonFlags.ConfigPath)
-	if confPath := daemonFlags.ConfigPath; confPath != "" {
-		confDat, err := os.ReadFile(confPath)
+	// ConfigSet store controller
+	var storeCtrl configset_store.Controller
+	if storeDir := daemonFlags.ConfigSetStore; storeDir != "" {
+		var storeRef directive.Reference
+		storeCtrl, _, storeRef, err = loader.WaitExecControllerReadyTyped[configset_store.Controller](
+			ctx,
+			b,
+			resolver.NewLoadControllerWithConfig(&configset_store_controller.Config{StoreDir: storeDir}),
+			nil,
+		)
 		if err != nil {
-			if os.IsNotExist(err) {
-				if daemonFlags.WriteConfig {
-					configLe.Info("cannot find config but write-config is set, continuing")
-				} else {
-					return errors.Wrapf(
-						err,
-						"cannot find config at %s",
-						daemonFlags.ConfigPath,
-					)
-				}
-			} else {
-				return errors.Wrap(err, "load config")
-			}
+			return errors.Wrap(err, "construct configset store controller")
 		}
+		defer storeRef.Release()
+	}
 
-		_, err = configset_json.UnmarshalYAML(ctx, b, confDat, confSet, true)
-		if err != nil {
-			return errors.Wrap(err, "unmarshal config yaml")
-		}
+	// Load config files
+	confPaths := daemonFlags.ConfigPaths.Value()
+	configLe := le.WithField("config", confPaths)
+	confSet, err := loadConfig(ctx, configLe, b, confPaths)
+	if err != nil {
+		return err
 	}
 
-	if daemonFlags.ConfigPath != "" && daemonFlags.WriteConfig {
+	if daemonFlags.WriteConfig && len(confPaths) != 0 {
+		if len(confPaths) != 1 {
+			return errors.New("write-config requires a single config file")
+		}
+		if daemonFlags.ExpandVars {
+			return errors.New("write-config cannot be used with expand-vars")
+		}
+		if st, err := os.Stat(confPaths[0]); err == nil && st.IsDir() {
+			return errors.Errorf("write-config requires a config file but %s is a directory", confPaths[0])
+		}
 		confDat, err := configset_json.MarshalYAML(confSet)
 		if err != nil {
 			return errors.Wrap(err, "marshal config")
 		}
-		err = os.WriteFile(daemonFlags.ConfigPath, confDat, 0o644)
+		err = os.WriteFile(confPaths[0], confDat, 0o644)
 		if err != nil {
 			return errors.Wrap(err, "write config file")
 		}
 	}
 
-	_, configSetRef, err := b.AddDirective(
-		configset.NewApplyConfigSet(confSet),
-		nil,
-	)
-	if err != nil {
+	applier := configset.NewApplier(b)
+	defer applier.Release()
+	if _, err := applier.Apply(confSet); err != nil {
 		return err
 	}
-	defer configSetRef.Release()
+
+	if len(reloadSignals) != 0 && len(confPaths) != 0 {
+		reloadCh := make(chan os.Signal, 1)
+		signal.Notify(reloadCh, reloadSignals...)
+		defer signal.Stop(reloadCh)
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case sig := <-reloadCh:
+					configLe.Infof("received %s, reloading config", sig)
+					reloadConfig(ctx, configLe, b, applier, confPaths)
+				}
+			}
+		}()
+	}
+
+	if daemonFlags.WatchConfig && len(confPaths) != 0 {
+		go func() {
+			err := watchConfigFiles(ctx, configLe, confPaths, func(ctx context.Context) {
+				reloadConfig(ctx, configLe, b, applier, confPaths)
+			})
+			if err != nil && err != context.Canceled {
+				configLe.WithError(err).Warn("config watcher exited")
+			}
+		}()
+	}
 
 	// Daemon API
 	if daemonFlags.APIListen != "" {
-		_, _, apiRef, err := loader.WaitExecControllerRunning(
+		_, _, apiRef, err := loader.WaitExecControllerReady(
 			ctx,
 			b,
 			resolver.NewLoadControllerWithConfig(&api_controller.Config{
-				ListenAddr: daemonFlags.APIListen,
+				ListenAddr:       daemonFlags.APIListen,
+				HealthListenAddr: daemonFlags.HealthListen,
 				BusApiConfig: &bus_api.Config{
-					EnableExecController: true,
+					EnableExecController:     true,
+					EnableExecDirective:      true,
+					EnableServeDirectives:    true,
+					EnableControlControllers: true,
+					EnableConfigsetStore:     daemonFlags.ConfigSetStore != "",
 				},
 			}),
 			nil,
@@ -147,20 +205,163 @@ func runDaemon(c *cli.Context) error {
 		defer apiRef.Release()
 	}
 
-	/* TODO profiling plugin
+	// Debug and profiling handlers
 	if daemonFlags.ProfListen != "" {
-		runtime.SetBlockProfileRate(1)
-		runtime.SetMutexProfileFraction(1)
-		go func() {
-			le.Debugf("profiling listener running: %s", daemonFlags.ProfListen)
-			err := http.ListenAndServe(daemonFlags.ProfListen, nil)
-			le.WithError(err).Warn("profiling listener exited")
-		}()
+		_, _, profRef, err := loader.WaitExecControllerReady(
+			ctx,
+			b,
+			resolver.NewLoadControllerWithConfig(&bus_debug_controller.Config{
+				ListenAddr:           daemonFlags.ProfListen,
+				BlockProfileRate:     int32(daemonFlags.ProfBlockRate),     //nolint:gosec
+				MutexProfileFraction: int32(daemonFlags.ProfMutexFraction), //nolint:gosec
+			}),
+			nil,
+		)
+		if err != nil {
+			return errors.Wrap(err, "listen on profiling addr")
+		}
+		defer profRef.Release()
 	}
-	_ = d
-	*/
 
-	// prevent deadlock here.
+	// wait for a shutdown signal
 	<-ctx.Done()
+	// restore the default signal behavior: a second signal exits immediately
+	ctxCancel()
+
+	shutdownTimeout := daemonFlags.ShutdownTimeout
+	le.Infof("shutting down with timeout %s", shutdownTimeout)
+	shutdownCtx, shutdownCtxCancel := context.WithTimeout(busCtx, shutdownTimeout)
+	defer shutdownCtxCancel()
+	var failed []string
+	if storeCtrl != nil {
+		failed = append(failed, storeCtrl.Shutdown(shutdownCtx)...)
+	}
+	failed = append(failed, applier.Shutdown(shutdownCtx)...)
+	if len(failed) != 0 {
+		return errors.Errorf(
+			"%d controller(s) failed to stop within %s: %s",
+			len(failed),
+			shutdownTimeout,
+			strings.Join(failed, ", "),
+		)
+	}
+	le.Info("shutdown complete")
 	return nil
 }
+
+// loadConfig loads and merges the config files and directories.
+//
+// Waits for the factories for the config ids to become available.
+func loadConfig(
+	ctx context.Context,
+	le *logrus.Entry,
+	b bus.Bus,
+	confPaths []string,
+) (configset.ConfigSet, error) {
+	files, err := configset_json.ListConfigFiles(confPaths...)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, errors.Wrap(err, "load config")
+		}
+		if !daemonFlags.WriteConfig {
+			return nil, errors.Wrap(err, "cannot find config")
+		}
+		le.Info("cannot find config but write-config is set, continuing")
+		return configset.ConfigSet{}, nil
+	}
+
+	sets, err := configset_json.ReadConfigSetFiles(daemonFlags.BuildExpandOpts(), files...)
+	if err != nil {
+		return nil, errors.Wrap(err, "load config")
+	}
+	csFiles := make([]*configset_json.ConfigSetFile, len(sets))
+	for i, cs := range sets {
+		resolved, err := cs.Resolve(ctx, b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarshal config %s", files[i])
+		}
+		csFiles[i] = &configset_json.ConfigSetFile{Path: files[i], ConfigSet: resolved}
+	}
+	return mergeConfigFiles(le, csFiles), nil
+}
+
+// mergeConfigFiles merges the config files and logs the overridden keys.
+func mergeConfigFiles(le *logrus.Entry, files []*configset_json.ConfigSetFile) configset.ConfigSet {
+	confSet, conflicts := configset_json.MergeConfigSetFiles(files...)
+	for _, conflict := range conflicts {
+		le.
+			WithField("config-key", conflict.Key).
+			WithField("source", conflict.Source).
+			WithField("files", conflict.Files).
+			Info("config key defined in multiple files")
+	}
+	return confSet
+}
+
+// reloadConfig reloads the config files and applies the changes.
+//
+// Keeps the previous config if a file cannot be parsed or is invalid.
+func reloadConfig(
+	ctx context.Context,
+	le *logrus.Entry,
+	b bus.Bus,
+	applier *configset.Applier,
+	confPaths []string,
+) {
+	files, err := configset_json.ListConfigFiles(confPaths...)
+	if err != nil {
+		le.WithError(err).Warn("cannot list config, keeping previous config")
+		return
+	}
+	csFiles, confErrs, err := configset_json.ValidateConfigSetFiles(ctx, b, daemonFlags.BuildExpandOpts(), files...)
+	if err != nil {
+		le.WithError(err).Warn("cannot parse config, keeping previous config")
+		return
+	}
+	if len(confErrs) != 0 {
+		for _, confErr := range confErrs {
+			le.
+				WithError(confErr.Err).
+				WithField("config-file", confErr.File).
+				WithField("config-key", confErr.Key).
+				Warn("invalid controller config")
+		}
+		le.Warn("config is invalid, keeping previous config")
+		return
+	}
+	confSet := mergeConfigFiles(le, csFiles)
+
+	var diff *configset.ApplierDiff
+	if applyTimeout := daemonFlags.ApplyTimeout; applyTimeout != 0 {
+		res, err := applier.ApplyTransactional(ctx, confSet, applyTimeout)
+		if res != nil {
+			for _, key := range res.Started {
+				le.WithField("config-key", key).Debug("controller started")
+			}
+			for key, keyErr := range res.Errors {
+				le.WithError(keyErr).WithField("config-key", key).Warn("controller did not start")
+			}
+		}
+		if err != nil {
+			le.WithError(err).Warn("unable to apply config, keeping previous config")
+			return
+		}
+		diff = res.Diff
+	} else {
+		var err error
+		diff, err = applier.Apply(confSet)
+		if err != nil {
+			le.WithError(err).Warn("unable to apply config")
+			return
+		}
+	}
+	if diff.Empty() {
+		le.Debug("config reloaded with no changes")
+		return
+	}
+	le.
+		WithField("added", diff.Added).
+		WithField("changed", diff.Changed).
+		WithField("removed", diff.Removed).
+		Info("config reloaded")
+}
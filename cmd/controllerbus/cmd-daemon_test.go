@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestReloadConfig tests that reloading an invalid config keeps the previous config.
+func TestReloadConfig(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate_controller.NewFactory(b))
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	confPaths := []string{filepath.Join(t.TempDir(), "config.yaml")}
+	writeConfig := func(data string) {
+		if err := os.WriteFile(confPaths[0], []byte(data), 0o644); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	// waitRunning waits for the example controller to run with the example field.
+	waitRunning := func(exampleField string) controller.Controller {
+		for {
+			for _, st := range csCtrl.GetControllerStates() {
+				ctrl := st.GetController()
+				if ctrl != nil && st.GetControllerConfig().GetConfig().(*boilerplate_controller.Config).GetExampleField() == exampleField {
+					return ctrl
+				}
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected example controller to run with %q", exampleField)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	// checkApplied checks the applied example field of the example key.
+	checkApplied := func(applier *configset.Applier, exampleField string) {
+		cs := applier.GetConfigSet()
+		conf := cs["example"]
+		if len(cs) != 1 || conf == nil || conf.GetConfig().(*boilerplate_controller.Config).GetExampleField() != exampleField {
+			t.Fatalf("expected example field %q but got %v", exampleField, cs)
+		}
+	}
+
+	writeConfig("example:\n  id: controllerbus/example/boilerplate\n  config:\n    exampleField: first\n")
+	confSet, err := loadConfig(ctx, le, b, confPaths)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	applier := configset.NewApplier(b)
+	defer applier.Release()
+	if _, err := applier.Apply(confSet); err != nil {
+		t.Fatal(err.Error())
+	}
+	ctrl := waitRunning("first")
+
+	// a config which cannot be parsed or is invalid keeps the previous config
+	for _, data := range []string{
+		"example: [",
+		"example:\n  id: controllerbus/example/unknown\n  config:\n    exampleField: second\n",
+		"example:\n  id: controllerbus/example/boilerplate\n  config:\n    unknownField: second\n",
+	} {
+		writeConfig(data)
+		reloadConfig(ctx, le, b, applier, confPaths)
+		checkApplied(applier, "first")
+		if running := waitRunning("first"); running != ctrl {
+			t.Fatalf("expected running controller to be kept after reloading %q", data)
+		}
+	}
+
+	// a valid config replaces the previous config
+	writeConfig("example:\n  id: controllerbus/example/boilerplate\n  config:\n    exampleField: second\n")
+	reloadConfig(ctx, le, b, applier, confPaths)
+	checkApplied(applier, "second")
+	if running := waitRunning("second"); running == ctrl {
+		t.Fatal("expected controller to be restarted with the new config")
+	}
+}
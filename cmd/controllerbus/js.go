@@ -3,13 +3,42 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"os"
+
 	"github.com/aperturerobotics/controllerbus/bus"
 	"github.com/aperturerobotics/controllerbus/controller/resolver/static"
-	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/sirupsen/logrus"
 )
 
+// shutdownSignals are the signals that shut down the daemon.
+var shutdownSignals = []os.Signal{os.Interrupt}
+
+// reloadSignals are the signals that reload the daemon config.
+// not supported on js
+var reloadSignals []os.Signal
+
 // addHotLoader adds the hot loader to the bus.
 // no-op on js
-func addHotLoader(b bus.Bus, sr *static.Resolver) (directive.Reference, error) {
+func addHotLoader(b bus.Bus, sr *static.Resolver) (func(), error) {
 	return nil, nil
 }
+
+// loadPluginDir loads the plugins in the dir.
+// not supported on js
+func loadPluginDir(
+	ctx context.Context,
+	le *logrus.Entry,
+	b bus.Bus,
+	sr *static.Resolver,
+	dir string,
+) (func(), error) {
+	return nil, errors.New("loading plugins is not supported on this platform")
+}
+
+// watchConfigFiles watches the config files and dirs and calls reload when they change.
+// not supported on js
+func watchConfigFiles(ctx context.Context, le *logrus.Entry, confPaths []string, reload func(ctx context.Context)) error {
+	return errors.New("watching the config file is not supported on this platform")
+}
@@ -3,18 +3,39 @@
 package main
 
 import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+
 	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	configset_watcher "github.com/aperturerobotics/controllerbus/controller/configset/watcher"
 	"github.com/aperturerobotics/controllerbus/controller/resolver"
 	"github.com/aperturerobotics/controllerbus/controller/resolver/static"
-	"github.com/aperturerobotics/controllerbus/directive"
+	plugin_shared_library "github.com/aperturerobotics/controllerbus/plugin/loader/shared-library"
 	hot_loader_filesystem "github.com/aperturerobotics/controllerbus/plugin/loader/shared-library/filesystem"
+	plugin_subprocess "github.com/aperturerobotics/controllerbus/plugin/loader/subprocess"
+	subprocess_loader_filesystem "github.com/aperturerobotics/controllerbus/plugin/loader/subprocess/filesystem"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
-// addHotLoader adds the hot loader to the bus.
+// shutdownSignals are the signals that shut down the daemon.
+var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// reloadSignals are the signals that reload the daemon config.
+var reloadSignals = []os.Signal{syscall.SIGHUP}
+
+// addHotLoader adds the hot loaders for the shared library and sub-process
+// plugins in the plugin dir to the bus.
 // no-op on js
-func addHotLoader(b bus.Bus, sr *static.Resolver) (directive.Reference, error) {
+//
+// Returns a function to remove the loaders.
+func addHotLoader(b bus.Bus, sr *static.Resolver) (func(), error) {
 	sr.AddFactory(hot_loader_filesystem.NewFactory(b))
+	sr.AddFactory(subprocess_loader_filesystem.NewFactory(b))
 
 	_, hlRef, err := b.AddDirective(
 		resolver.NewLoadControllerWithConfig(&hot_loader_filesystem.Config{
@@ -26,5 +47,101 @@ func addHotLoader(b bus.Bus, sr *static.Resolver) (directive.Reference, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "construct plugin loading controller")
 	}
-	return hlRef, nil
+	_, spRef, err := b.AddDirective(
+		resolver.NewLoadControllerWithConfig(&subprocess_loader_filesystem.Config{
+			Dir:   pluginDir,
+			Watch: true,
+		}),
+		nil,
+	)
+	if err != nil {
+		hlRef.Release()
+		return nil, errors.Wrap(err, "construct subprocess plugin loading controller")
+	}
+	return func() {
+		spRef.Release()
+		hlRef.Release()
+	}, nil
+}
+
+// loadPluginDir loads the plugins in the dir and waits for their resolvers to
+// be added to the bus. Adds the plugin loader factories like addHotLoader.
+//
+// Returns a function to unload the plugins.
+func loadPluginDir(
+	ctx context.Context,
+	le *logrus.Entry,
+	b bus.Bus,
+	sr *static.Resolver,
+	dir string,
+) (func(), error) {
+	sr.AddFactory(hot_loader_filesystem.NewFactory(b))
+	sr.AddFactory(subprocess_loader_filesystem.NewFactory(b))
+
+	dirContents, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var closers []func()
+	release := func() {
+		for _, closer := range closers {
+			closer()
+		}
+	}
+	resolvers := make(map[controller.FactoryResolver]struct{})
+	for _, df := range dirContents {
+		if df.IsDir() || !df.Type().IsRegular() {
+			continue
+		}
+		plugPath := path.Join(dir, df.Name())
+		switch {
+		case strings.HasSuffix(plugPath, plugin_shared_library.PluginSuffix):
+			lp, err := plugin_shared_library.LoadPluginSharedLibrary(ctx, le, b, plugPath)
+			if err != nil {
+				release()
+				return nil, errors.Wrapf(err, "load plugin %s", plugPath)
+			}
+			closers = append(closers, lp.Close)
+			resolvers[lp.PluginResolver] = struct{}{}
+		case strings.HasSuffix(plugPath, plugin_subprocess.PluginSuffix):
+			lp, err := plugin_subprocess.LoadPluginSubprocess(ctx, le, b, plugPath)
+			if err != nil {
+				release()
+				return nil, errors.Wrapf(err, "load plugin %s", plugPath)
+			}
+			closers = append(closers, lp.Close)
+			resolvers[lp.RemoteResolver] = struct{}{}
+		}
+	}
+
+	// the resolver controllers are added to the bus asynchronously
+	for {
+		var waitCh <-chan struct{}
+		b.GetControllersBroadcast().HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+			waitCh = getWaitCh()
+		})
+		var found int
+		for _, ctrl := range b.GetControllers() {
+			if resCtrl, ok := ctrl.(*resolver.Controller); ok {
+				if _, ok := resolvers[resCtrl.GetFactoryResolver()]; ok {
+					found++
+				}
+			}
+		}
+		if found >= len(resolvers) {
+			return release, nil
+		}
+		select {
+		case <-ctx.Done():
+			release()
+			return nil, context.Canceled
+		case <-waitCh:
+		}
+	}
+}
+
+// watchConfigFiles watches the config files and dirs and calls reload when they change.
+func watchConfigFiles(ctx context.Context, le *logrus.Entry, confPaths []string, reload func(ctx context.Context)) error {
+	return configset_watcher.WatchFiles(ctx, le, confPaths, reload)
 }
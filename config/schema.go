@@ -0,0 +1,107 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// ConstructorWithSchema is a Constructor that provides the JSON schema of the
+// config directly instead of deriving it from the config type.
+type ConstructorWithSchema interface {
+	Constructor
+	// GetConfigSchema returns the JSON schema of the config.
+	GetConfigSchema() ([]byte, error)
+}
+
+// GetConfigSchema returns the JSON schema of the config built by the constructor.
+func GetConfigSchema(ctor Constructor) ([]byte, error) {
+	if sctor, ok := ctor.(ConstructorWithSchema); ok {
+		return sctor.GetConfigSchema()
+	}
+	return BuildConfigSchema(ctor.ConstructConfig())
+}
+
+// BuildConfigSchema builds a JSON schema for the JSON form of the config.
+//
+// The schema is derived from the protobuf struct tags of the config message.
+func BuildConfigSchema(conf Config) ([]byte, error) {
+	schema := buildTypeSchema(reflect.TypeOf(conf), make(map[reflect.Type]bool))
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["title"] = conf.GetConfigID()
+	return json.Marshal(schema)
+}
+
+// stringerType is the type of fmt.Stringer.
+var stringerType = reflect.TypeFor[interface{ String() string }]()
+
+// buildTypeSchema builds the schema for a type.
+//
+// seen contains the message types currently being expanded.
+func buildTypeSchema(t reflect.Type, seen map[reflect.Type]bool) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Int32:
+		// enums are encoded with the value name
+		if t.Implements(stringerType) {
+			return map[string]any{"type": []string{"string", "integer"}}
+		}
+		return map[string]any{"type": "integer"}
+	case reflect.Uint32:
+		return map[string]any{"type": "integer", "minimum": 0}
+	case reflect.Int64, reflect.Uint64:
+		// 64-bit integers are encoded as strings
+		return map[string]any{"type": []string{"integer", "string"}}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "contentEncoding": "base64"}
+		}
+		return map[string]any{"type": "array", "items": buildTypeSchema(t.Elem(), seen)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": buildTypeSchema(t.Elem(), seen)}
+	case reflect.Struct:
+		return buildMessageSchema(t, seen)
+	default:
+		return map[string]any{}
+	}
+}
+
+// buildMessageSchema builds the schema for a message struct.
+func buildMessageSchema(t reflect.Type, seen map[reflect.Type]bool) map[string]any {
+	schema := map[string]any{"type": "object"}
+	if seen[t] {
+		// recursive message
+		return schema
+	}
+	seen[t] = true
+	defer delete(seen, t)
+
+	props := make(map[string]any)
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if oneofName := field.Tag.Get("protobuf_oneof"); oneofName != "" {
+			// the oneof wrapper types are not known
+			props[oneofName] = map[string]any{}
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" || field.Tag.Get("protobuf") == "" {
+			continue
+		}
+		props[name] = buildTypeSchema(field.Type, seen)
+	}
+	schema["properties"] = props
+	return schema
+}
@@ -0,0 +1,270 @@
+package configset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/aperturerobotics/util/broadcast"
+)
+
+// ApplyTransactionResult is the result of Applier.ApplyTransactional.
+type ApplyTransactionResult struct {
+	// Diff contains the keys changed by the apply.
+	// If RolledBack is set the changes were reverted.
+	Diff *ApplierDiff
+	// Started contains the added and changed keys which started.
+	Started []string
+	// Errors contains the errors of the added and changed keys which did not
+	// start, by key.
+	Errors map[string]error
+	// RolledBack indicates the previous configs were restored.
+	RolledBack bool
+}
+
+// ApplyTransactional replaces the applied configset with cs if every added
+// or changed config starts within timeout.
+//
+// The new revisions are applied while the previous revisions are held, then
+// waits for each added or changed controller to be running, or ready if it
+// implements controller.ControllerWithReady. Once all have started the
+// previous revisions and the removed keys are released. If any controller
+// reports an error, or does not start before timeout or ctx is canceled, the
+// new revisions are released and the previous configs are applied again with
+// a newer revision. Removed keys keep running until the apply succeeds.
+//
+// If timeout is zero, waits until ctx is canceled. Returns an error wrapping
+// ErrApplyRolledBack if the apply was rolled back. The result contains the
+// outcome for each key.
+func (a *Applier) ApplyTransactional(
+	ctx context.Context,
+	cs ConfigSet,
+	timeout time.Duration,
+) (*ApplyTransactionResult, error) {
+	if err := cs.CheckDependencies(); err != nil {
+		return nil, err
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	res := &ApplyTransactionResult{
+		Diff:   &ApplierDiff{},
+		Errors: make(map[string]error),
+	}
+	for key := range a.entries {
+		if conf := cs[key]; conf == nil || conf.GetConfig() == nil {
+			res.Diff.Removed = append(res.Diff.Removed, key)
+		}
+	}
+
+	// apply the new revisions without releasing the previous
+	w := &startWatcher{states: make(map[string]State)}
+	pending := make(map[string]*appliedConfig)
+	var keys []string
+	for key, conf := range cs {
+		if key == "" || conf == nil || conf.GetConfig() == nil {
+			continue
+		}
+
+		rev := conf.GetRev()
+		prev := a.entries[key]
+		if prev != nil {
+			if prev.srcRev == rev &&
+				prev.conf.GetConfig().EqualsConfig(conf.GetConfig()) &&
+				prev.conf.GetRestartPolicy().EqualVT(conf.GetRestartPolicy()) &&
+				slices.Equal(prev.conf.GetDependsOn(), conf.GetDependsOn()) {
+				continue
+			}
+			if prevRev := prev.conf.GetRev(); rev <= prevRev {
+				rev = prevRev + 1
+			}
+		}
+
+		applied := NewControllerConfigWithOpts(rev, conf.GetConfig(), conf.GetRestartPolicy(), conf.GetDependsOn())
+		_, ref, err := a.b.AddDirective(NewApplyConfigSet(ConfigSet{key: applied}), w.newHandler(key, rev))
+		if err != nil {
+			return res, errors.Join(err, a.rollback(pending))
+		}
+
+		entry := &appliedConfig{srcRev: conf.GetRev(), conf: applied, ref: ref}
+		if prev != nil {
+			entry.seq = prev.seq
+			res.Diff.Changed = append(res.Diff.Changed, key)
+		} else {
+			entry.seq = a.nextSeq
+			a.nextSeq++
+			res.Diff.Added = append(res.Diff.Added, key)
+		}
+		pending[key] = entry
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	slices.Sort(res.Diff.Added)
+	slices.Sort(res.Diff.Changed)
+	slices.Sort(res.Diff.Removed)
+
+	waitCtx := ctx
+	if timeout > 0 {
+		var waitCtxCancel context.CancelFunc
+		waitCtx, waitCtxCancel = context.WithTimeout(ctx, timeout)
+		defer waitCtxCancel()
+	}
+	w.wait(waitCtx, keys, res)
+
+	if len(res.Errors) != 0 {
+		res.RolledBack = true
+		var firstErr error
+		for _, key := range keys {
+			if err := res.Errors[key]; err != nil && !errors.Is(err, ErrApplyAborted) {
+				firstErr = fmt.Errorf("%s: %w", key, err)
+				break
+			}
+		}
+		if firstErr == nil {
+			firstErr = ErrApplyAborted
+		}
+		return res, errors.Join(fmt.Errorf("%w: %w", ErrApplyRolledBack, firstErr), a.rollback(pending))
+	}
+
+	// release the previous revisions and the removed keys
+	for key, entry := range pending {
+		if prev := a.entries[key]; prev != nil {
+			prev.ref.Release()
+		}
+		a.entries[key] = entry
+	}
+	for _, key := range res.Diff.Removed {
+		a.entries[key].ref.Release()
+		delete(a.entries, key)
+	}
+	return res, nil
+}
+
+// rollback releases the pending revisions and applies the previous configs
+// with a revision newer than the pending revisions.
+// mtx is locked by the caller
+func (a *Applier) rollback(pending map[string]*appliedConfig) error {
+	var errs []error
+	for key, entry := range pending {
+		prev := a.entries[key]
+		if prev == nil {
+			entry.ref.Release()
+			continue
+		}
+
+		restored := NewControllerConfigWithOpts(
+			entry.conf.GetRev()+1,
+			prev.conf.GetConfig(),
+			prev.conf.GetRestartPolicy(),
+			prev.conf.GetDependsOn(),
+		)
+		di, ref, err := a.b.AddDirective(NewApplyConfigSet(ConfigSet{key: restored}), nil)
+		if err != nil {
+			// keep the pending revision applied
+			errs = append(errs, fmt.Errorf("restore %s: %w", key, err))
+			prev.ref.Release()
+			a.entries[key] = entry
+			continue
+		}
+
+		// wait for the restored revision to be pushed so the key is not removed
+		waitDirectiveIdle(di)
+		entry.ref.Release()
+		prev.ref.Release()
+		a.entries[key] = &appliedConfig{srcRev: prev.srcRev, conf: restored, ref: ref, seq: prev.seq}
+	}
+	return errors.Join(errs...)
+}
+
+// startWatcher watches the states of the revisions applied by a transaction.
+type startWatcher struct {
+	// bcast guards below fields
+	bcast broadcast.Broadcast
+	// states contains the most recent state of the applied revision by key
+	states map[string]State
+}
+
+// newHandler returns a handler for the ApplyConfigSet directive applying rev
+// of key.
+func (w *startWatcher) newHandler(key string, rev uint64) directive.ReferenceHandler {
+	return bus.NewCallbackHandler(
+		func(val directive.AttachedValue) {
+			st, ok := val.GetValue().(ApplyConfigSetValue)
+			if !ok || st == nil || st.GetId() != key || st.GetControllerConfig().GetRev() != rev {
+				return
+			}
+			w.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+				w.states[key] = st
+				broadcast()
+			})
+		},
+		nil,
+		nil,
+	)
+}
+
+// wait waits for the controllers of the keys to start, fail, or ctx to be
+// canceled, and sets the outcomes in res.
+//
+// Stops waiting as soon as any controller fails: the keys which have not
+// started yet are marked with ErrApplyAborted.
+func (w *startWatcher) wait(ctx context.Context, keys []string, res *ApplyTransactionResult) {
+	for {
+		var waitCh <-chan struct{}
+		var started, waiting []string
+		var failed bool
+		w.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+			waitCh = getWaitCh()
+			for _, key := range keys {
+				st := w.states[key]
+				switch {
+				case st == nil:
+					waiting = append(waiting, key)
+				case st.GetError() != nil:
+					res.Errors[key] = st.GetError()
+					failed = true
+				case isStarted(st):
+					started = append(started, key)
+				default:
+					waiting = append(waiting, key)
+				}
+			}
+		})
+
+		var waitErr error
+		if failed {
+			waitErr = ErrApplyAborted
+		} else if len(waiting) != 0 {
+			select {
+			case <-ctx.Done():
+				waitErr = ctx.Err()
+			case <-waitCh:
+				continue
+			}
+		}
+		for _, key := range waiting {
+			res.Errors[key] = waitErr
+		}
+		res.Started = started
+		return
+	}
+}
+
+// isStarted checks if the controller is running, or ready if it implements
+// controller.ControllerWithReady.
+func isStarted(st State) bool {
+	ctrl := st.GetController()
+	if ctrl == nil {
+		return false
+	}
+	if _, ok := ctrl.(controller.ControllerWithReady); ok {
+		return st.GetReady()
+	}
+	return true
+}
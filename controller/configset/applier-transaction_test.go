@@ -0,0 +1,127 @@
+package configset_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	controller_mock "github.com/aperturerobotics/controllerbus/controller/mock"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestApplyTransactional tests applying a configset with rollback.
+func TestApplyTransactional(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(&controller_mock.MockFactory{})
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	// getRunning returns the names of the running controllers by key.
+	getRunning := func() map[string]string {
+		running := make(map[string]string)
+		for _, st := range csCtrl.GetControllerStates() {
+			if ctrl, ok := st.GetController().(*controller_mock.MockController); ok {
+				running[st.GetId()] = ctrl.GetName()
+			}
+		}
+		return running
+	}
+	// waitRunning waits for the running controllers to match.
+	waitRunning := func(expected map[string]string) {
+		for {
+			running := getRunning()
+			if len(running) == len(expected) {
+				match := true
+				for key, name := range expected {
+					match = match && running[key] == name
+				}
+				if match {
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected running %v but got %v", expected, running)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	newConf := func(name string) configset.ControllerConfig {
+		return configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: name})
+	}
+
+	applier := configset.NewApplier(b)
+	defer applier.Release()
+	res, err := applier.ApplyTransactional(ctx, configset.ConfigSet{
+		"a": newConf("first"),
+		"b": newConf("first"),
+	}, time.Second)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !slices.Equal(res.Started, []string{"a", "b"}) || len(res.Errors) != 0 || res.RolledBack {
+		t.Fatalf("unexpected result: %v", res)
+	}
+	waitRunning(map[string]string{"a": "first", "b": "first"})
+
+	// a failing controller rolls back the changed, added and removed keys
+	res, err = applier.ApplyTransactional(ctx, configset.ConfigSet{
+		"a": newConf(controller_mock.FailName),
+		"c": newConf("first"),
+	}, time.Second)
+	if !errors.Is(err, configset.ErrApplyRolledBack) || !errors.Is(err, controller_mock.ErrMockExit) {
+		t.Fatalf("expected rolled back error but got %v", err)
+	}
+	if !res.RolledBack || !errors.Is(res.Errors["a"], controller_mock.ErrMockExit) {
+		t.Fatalf("unexpected result: %v", res)
+	}
+	if cErr := res.Errors["c"]; cErr != nil && !errors.Is(cErr, configset.ErrApplyAborted) {
+		t.Fatalf("unexpected error for c: %v", cErr)
+	}
+	if !slices.Equal(res.Diff.Changed, []string{"a"}) ||
+		!slices.Equal(res.Diff.Added, []string{"c"}) ||
+		!slices.Equal(res.Diff.Removed, []string{"b"}) {
+		t.Fatalf("unexpected diff: %v", res.Diff)
+	}
+	applied := applier.GetConfigSet()
+	if len(applied) != 2 || applied["a"].GetConfig().(*boilerplate.Config).GetExampleField() != "first" || applied["b"] == nil {
+		t.Fatalf("expected previous configs to be restored: %v", applied)
+	}
+	waitRunning(map[string]string{"a": "first", "b": "first"})
+
+	// a controller which does not start before the timeout rolls back
+	res, err = applier.ApplyTransactional(ctx, configset.ConfigSet{
+		"a": newConf("first"),
+		"b": configset.NewControllerConfigWithOpts(1, &boilerplate.Config{ExampleField: "second"}, nil, []string{"missing"}),
+	}, 100*time.Millisecond)
+	if !errors.Is(err, configset.ErrApplyRolledBack) || !errors.Is(res.Errors["b"], context.DeadlineExceeded) {
+		t.Fatalf("expected timeout to roll back but got %v", err)
+	}
+	waitRunning(map[string]string{"a": "first", "b": "first"})
+}
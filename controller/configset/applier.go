@@ -0,0 +1,249 @@
+package configset
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
+)
+
+// Applier applies a configset to a bus which can be replaced while running.
+//
+// Each key is applied with a separate ApplyConfigSet directive so that the
+// controllers for unchanged keys keep running when the configset is replaced.
+type Applier struct {
+	// b is the bus to apply to
+	b bus.Bus
+
+	// mtx guards below fields
+	mtx sync.Mutex
+	// entries contains the applied configs by key
+	entries map[string]*appliedConfig
+	// nextSeq is the sequence number of the next added key
+	nextSeq uint64
+}
+
+// appliedConfig is a controller config applied by Applier.
+type appliedConfig struct {
+	// srcRev is the revision in the source configset
+	srcRev uint64
+	// conf is the applied config with the bumped revision
+	conf ControllerConfig
+	// ref is the reference to the ApplyConfigSet directive
+	ref directive.Reference
+	// seq is the order the key was added in
+	seq uint64
+}
+
+// ApplierDiff contains the keys changed by Applier.Apply.
+type ApplierDiff struct {
+	// Added contains the added keys.
+	Added []string
+	// Changed contains the keys with a changed config.
+	Changed []string
+	// Removed contains the removed keys.
+	Removed []string
+}
+
+// Empty checks if the diff has no changes.
+func (d *ApplierDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// NewApplier constructs a new Applier.
+func NewApplier(b bus.Bus) *Applier {
+	return &Applier{b: b, entries: make(map[string]*appliedConfig)}
+}
+
+// Apply replaces the applied configset with cs.
+//
+// Changed configs are applied with a revision newer than the applied one so
+// the controller restarts with the new config. Removed keys are released.
+// Keys with nil configs are ignored. If the configs depend on each other in a
+// cycle, returns a *DependencyCycleError without applying any changes.
+func (a *Applier) Apply(cs ConfigSet) (*ApplierDiff, error) {
+	if err := cs.CheckDependencies(); err != nil {
+		return nil, err
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	diff := &ApplierDiff{}
+	for key, prev := range a.entries {
+		if conf := cs[key]; conf == nil || conf.GetConfig() == nil {
+			prev.ref.Release()
+			delete(a.entries, key)
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+
+	for key, conf := range cs {
+		if key == "" || conf == nil || conf.GetConfig() == nil {
+			continue
+		}
+
+		rev := conf.GetRev()
+		prev := a.entries[key]
+		if prev != nil {
+			if prev.srcRev == rev &&
+				prev.conf.GetConfig().EqualsConfig(conf.GetConfig()) &&
+				prev.conf.GetRestartPolicy().EqualVT(conf.GetRestartPolicy()) &&
+				slices.Equal(prev.conf.GetDependsOn(), conf.GetDependsOn()) {
+				continue
+			}
+			if prevRev := prev.conf.GetRev(); rev <= prevRev {
+				rev = prevRev + 1
+			}
+		}
+
+		applied := NewControllerConfigWithOpts(rev, conf.GetConfig(), conf.GetRestartPolicy(), conf.GetDependsOn())
+		di, ref, err := a.b.AddDirective(NewApplyConfigSet(ConfigSet{key: applied}), nil)
+		if err != nil {
+			return diff, err
+		}
+
+		// release the previous config after applying the new revision
+		entry := &appliedConfig{srcRev: conf.GetRev(), conf: applied, ref: ref}
+		if prev != nil {
+			// wait for the new revision to be pushed so the key is not removed
+			waitDirectiveIdle(di)
+			prev.ref.Release()
+			entry.seq = prev.seq
+			diff.Changed = append(diff.Changed, key)
+		} else {
+			entry.seq = a.nextSeq
+			a.nextSeq++
+			diff.Added = append(diff.Added, key)
+		}
+		a.entries[key] = entry
+	}
+
+	slices.Sort(diff.Added)
+	slices.Sort(diff.Changed)
+	slices.Sort(diff.Removed)
+	return diff, nil
+}
+
+// GetConfigSet returns the applied configset with the bumped revisions.
+func (a *Applier) GetConfigSet() ConfigSet {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	cs := make(ConfigSet, len(a.entries))
+	for key, entry := range a.entries {
+		cs[key] = entry.conf
+	}
+	return cs
+}
+
+// Release releases all applied configs.
+func (a *Applier) Release() {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	for key, entry := range a.entries {
+		entry.ref.Release()
+		delete(a.entries, key)
+	}
+}
+
+// Shutdown releases the applied configs, waiting for each controller to exit
+// before releasing the next. Dependents are released before the keys they
+// depend on, otherwise keys are released in reverse order of when they were
+// added.
+//
+// Returns the keys of the controllers that did not exit before ctx was
+// canceled. The remaining configs are released without waiting once ctx is
+// canceled.
+func (a *Applier) Shutdown(ctx context.Context) []string {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	keys := a.getShutdownOrder()
+
+	running := getRunningControllers(a.b)
+	var failed []string
+	for _, key := range keys {
+		a.entries[key].ref.Release()
+		delete(a.entries, key)
+		if ctrl := running[key]; ctrl != nil && !bus.WaitControllerRemoved(ctx, a.b, ctrl) {
+			failed = append(failed, key)
+		}
+	}
+	return failed
+}
+
+// getRunningControllers returns the running controllers of the configset
+// controllers on the bus by key.
+func getRunningControllers(b bus.Bus) map[string]controller.Controller {
+	running := make(map[string]controller.Controller)
+	for _, ctrl := range b.GetControllers() {
+		csCtrl, ok := ctrl.(Controller)
+		if !ok {
+			continue
+		}
+		for _, st := range csCtrl.GetControllerStates() {
+			if c := st.GetController(); c != nil {
+				running[st.GetId()] = c
+			}
+		}
+	}
+	return running
+}
+
+// getShutdownOrder returns the applied keys in the order to release them.
+// mtx is locked by the caller
+func (a *Applier) getShutdownOrder() []string {
+	remaining := make([]string, 0, len(a.entries))
+	for key := range a.entries {
+		remaining = append(remaining, key)
+	}
+	// most recently added first
+	slices.SortFunc(remaining, func(x, y string) int {
+		return cmp.Compare(a.entries[y].seq, a.entries[x].seq)
+	})
+
+	// hasDependents checks if any other remaining key depends on key.
+	hasDependents := func(key string) bool {
+		for _, other := range remaining {
+			if other != key && slices.Contains(a.entries[other].conf.GetDependsOn(), key) {
+				return true
+			}
+		}
+		return false
+	}
+
+	keys := make([]string, 0, len(remaining))
+	for len(remaining) != 0 {
+		// the first key without dependents, or the first key if all have dependents
+		next := slices.IndexFunc(remaining, func(key string) bool {
+			return !hasDependents(key)
+		})
+		if next == -1 {
+			next = 0
+		}
+		keys = append(keys, remaining[next])
+		remaining = slices.Delete(remaining, next, next+1)
+	}
+	return keys
+}
+
+// waitDirectiveIdle waits for the directive resolvers to become idle.
+func waitDirectiveIdle(di directive.Instance) {
+	idleCh := make(chan struct{})
+	var idleOnce sync.Once
+	relIdleCb := di.AddIdleCallback(func(isIdle bool, errs []error) {
+		if isIdle {
+			idleOnce.Do(func() {
+				close(idleCh)
+			})
+		}
+	})
+	<-idleCh
+	relIdleCb()
+}
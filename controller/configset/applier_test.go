@@ -0,0 +1,198 @@
+package configset_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	controller_mock "github.com/aperturerobotics/controllerbus/controller/mock"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestApplier tests replacing the applied configset.
+func TestApplier(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate.NewFactory(b))
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	// waitRunning waits for the running controllers to match the keys.
+	waitRunning := func(keys ...string) map[string]controller.Controller {
+		for {
+			running := make(map[string]controller.Controller)
+			var runningKeys []string
+			for _, st := range csCtrl.GetControllerStates() {
+				if ctrl := st.GetController(); ctrl != nil {
+					running[st.GetId()] = ctrl
+					runningKeys = append(runningKeys, st.GetId())
+				}
+			}
+			if slices.Equal(runningKeys, keys) {
+				return running
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected running %v but got %v", keys, runningKeys)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	applier := configset.NewApplier(b)
+	defer applier.Release()
+	diff, err := applier.Apply(configset.ConfigSet{
+		"changed":   configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: "before"}),
+		"removed":   configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: "removed"}),
+		"unchanged": configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: "unchanged"}),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(diff.Added) != 3 {
+		t.Fatalf("unexpected diff: %v", diff)
+	}
+	before := waitRunning("changed", "removed", "unchanged")
+
+	diff, err = applier.Apply(configset.ConfigSet{
+		"changed":   configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: "after"}),
+		"unchanged": configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: "unchanged"}),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !slices.Equal(diff.Changed, []string{"changed"}) || !slices.Equal(diff.Removed, []string{"removed"}) || len(diff.Added) != 0 {
+		t.Fatalf("unexpected diff: %v", diff)
+	}
+	if rev := applier.GetConfigSet()["changed"].GetRev(); rev != 2 {
+		t.Fatalf("expected bumped revision 2 but got %d", rev)
+	}
+
+	for {
+		after := waitRunning("changed", "unchanged")
+		if after["unchanged"] != before["unchanged"] {
+			t.Fatal("expected unchanged controller to keep running")
+		}
+		if after["changed"] != before["changed"] {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("expected changed controller to restart")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// TestApplierShutdown tests shutting down the applied configs in reverse order.
+func TestApplierShutdown(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// stuck is closed to allow the controller named stuck to exit
+	stuck := make(chan struct{})
+	defer close(stuck)
+	factory := &controller_mock.MockFactory{
+		ExecuteFn: func(ctx context.Context, c *controller_mock.MockController) error {
+			<-ctx.Done()
+			if c.GetName() == "stuck" {
+				<-stuck
+			}
+			return ctx.Err()
+		},
+	}
+	sr.AddFactory(factory)
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	applier := configset.NewApplier(b)
+	cs := configset.ConfigSet{}
+	for _, key := range []string{"first", "second", "third"} {
+		cs[key] = configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: key})
+		if _, err := applier.Apply(cs); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	for {
+		var running int
+		for _, st := range csCtrl.GetControllerStates() {
+			if st.GetController() != nil {
+				running++
+			}
+		}
+		if running == 3 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal(ctx.Err().Error())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	if failed := applier.Shutdown(ctx); len(failed) != 0 {
+		t.Fatalf("unexpected failed controllers: %v", failed)
+	}
+	if exited := factory.GetExited(); !slices.Equal(exited, []string{"third", "second", "first"}) {
+		t.Fatalf("expected reverse shutdown order but got %v", exited)
+	}
+
+	// a controller that ignores the context fails to stop
+	cs = configset.ConfigSet{"stuck": configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: "stuck"})}
+	if _, err := applier.Apply(cs); err != nil {
+		t.Fatal(err.Error())
+	}
+	for len(csCtrl.GetControllerStates()) == 0 || csCtrl.GetControllerStates()[0].GetController() == nil {
+		select {
+		case <-ctx.Done():
+			t.Fatal(ctx.Err().Error())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	shutdownCtx, shutdownCtxCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer shutdownCtxCancel()
+	if failed := applier.Shutdown(shutdownCtx); !slices.Equal(failed, []string{"stuck"}) {
+		t.Fatalf("expected stuck controller to fail to stop but got %v", failed)
+	}
+}
@@ -2,12 +2,15 @@ package configset
 
 import (
 	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/aperturerobotics/controllerbus/controller/loader"
 )
 
 // controllerConfig backs ControllerConfig in memory.
 type controllerConfig struct {
-	rev  uint64
-	conf config.Config
+	rev           uint64
+	conf          config.Config
+	restartPolicy *loader.RestartPolicy
+	dependsOn     []string
 }
 
 // NewControllerConfig constructs a controller config object.
@@ -18,6 +21,36 @@ func NewControllerConfig(rev uint64, conf config.Config) ControllerConfig {
 	}
 }
 
+// NewControllerConfigWithRestartPolicy constructs a controller config object
+// with a policy for restarting the controller.
+func NewControllerConfigWithRestartPolicy(
+	rev uint64,
+	conf config.Config,
+	restartPolicy *loader.RestartPolicy,
+) ControllerConfig {
+	return &controllerConfig{
+		rev:           rev,
+		conf:          conf,
+		restartPolicy: restartPolicy,
+	}
+}
+
+// NewControllerConfigWithOpts constructs a controller config object with a
+// policy for restarting the controller and the keys it depends on.
+func NewControllerConfigWithOpts(
+	rev uint64,
+	conf config.Config,
+	restartPolicy *loader.RestartPolicy,
+	dependsOn []string,
+) ControllerConfig {
+	return &controllerConfig{
+		rev:           rev,
+		conf:          conf,
+		restartPolicy: restartPolicy,
+		dependsOn:     dependsOn,
+	}
+}
+
 // GetRev returns the revision.
 func (c *controllerConfig) GetRev() uint64 {
 	return c.rev
@@ -28,5 +61,15 @@ func (c *controllerConfig) GetConfig() config.Config {
 	return c.conf
 }
 
+// GetRestartPolicy returns the policy for restarting the controller.
+func (c *controllerConfig) GetRestartPolicy() *loader.RestartPolicy {
+	return c.restartPolicy
+}
+
+// GetDependsOn returns the configset keys the controller depends on.
+func (c *controllerConfig) GetDependsOn() []string {
+	return c.dependsOn
+}
+
 // _ is a type assertion
 var _ ControllerConfig = ((*controllerConfig)(nil))
@@ -2,9 +2,12 @@ package configset
 
 import (
 	"context"
+	"slices"
 
 	"github.com/aperturerobotics/controllerbus/config"
 	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/loader"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
 )
 
 // Controller is a configset controller.
@@ -21,6 +24,26 @@ type Controller interface {
 		key string,
 		conf ControllerConfig,
 	) (Reference, error)
+
+	// GetControllerStates returns a snapshot of the states of the controllers
+	// managed by the configset controller, sorted by key.
+	GetControllerStates() []State
+	// GetConfigSet returns a snapshot of the most recent config pushed for each
+	// key, which may not be in use yet.
+	GetConfigSet() ConfigSet
+
+	// RestartController restarts the controller with the configset key.
+	// Clears the quarantine if the controller was quarantined.
+	// Returns false if the key was not found.
+	RestartController(key string) bool
+	// StopController stops the controller with the configset key and releases
+	// the references to it. Persistent references are kept and re-attached if
+	// the key is applied again. Returns false if the key was not found.
+	StopController(key string) bool
+	// RemoveController stops the controller with the configset key and
+	// releases all references to it, including persistent references.
+	// Returns false if the key was not found.
+	RemoveController(key string) bool
 }
 
 // ConfigSet is a key/value set of controller configs.
@@ -39,6 +62,12 @@ func (c ConfigSet) Equal(os ConfigSet) bool {
 		if !ov.GetConfig().EqualsConfig(v.GetConfig()) {
 			return false
 		}
+		if !ov.GetRestartPolicy().EqualVT(v.GetRestartPolicy()) {
+			return false
+		}
+		if !slices.Equal(ov.GetDependsOn(), v.GetDependsOn()) {
+			return false
+		}
 		if ov.GetRev() != v.GetRev() {
 			return false
 		}
@@ -78,6 +107,12 @@ type ControllerConfig interface {
 	GetRev() uint64
 	// GetConfig returns the config object.
 	GetConfig() config.Config
+	// GetRestartPolicy returns the policy for restarting the controller.
+	// If nil, restarts the controller when Execute returns an error.
+	GetRestartPolicy() *loader.RestartPolicy
+	// GetDependsOn returns the configset keys the controller depends on.
+	// The controller is started once the dependencies are running or ready.
+	GetDependsOn() []string
 }
 
 // Reference is a reference to a pushed controller config. The reference is used
@@ -106,6 +141,16 @@ type State interface {
 	// GetController returns the controller instance if running.
 	// Returns nil otherwise.
 	GetController() controller.Controller
+	// GetReady returns if the running controller reported it is ready.
+	// Only controllers implementing controller.ControllerWithReady are ready.
+	GetReady() bool
+	// GetQuarantined returns if the controller was quarantined after exiting
+	// with an error too many times. The error is ErrControllerQuarantined.
+	GetQuarantined() bool
 	// GetError returns any error processing the controller config.
 	GetError() error
+	// GetExecValue returns the most recent value of the directive executing
+	// the controller, with the restart count and the last exit.
+	// Returns nil if there is none.
+	GetExecValue() resolver.LoadControllerWithConfigValue
 }
@@ -1,7 +1,10 @@
 package configset_controller
 
 import (
+	"time"
+
 	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/pkg/errors"
 )
 
 // ConfigID is the identifier for the config type.
@@ -19,5 +22,24 @@ func (c *Config) EqualsConfig(oc config.Config) bool {
 
 // Validate validates the configuration.
 func (c *Config) Validate() error {
+	if _, err := c.ParseQuarantineWindowDur(); err != nil {
+		return errors.Wrap(err, "quarantine_window_dur")
+	}
 	return nil
 }
+
+// ParseQuarantineWindowDur parses the quarantine window duration if set.
+func (c *Config) ParseQuarantineWindowDur() (time.Duration, error) {
+	var dur time.Duration
+	if windowDur := c.GetQuarantineWindowDur(); windowDur != "" {
+		var err error
+		dur, err = time.ParseDuration(windowDur)
+		if err != nil {
+			return 0, err
+		}
+		if dur < 0 {
+			return 0, errors.Errorf("duration cannot be negative: %s", windowDur)
+		}
+	}
+	return dur, nil
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	io "io"
 	slices "slices"
+	strconv "strconv"
 	strings "strings"
 
 	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
@@ -17,6 +18,14 @@ import (
 // Config is the configset controller configuration.
 type Config struct {
 	unknownFields []byte
+	// QuarantineExits is the number of times a controller can exit with an
+	// error within quarantine_window_dur before it is quarantined.
+	// If zero, controllers are never quarantined.
+	QuarantineExits uint32 `protobuf:"varint,1,opt,name=quarantine_exits,json=quarantineExits,proto3" json:"quarantineExits,omitempty"`
+	// QuarantineWindowDur is the window to count exits within as a duration string.
+	// If empty, all exits since the config was applied are counted.
+	// Example: 1m
+	QuarantineWindowDur string `protobuf:"bytes,2,opt,name=quarantine_window_dur,json=quarantineWindowDur,proto3" json:"quarantineWindowDur,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -25,11 +34,27 @@ func (x *Config) Reset() {
 
 func (*Config) ProtoMessage() {}
 
+func (x *Config) GetQuarantineExits() uint32 {
+	if x != nil {
+		return x.QuarantineExits
+	}
+	return 0
+}
+
+func (x *Config) GetQuarantineWindowDur() string {
+	if x != nil {
+		return x.QuarantineWindowDur
+	}
+	return ""
+}
+
 func (m *Config) CloneVT() *Config {
 	if m == nil {
 		return (*Config)(nil)
 	}
 	r := new(Config)
+	r.QuarantineExits = m.QuarantineExits
+	r.QuarantineWindowDur = m.QuarantineWindowDur
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = slices.Clone(m.unknownFields)
 	}
@@ -46,6 +71,12 @@ func (this *Config) EqualVT(that *Config) bool {
 	} else if this == nil || that == nil {
 		return false
 	}
+	if this.QuarantineExits != that.QuarantineExits {
+		return false
+	}
+	if this.QuarantineWindowDur != that.QuarantineWindowDur {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -64,6 +95,17 @@ func (x *Config) MarshalProtoJSON(s *json.MarshalState) {
 		return
 	}
 	s.WriteObjectStart()
+	var wroteField bool
+	if x.QuarantineExits != 0 || s.HasField("quarantineExits") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("quarantineExits")
+		s.WriteUint32(x.QuarantineExits)
+	}
+	if x.QuarantineWindowDur != "" || s.HasField("quarantineWindowDur") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("quarantineWindowDur")
+		s.WriteString(x.QuarantineWindowDur)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -78,7 +120,16 @@ func (x *Config) UnmarshalProtoJSON(s *json.UnmarshalState) {
 		return
 	}
 	s.ReadObject(func(key string) {
-		// no fields
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "quarantine_exits", "quarantineExits":
+			s.AddField("quarantine_exits")
+			x.QuarantineExits = s.ReadUint32()
+		case "quarantine_window_dur", "quarantineWindowDur":
+			s.AddField("quarantine_window_dur")
+			x.QuarantineWindowDur = s.ReadString()
+		}
 	})
 }
 
@@ -117,6 +168,18 @@ func (m *Config) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.QuarantineWindowDur) > 0 {
+		i -= len(m.QuarantineWindowDur)
+		copy(dAtA[i:], m.QuarantineWindowDur)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.QuarantineWindowDur)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QuarantineExits != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.QuarantineExits))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -126,6 +189,13 @@ func (m *Config) SizeVT() (n int) {
 	}
 	var l int
 	_ = l
+	if m.QuarantineExits != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.QuarantineExits))
+	}
+	l = len(m.QuarantineWindowDur)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -133,6 +203,20 @@ func (m *Config) SizeVT() (n int) {
 func (x *Config) MarshalProtoText() string {
 	var sb strings.Builder
 	sb.WriteString("Config {")
+	if x.QuarantineExits != 0 {
+		if sb.Len() > 8 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("quarantine_exits: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.QuarantineExits), 10))
+	}
+	if x.QuarantineWindowDur != "" {
+		if sb.Len() > 8 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("quarantine_window_dur: ")
+		sb.WriteString(strconv.Quote(x.QuarantineWindowDur))
+	}
 	sb.WriteString("}")
 	return sb.String()
 }
@@ -161,6 +245,37 @@ func (m *Config) UnmarshalVT(dAtA []byte) error {
 			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantineExits", wireType)
+			}
+			m.QuarantineExits = 0
+			m.QuarantineExits, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantineWindowDur", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuarantineWindowDur = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
@@ -1,9 +1,17 @@
 // @generated
 // This file is @generated by prost-build.
 /// Config is the configset controller configuration.
-///
-/// TODO Future options might control how configsets are merged.
-#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
 pub struct Config {
+    /// QuarantineExits is the number of times a controller can exit with an
+    /// error within quarantine_window_dur before it is quarantined.
+    /// If zero, controllers are never quarantined.
+    #[prost(uint32, tag="1")]
+    pub quarantine_exits: u32,
+    /// QuarantineWindowDur is the window to count exits within as a duration string.
+    /// If empty, all exits since the config was applied are counted.
+    /// Example: 1m
+    #[prost(string, tag="2")]
+    pub quarantine_window_dur: ::prost::alloc::string::String,
 }
 // @@protoc_insertion_point(module)
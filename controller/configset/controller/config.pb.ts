@@ -3,22 +3,45 @@
 /* eslint-disable */
 
 import type { MessageType, PartialFieldInfo } from '@aptre/protobuf-es-lite'
-import { createMessageType } from '@aptre/protobuf-es-lite'
+import { createMessageType, ScalarType } from '@aptre/protobuf-es-lite'
 
 export const protobufPackage = 'configset.controller'
 
 /**
  * Config is the configset controller configuration.
  *
- * TODO Future options might control how configsets are merged.
- *
  * @generated from message configset.controller.Config
  */
-export interface Config {}
+export interface Config {
+  /**
+   * QuarantineExits is the number of times a controller can exit with an
+   * error within quarantine_window_dur before it is quarantined.
+   * If zero, controllers are never quarantined.
+   *
+   * @generated from field: uint32 quarantine_exits = 1;
+   */
+  quarantineExits?: number
+  /**
+   * QuarantineWindowDur is the window to count exits within as a duration string.
+   * If empty, all exits since the config was applied are counted.
+   * Example: 1m
+   *
+   * @generated from field: string quarantine_window_dur = 2;
+   */
+  quarantineWindowDur?: string
+}
 
 // Config contains the message type declaration for Config.
 export const Config: MessageType<Config> = createMessageType({
   typeName: 'configset.controller.Config',
-  fields: [] as readonly PartialFieldInfo[],
+  fields: [
+    { no: 1, name: 'quarantine_exits', kind: 'scalar', T: ScalarType.UINT32 },
+    {
+      no: 2,
+      name: 'quarantine_window_dur',
+      kind: 'scalar',
+      T: ScalarType.STRING,
+    },
+  ] as readonly PartialFieldInfo[],
   packedByDefault: true,
 })
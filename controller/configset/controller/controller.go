@@ -2,12 +2,15 @@ package configset_controller
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/aperturerobotics/controllerbus/bus"
 	"github.com/aperturerobotics/controllerbus/controller"
 	"github.com/aperturerobotics/controllerbus/controller/configset"
 	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/aperturerobotics/util/broadcast"
 	"github.com/sirupsen/logrus"
 )
 
@@ -23,8 +26,12 @@ type Controller struct {
 	le *logrus.Entry
 	// bus is the controller bus
 	bus bus.Bus
+	// conf is the controller config
+	conf *Config
 	// wakeCh wakes the controller
 	wakeCh chan struct{}
+	// bcast is broadcast when the controllers or their states change
+	bcast broadcast.Broadcast
 
 	// mtx guards the controllers map
 	mtx                  sync.Mutex
@@ -36,9 +43,22 @@ type Controller struct {
 // NewController constructs a new peer controller.
 // If privKey is nil, one will be generated.
 func NewController(le *logrus.Entry, bus bus.Bus) (*Controller, error) {
+	return NewControllerWithConfig(le, bus, nil)
+}
+
+// NewControllerWithConfig constructs a new configset controller with a config.
+// If conf is nil, uses the default config.
+func NewControllerWithConfig(le *logrus.Entry, bus bus.Bus, conf *Config) (*Controller, error) {
+	if conf == nil {
+		conf = &Config{}
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
 	return &Controller{
 		le:             le,
 		bus:            bus,
+		conf:           conf,
 		wakeCh:         make(chan struct{}, 1),
 		controllers:    make(map[string]*runningController),
 		persistentRefs: make(map[uint32]*runningControllerRef),
@@ -49,7 +69,9 @@ func NewController(le *logrus.Entry, bus bus.Bus) (*Controller, error) {
 // Returning nil ends execution.
 // Returning an error triggers a retry with backoff.
 func (c *Controller) Execute(ctx context.Context) error {
-	execControllers := make(map[string]context.CancelFunc)
+	execControllers := make(map[string]*execController)
+	// stopping contains the removed controllers which have not exited yet
+	var stopping []*execController
 ExecLoop:
 	for {
 		select {
@@ -59,43 +81,58 @@ ExecLoop:
 		}
 
 		c.mtx.Lock()
-		for k, cancel := range execControllers {
+		for k, ex := range execControllers {
 			cv, ok := c.controllers[k]
-			if ok {
+			if ok && cv == ex.rc {
 				cv.mtx.Lock()
 				if len(cv.refs) == 0 { // garbage collect
 					delete(c.controllers, k)
 					ok = false
 				}
 				cv.mtx.Unlock()
+			} else {
+				ok = false
 			}
 			if !ok {
-				cancel()
 				delete(execControllers, k)
+				stopping = append(stopping, ex)
 			}
 		}
-		for k, c := range c.controllers {
+		for k, rc := range c.controllers {
 			if _, ok := execControllers[k]; !ok {
 				// #nosec G118 -- cancel func is stored in execControllers and called when the controller is removed.
 				nctx, nctxCancel := context.WithCancel(ctx)
-				execControllers[k] = nctxCancel
-				go func(nctx context.Context, ctrl *runningController) {
-					_ = ctrl.Execute(nctx)
-				}(nctx, c)
+				ex := &execController{
+					rc:     rc,
+					cancel: nctxCancel,
+					exited: make(chan struct{}),
+				}
+				execControllers[k] = ex
+				go func() {
+					_ = ex.rc.Execute(nctx, ctx)
+					close(ex.exited)
+					c.wake()
+				}()
 			}
 		}
 		c.mtx.Unlock()
+		c.broadcast()
+
+		stopping = stopExecControllers(stopping)
 	}
 
 	c.mtx.Lock()
 	for k := range c.controllers {
 		delete(c.controllers, k)
-		if cancel, kiOk := execControllers[k]; kiOk {
-			cancel()
+		if ex, kiOk := execControllers[k]; kiOk {
+			ex.cancel()
 			delete(execControllers, k)
 		}
 	}
 	c.mtx.Unlock()
+	for _, ex := range stopping {
+		ex.cancel()
+	}
 	return nil
 }
 
@@ -141,11 +178,13 @@ func (c *Controller) PushControllerConfig(
 	if existingOk {
 		if existing.ApplyConfig(conf) {
 			c.wake()
+			c.broadcast()
 		}
 	} else {
 		existing = newRunningController(c, key, conf)
 		c.controllers[key] = existing
 		c.wake()
+		c.broadcast()
 		for _, pref := range c.persistentRefs {
 			if pref.id == key {
 				existing.ApplyReference(pref)
@@ -158,6 +197,79 @@ func (c *Controller) PushControllerConfig(
 	return ref, nil
 }
 
+// GetControllerStates returns a snapshot of the states of the controllers
+// managed by the configset controller, sorted by key.
+func (c *Controller) GetControllerStates() []configset.State {
+	c.mtx.Lock()
+	states := make([]configset.State, 0, len(c.controllers))
+	for _, rc := range c.controllers {
+		states = append(states, rc.GetState())
+	}
+	c.mtx.Unlock()
+	slices.SortFunc(states, func(a, b configset.State) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+	return states
+}
+
+// GetConfigSet returns a snapshot of the most recent config pushed for each
+// key, which may not be in use yet.
+func (c *Controller) GetConfigSet() configset.ConfigSet {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	cs := make(configset.ConfigSet, len(c.controllers))
+	for key, rc := range c.controllers {
+		cs[key] = rc.getControllerConfig()
+	}
+	return cs
+}
+
+// RestartController restarts the controller with the configset key.
+// Clears the quarantine if the controller was quarantined.
+// Returns false if the key was not found.
+func (c *Controller) RestartController(key string) bool {
+	c.mtx.Lock()
+	rc, ok := c.controllers[key]
+	c.mtx.Unlock()
+	if ok {
+		rc.Restart()
+	}
+	return ok
+}
+
+// StopController stops the controller with the configset key and releases the
+// references to it. Persistent references are kept and re-attached if the key
+// is applied again. Returns false if the key was not found.
+func (c *Controller) StopController(key string) bool {
+	return c.stopController(key, false)
+}
+
+// RemoveController stops the controller with the configset key and releases
+// all references to it, including persistent references.
+// Returns false if the key was not found.
+func (c *Controller) RemoveController(key string) bool {
+	return c.stopController(key, true)
+}
+
+// stopController stops the controller with the configset key.
+func (c *Controller) stopController(key string, releasePersistent bool) bool {
+	c.mtx.Lock()
+	rc, ok := c.controllers[key]
+	var persistent []*runningControllerRef
+	if ok {
+		for _, pref := range c.persistentRefs {
+			if pref.id == key {
+				persistent = append(persistent, pref)
+			}
+		}
+	}
+	c.mtx.Unlock()
+	if ok {
+		rc.Stop(persistent, releasePersistent)
+	}
+	return ok
+}
+
 // AddConfigSetReference adds a persistent reference to a config set which will
 // be re-applied across iterations. This reference type will wait until a
 // ApplyConfigSet specifies the configuration for the controller, and will add
@@ -203,6 +315,58 @@ func (c *Controller) resolveLookupConfigSet(
 	return directive.Resolvers(newLookupConfigSetResolver(c, ctx, di, dir))
 }
 
+// checkDependencies checks if the dependencies of the config for the key are
+// running, and ready if the controller reports readiness.
+//
+// Returns the dependency cycle if one was found, otherwise returns the key of
+// the first dependency which is not running yet, or empty if all are running.
+func (c *Controller) checkDependencies(
+	key string,
+	conf configset.ControllerConfig,
+) ([]string, string) {
+	dependsOn := conf.GetDependsOn()
+	if len(dependsOn) == 0 {
+		return nil, ""
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	cycle := configset.FindDependencyCycle([]string{key}, func(k string) []string {
+		if k == key {
+			return dependsOn
+		}
+		if rc := c.controllers[k]; rc != nil {
+			return rc.getControllerConfig().GetDependsOn()
+		}
+		return nil
+	})
+	if cycle != nil {
+		return cycle, ""
+	}
+
+	for _, dep := range dependsOn {
+		rc := c.controllers[dep]
+		if rc == nil {
+			return nil, dep
+		}
+		rc.mtx.Lock()
+		st := rc.state
+		rc.mtx.Unlock()
+		if !st.isRunning() {
+			return nil, dep
+		}
+	}
+	return nil, ""
+}
+
+// broadcast wakes the running controllers waiting for dependencies.
+func (c *Controller) broadcast() {
+	c.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+		broadcast()
+	})
+}
+
 // wake wakes the controller
 func (c *Controller) wake() {
 	select {
@@ -0,0 +1,60 @@
+package configset_controller
+
+import (
+	"context"
+	"slices"
+)
+
+// execController is a running controller executing in a goroutine.
+type execController struct {
+	// rc is the running controller
+	rc *runningController
+	// cancel cancels the running controller context
+	cancel context.CancelFunc
+	// exited is closed when the running controller exits
+	exited chan struct{}
+	// canceled indicates cancel was called
+	canceled bool
+}
+
+// stopExecControllers cancels the removed controllers once the removed
+// controllers which depend on them have exited.
+//
+// If the remaining controllers depend on each other in a cycle, they are all
+// canceled. Returns the controllers which have not exited yet.
+func stopExecControllers(stopping []*execController) []*execController {
+	stopping = slices.DeleteFunc(stopping, func(ex *execController) bool {
+		select {
+		case <-ex.exited:
+			return true
+		default:
+			return false
+		}
+	})
+
+	// hasDependents checks if another removed controller depends on ex.
+	hasDependents := func(ex *execController) bool {
+		for _, other := range stopping {
+			if other != ex && slices.Contains(other.rc.getControllerConfig().GetDependsOn(), ex.rc.key) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var anyCanceled bool
+	for _, ex := range stopping {
+		if !ex.canceled && !hasDependents(ex) {
+			ex.cancel()
+			ex.canceled = true
+		}
+		anyCanceled = anyCanceled || ex.canceled
+	}
+	if !anyCanceled {
+		for _, ex := range stopping {
+			ex.cancel()
+			ex.canceled = true
+		}
+	}
+	return stopping
+}
@@ -42,9 +42,7 @@ func (t *Factory) Construct(
 ) (controller.Controller, error) {
 	le := opts.GetLogger()
 	cc := conf.(*Config)
-
-	_ = cc
-	return NewController(le, t.bus)
+	return NewControllerWithConfig(le, t.bus, cc)
 }
 
 // GetVersion returns the version of this controller.
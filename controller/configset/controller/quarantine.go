@@ -0,0 +1,62 @@
+package configset_controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+)
+
+// exitCounter counts the controller exits with an error within a window.
+type exitCounter struct {
+	// maxExits is the number of exits within the window to quarantine after
+	// if zero, the controller is never quarantined
+	maxExits uint32
+	// window is the window to count exits within
+	// if zero, all exits are counted
+	window time.Duration
+	// exits contains the timestamps of the exits within the window
+	exits []time.Time
+}
+
+// newExitCounter constructs an exitCounter for the controller config.
+func newExitCounter(conf *Config) *exitCounter {
+	// the config is validated when constructing the controller
+	window, _ := conf.ParseQuarantineWindowDur()
+	return &exitCounter{
+		maxExits: conf.GetQuarantineExits(),
+		window:   window,
+	}
+}
+
+// add records an exit at the given time.
+// returns true if the controller should be quarantined.
+func (e *exitCounter) add(now time.Time) bool {
+	if e.maxExits == 0 {
+		return false
+	}
+	if e.window != 0 {
+		cutoff := now.Add(-e.window)
+		var i int
+		for i < len(e.exits) && !e.exits[i].After(cutoff) {
+			i++
+		}
+		e.exits = e.exits[i:]
+	}
+	e.exits = append(e.exits, now)
+	return uint32(len(e.exits)) >= e.maxExits
+}
+
+// reset clears the recorded exits.
+func (e *exitCounter) reset() {
+	e.exits = nil
+}
+
+// errQuarantined builds the state error of a quarantined controller.
+func (e *exitCounter) errQuarantined(lastErr error) error {
+	msg := fmt.Sprintf("%d exits", len(e.exits))
+	if e.window != 0 {
+		msg += " within " + e.window.String()
+	}
+	return fmt.Errorf("%w after %s: %w", configset.ErrControllerQuarantined, msg, lastErr)
+}
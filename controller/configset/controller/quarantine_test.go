@@ -0,0 +1,106 @@
+package configset_controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	controller_exec "github.com/aperturerobotics/controllerbus/controller/exec"
+	controller_mock "github.com/aperturerobotics/controllerbus/controller/mock"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestQuarantine tests quarantining a controller which keeps exiting with an error.
+func TestQuarantine(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	factory := &controller_mock.MockFactory{}
+	sr.AddFactory(factory)
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{
+			QuarantineExits:     3,
+			QuarantineWindowDur: "1m",
+		}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	// waitState waits for the state of the key to match.
+	waitState := func(desc string, match func(st configset.State) bool) configset.State {
+		for {
+			states := csCtrl.GetControllerStates()
+			if len(states) == 1 && match(states[0]) {
+				return states[0]
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected %s", desc)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	isQuarantined := func(st configset.State) bool { return st.GetQuarantined() }
+
+	applier := configset.NewApplier(b)
+	defer applier.Release()
+	if _, err := applier.Apply(configset.ConfigSet{
+		"key": configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: controller_mock.FailName}),
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	st := waitState("controller to be quarantined", isQuarantined)
+	if !errors.Is(st.GetError(), configset.ErrControllerQuarantined) || !errors.Is(st.GetError(), controller_mock.ErrMockExit) {
+		t.Fatalf("unexpected quarantined error: %v", st.GetError())
+	}
+	if status := controller_exec.NewExecControllerResponse(st).GetStatus(); status != controller_exec.ControllerStatus_ControllerStatus_QUARANTINED {
+		t.Fatalf("unexpected status: %v", status)
+	}
+	execs := factory.GetExecs()
+	if execs != 3 {
+		t.Fatalf("expected 3 execs before quarantine but got %d", execs)
+	}
+	<-time.After(300 * time.Millisecond)
+	if n := factory.GetExecs(); n != execs {
+		t.Fatalf("expected quarantined controller not to be retried: %d execs", n)
+	}
+
+	// restarting clears the quarantine
+	if !csCtrl.RestartController("key") {
+		t.Fatal("expected to restart the controller")
+	}
+	waitState("controller to be quarantined again", func(st configset.State) bool {
+		return st.GetQuarantined() && factory.GetExecs() == 2*execs
+	})
+
+	// a new config clears the quarantine
+	if _, err := applier.Apply(configset.ConfigSet{
+		"key": configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: "ok"}),
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+	waitState("controller to run with the new config", func(st configset.State) bool {
+		return st.GetController() != nil && st.GetError() == nil && !st.GetQuarantined()
+	})
+}
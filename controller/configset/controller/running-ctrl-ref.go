@@ -50,9 +50,8 @@ func (r *runningControllerRef) GetState() configset.State {
 func (r *runningControllerRef) AddStateCallback(cb func(configset.State)) {
 	r.mtx.Lock()
 	r.cbs = append(r.cbs, cb)
-	rc := r.rc
 	r.mtx.Unlock()
-	cb(rc.GetState())
+	cb(r.GetState())
 }
 
 // GetRunningController gets the running controller.
@@ -91,8 +90,8 @@ func (r *runningControllerRef) pushState(st configset.State) {
 // Release releases the reference.
 func (r *runningControllerRef) Release() {
 	r.relOnce.Do(func() {
-		if r.rc != nil {
-			r.rc.DelReference(r)
+		if rc := r.GetRunningController(); rc != nil {
+			rc.DelReference(r)
 		}
 		if cb := r.relInternalCallback; cb != nil {
 			go r.relInternalCallback()
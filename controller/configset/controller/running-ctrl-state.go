@@ -3,6 +3,7 @@ package configset_controller
 import (
 	"github.com/aperturerobotics/controllerbus/controller"
 	"github.com/aperturerobotics/controllerbus/controller/configset"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
 )
 
 // runningControllerState implements configset state
@@ -11,6 +12,12 @@ type runningControllerState struct {
 	err  error
 	conf configset.ControllerConfig
 	ctrl controller.Controller
+	// ready indicates the controller reported it is ready
+	ready bool
+	// quarantined indicates the controller was quarantined
+	quarantined bool
+	// val is the most recent value of the exec controller directive
+	val resolver.LoadControllerWithConfigValue
 }
 
 // GetId returns the controller id.
@@ -29,11 +36,40 @@ func (s *runningControllerState) GetController() controller.Controller {
 	return s.ctrl
 }
 
+// GetReady returns if the running controller reported it is ready.
+func (s *runningControllerState) GetReady() bool {
+	return s.ready
+}
+
+// GetQuarantined returns if the controller was quarantined after exiting with
+// an error too many times.
+func (s *runningControllerState) GetQuarantined() bool {
+	return s.quarantined
+}
+
 // GetError returns any error processing the controller config.
 func (s *runningControllerState) GetError() error {
 	return s.err
 }
 
+// isRunning checks if the controller is running, and ready if the controller
+// reports readiness.
+func (s *runningControllerState) isRunning() bool {
+	if s.ctrl == nil {
+		return false
+	}
+	if _, ok := s.ctrl.(controller.ControllerWithReady); ok {
+		return s.ready
+	}
+	return true
+}
+
+// GetExecValue returns the most recent value of the directive executing the
+// controller, if any.
+func (s *runningControllerState) GetExecValue() resolver.LoadControllerWithConfigValue {
+	return s.val
+}
+
 // Equals checks if the two states are equal.
 func (s *runningControllerState) Equals(other *runningControllerState) bool {
 	switch {
@@ -41,6 +77,9 @@ func (s *runningControllerState) Equals(other *runningControllerState) bool {
 	case s.err != other.err:
 	case s.conf != other.conf:
 	case s.ctrl != other.ctrl:
+	case s.ready != other.ready:
+	case s.quarantined != other.quarantined:
+	case s.val != other.val:
 	default:
 		return true
 	}
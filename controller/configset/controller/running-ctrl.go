@@ -2,11 +2,15 @@ package configset_controller
 
 import (
 	"context"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
 	"github.com/aperturerobotics/controllerbus/controller/configset"
 	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/directive"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
@@ -56,10 +60,15 @@ func (c *runningController) GetConfigKey() string {
 }
 
 // Execute actuates the running controller.
-func (c *runningController) Execute(ctx context.Context) (rerr error) {
+//
+// Once ctx is canceled, waits for the controller to be removed from the bus
+// before returning, or until stopCtx is canceled.
+func (c *runningController) Execute(ctx, stopCtx context.Context) (rerr error) {
 	c.mtx.Lock()
 	conf := c.conf
 	c.mtx.Unlock()
+	// exits counts the exits with an error since the config was applied
+	exits := newExitCounter(c.c.conf)
 	for {
 		if err := ctx.Err(); err != nil {
 			return err
@@ -68,6 +77,9 @@ func (c *runningController) Execute(ctx context.Context) (rerr error) {
 		clearState := func(err error) {
 			c.mtx.Lock()
 			c.state.ctrl = nil
+			c.state.ready = false
+			c.state.val = nil
+			c.state.quarantined = false
 			c.state.err = err
 			c.state.conf = conf
 			s := c.state
@@ -79,7 +91,12 @@ func (c *runningController) Execute(ctx context.Context) (rerr error) {
 		ctrlConf := conf.GetConfig()
 
 		// execute the controller with the current config
-		execDir := resolver.NewLoadControllerWithConfig(ctrlConf)
+		execDir := resolver.NewLoadControllerWithConfigAndRestartPolicy(
+			ctrlConf,
+			directive.ValueOptions{},
+			nil,
+			conf.GetRestartPolicy(),
+		)
 		if err := execDir.Validate(); err != nil {
 			// mark config error and wait for config to change
 			c.le.WithError(err).Warn("controller config is invalid")
@@ -90,6 +107,17 @@ func (c *runningController) Execute(ctx context.Context) (rerr error) {
 			case <-c.confRestartCh:
 				c.le.Info("restarting with new config")
 			}
+			exits.reset()
+			continue
+		}
+
+		// wait for the dependencies to be running
+		if ok, err := c.waitDependencies(ctx, conf, clearState); !ok {
+			if err != nil {
+				return err
+			}
+			exits.reset()
+			conf = c.getControllerConfig()
 			continue
 		}
 
@@ -112,12 +140,30 @@ func (c *runningController) Execute(ctx context.Context) (rerr error) {
 			})
 		}
 
+		// updatedCtrl is the controller the config was updated in place for
+		var updatedCtrl controller.Controller
+		// lastExit is the last value counted as an exit
+		var lastExit resolver.LoadControllerWithConfigValue
+		// quarantineErr is set if the controller was quarantined
+		var quarantineErr error
 	RecheckStateLoop:
 		for {
 			select {
 			case <-ctx.Done():
 				break RecheckStateLoop
 			case <-c.confRestartCh:
+				exits.reset()
+				nconf := c.getControllerConfig()
+				if uctrl := c.updateConfig(ctx, conf, nconf); uctrl != nil {
+					c.le.Info("updated controller config in place")
+					conf, updatedCtrl = nconf, uctrl
+					c.mtx.Lock()
+					c.state.conf = conf
+					st := c.state
+					c.pushState(&st)
+					c.mtx.Unlock()
+					continue RecheckStateLoop
+				}
 				c.le.Info("restarting with new config")
 				break RecheckStateLoop
 			case <-disposed:
@@ -128,34 +174,162 @@ func (c *runningController) Execute(ctx context.Context) (rerr error) {
 					continue RecheckStateLoop
 				}
 				uval := aval.GetValue()
-				c.mtx.Lock()
+				// the loader would re-construct the controller with the old config
+				if updatedCtrl != nil && uval.GetController() != updatedCtrl {
+					c.le.Info("restarting with new config")
+					break RecheckStateLoop
+				}
 				uerr := uval.GetError()
+				// count the exits with an error including retries by the loader
+				if uerr != nil && uval.GetController() == nil && uval != lastExit {
+					lastExit = uval
+					if exits.add(time.Now()) {
+						quarantineErr = exits.errQuarantined(uerr)
+						break RecheckStateLoop
+					}
+				}
+				c.mtx.Lock()
 				if uerr != nil && uerr != c.state.err {
 					c.le.WithError(uerr).Warn("controller error")
 				}
 				c.state.err = uerr
 				c.state.ctrl = uval.GetController()
+				c.state.ready = uval.GetReady()
+				c.state.val = uval
 				c.state.conf = conf
 				st := c.state
 				c.pushState(&st)
 				c.mtx.Unlock()
 			}
 		}
+		c.mtx.Lock()
+		ctrl := c.state.ctrl
+		c.mtx.Unlock()
 		if execRef != nil {
 			execRef.Release()
 		}
 		if disposeCb != nil {
 			disposeCb()
 		}
+		if ctrl != nil && ctx.Err() != nil {
+			_ = bus.WaitControllerRemoved(stopCtx, c.c.bus, ctrl)
+		}
 		c.mtx.Lock()
 		conf = c.conf
 		if c.state.ctrl != nil || c.state.conf != conf {
 			c.state.ctrl = nil
+			c.state.ready = false
+			c.state.val = nil
 			c.state.conf = conf
 			s := c.state
 			c.pushState(&s)
 		}
 		c.mtx.Unlock()
+
+		// wait for the config to change or a restart if quarantined
+		if quarantineErr != nil {
+			c.le.WithError(quarantineErr).Warn("controller quarantined")
+			c.mtx.Lock()
+			c.state.err = quarantineErr
+			c.state.quarantined = true
+			c.state.val = lastExit
+			st := c.state
+			c.pushState(&st)
+			c.mtx.Unlock()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-c.confRestartCh:
+				c.le.Info("restarting quarantined controller")
+			}
+			exits.reset()
+			conf = c.getControllerConfig()
+		}
+	}
+}
+
+// updateConfig tries to apply the next config to the running controller
+// without restarting it.
+//
+// Returns the controller if it applied the config, or nil if the controller
+// must be restarted with the next config.
+func (c *runningController) updateConfig(
+	ctx context.Context,
+	prev, next configset.ControllerConfig,
+) controller.Controller {
+	if prev == next {
+		return nil
+	}
+	c.mtx.Lock()
+	ctrl := c.state.ctrl
+	c.mtx.Unlock()
+	upd, ok := ctrl.(controller.ControllerWithConfigUpdate)
+	if !ok {
+		return nil
+	}
+
+	nextConf := next.GetConfig()
+	if nextConf == nil ||
+		nextConf.GetConfigID() != prev.GetConfig().GetConfigID() ||
+		!next.GetRestartPolicy().EqualVT(prev.GetRestartPolicy()) ||
+		nextConf.Validate() != nil {
+		return nil
+	}
+	applied, err := upd.UpdateConfig(ctx, nextConf)
+	if err != nil {
+		c.le.WithError(err).Warn("unable to update controller config in place")
+		return nil
+	}
+	if !applied {
+		return nil
+	}
+	return ctrl
+}
+
+// waitDependencies waits for the controllers the config depends on to be
+// running, updating the state while waiting.
+//
+// Returns false if the controller should be restarted with a new config.
+func (c *runningController) waitDependencies(
+	ctx context.Context,
+	conf configset.ControllerConfig,
+	clearState func(err error),
+) (bool, error) {
+	var cycleErr *configset.DependencyCycleError
+	var prevWaiting string
+	for {
+		var waitCh <-chan struct{}
+		c.c.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
+			waitCh = getWaitCh()
+		})
+
+		cycle, waiting := c.c.checkDependencies(c.key, conf)
+		switch {
+		case cycle != nil:
+			if cycleErr == nil || !slices.Equal(cycleErr.Cycle, cycle) {
+				cycleErr = &configset.DependencyCycleError{Cycle: cycle}
+				c.le.WithError(cycleErr).Warn("controller config is invalid")
+				clearState(cycleErr)
+			}
+		case waiting != "":
+			if cycleErr != nil || waiting != prevWaiting {
+				cycleErr = nil
+				c.le.WithField("depends-on", waiting).Debug("waiting for dependency")
+				clearState(nil)
+			}
+		default:
+			return true, nil
+		}
+		prevWaiting = waiting
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-c.confRestartCh:
+			c.le.Info("restarting with new config")
+			return false, nil
+		case <-waitCh:
+		}
 	}
 }
 
@@ -165,6 +339,14 @@ func (c *runningController) GetControllerConfig() configset.ControllerConfig {
 	return c.conf
 }
 
+// getControllerConfig returns the controller config while locking mtx.
+func (c *runningController) getControllerConfig() configset.ControllerConfig {
+	c.mtx.Lock()
+	conf := c.conf
+	c.mtx.Unlock()
+	return conf
+}
+
 // GetState returns the current state object.
 func (c *runningController) GetState() configset.State {
 	c.mtx.Lock()
@@ -223,11 +405,43 @@ func (c *runningController) ApplyConfig(conf configset.ControllerConfig) bool {
 	}
 
 	c.conf = conf
+	c.Restart()
+	return true
+}
+
+// Restart restarts the controller with the current config.
+func (c *runningController) Restart() {
 	select {
 	case c.confRestartCh <- struct{}{}:
 	default:
 	}
-	return true
+}
+
+// Stop marks the controller as stopped and releases the references.
+//
+// The persistent references are detached instead of released unless
+// releasePersistent is set. The controller is garbage collected once no
+// references remain.
+func (c *runningController) Stop(persistent []*runningControllerRef, releasePersistent bool) {
+	c.mtx.Lock()
+	c.state.ctrl = nil
+	c.state.ready = false
+	c.state.val = nil
+	c.state.quarantined = false
+	c.state.err = configset.ErrControllerStopped
+	st := c.state
+	c.pushState(&st)
+	refs := slices.Clone(c.refs)
+	c.mtx.Unlock()
+
+	for _, ref := range refs {
+		if !releasePersistent && slices.Contains(persistent, ref) {
+			c.DelReference(ref)
+			ref.setRunningController(nil, &st)
+			continue
+		}
+		ref.Release()
+	}
 }
 
 // pushState pushes the new state to all references
@@ -241,6 +455,7 @@ func (c *runningController) pushState(st *runningControllerState) {
 	for _, ref := range c.refs {
 		ref.pushState(st)
 	}
+	c.c.broadcast()
 }
 
 // _ is a type assertion
@@ -0,0 +1,81 @@
+package configset_controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	controller_mock "github.com/aperturerobotics/controllerbus/controller/mock"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestUpdateConfig tests applying a config change without restarting the controller.
+func TestUpdateConfig(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// the config is updated in place unless the new name is "restart"
+	factory := &controller_mock.MockFactory{
+		UpdateConfigFn: func(c *controller_mock.MockController, name string) bool {
+			return name != "restart"
+		},
+	}
+	sr.AddFactory(factory)
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	// apply applies the name and waits for a controller running with it.
+	applier := configset.NewApplier(b)
+	defer applier.Release()
+	apply := func(name string) *controller_mock.MockController {
+		cs := configset.ConfigSet{"update": configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: name})}
+		if _, err := applier.Apply(cs); err != nil {
+			t.Fatal(err.Error())
+		}
+		for {
+			states := csCtrl.GetControllerStates()
+			if len(states) == 1 {
+				ctrl, _ := states[0].GetController().(*controller_mock.MockController)
+				conf, _ := states[0].GetControllerConfig().GetConfig().(*boilerplate.Config)
+				if ctrl != nil && ctrl.GetName() == name && conf.GetExampleField() == name {
+					return ctrl
+				}
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected controller running with %s", name)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	ctrl := apply("first")
+	if updated := apply("second"); updated != ctrl || factory.GetConstructs() != 1 {
+		t.Fatalf("expected config to be updated in place: constructs %d", factory.GetConstructs())
+	}
+	if restarted := apply("restart"); restarted == ctrl || factory.GetConstructs() != 2 {
+		t.Fatalf("expected controller to be restarted: constructs %d", factory.GetConstructs())
+	}
+}
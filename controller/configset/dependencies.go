@@ -0,0 +1,88 @@
+package configset
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// DependencyCycleError is the error for configs which depend on each other.
+type DependencyCycleError struct {
+	// Cycle contains the keys in the cycle starting and ending with the same key.
+	Cycle []string
+}
+
+// Error returns the error string.
+func (e *DependencyCycleError) Error() string {
+	return "dependency cycle: " + strings.Join(e.Cycle, " -> ")
+}
+
+// ValidateDependsOn validates the list of keys a controller depends on.
+func ValidateDependsOn(dependsOn []string) error {
+	for i, key := range dependsOn {
+		if key == "" {
+			return errors.New("dependency key cannot be empty")
+		}
+		if slices.Contains(dependsOn[:i], key) {
+			return errors.New("duplicate dependency key: " + key)
+		}
+	}
+	return nil
+}
+
+// FindDependencyCycle searches for a dependency cycle reachable from the keys.
+//
+// getDependsOn returns the keys a key depends on. Keys are visited in order.
+// Returns the keys in the cycle starting and ending with the same key, or nil.
+func FindDependencyCycle(keys []string, getDependsOn func(key string) []string) []string {
+	// done contains keys with no cycle reachable from them
+	done := make(map[string]struct{})
+	// path is the current path from the starting key
+	var path []string
+	var visit func(key string) []string
+	visit = func(key string) []string {
+		if idx := slices.Index(path, key); idx != -1 {
+			return append(slices.Clone(path[idx:]), key)
+		}
+		if _, ok := done[key]; ok {
+			return nil
+		}
+		path = append(path, key)
+		for _, dep := range getDependsOn(key) {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		done[key] = struct{}{}
+		return nil
+	}
+	for _, key := range keys {
+		if cycle := visit(key); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// CheckDependencies checks the configs in the configset for dependency cycles.
+//
+// Dependencies which are not in the configset are ignored. Returns a
+// *DependencyCycleError if a cycle was found.
+func (c ConfigSet) CheckDependencies() error {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	cycle := FindDependencyCycle(keys, func(key string) []string {
+		if conf := c[key]; conf != nil {
+			return conf.GetDependsOn()
+		}
+		return nil
+	})
+	if cycle != nil {
+		return &DependencyCycleError{Cycle: cycle}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package configset_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	controller_mock "github.com/aperturerobotics/controllerbus/controller/mock"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestFindDependencyCycle tests detecting dependency cycles.
+func TestFindDependencyCycle(t *testing.T) {
+	deps := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"b"},
+		"d": {"missing"},
+	}
+	getDependsOn := func(key string) []string { return deps[key] }
+	if cycle := configset.FindDependencyCycle([]string{"a"}, getDependsOn); !slices.Equal(cycle, []string{"b", "c", "b"}) {
+		t.Fatalf("unexpected cycle: %v", cycle)
+	}
+	if cycle := configset.FindDependencyCycle([]string{"d"}, getDependsOn); cycle != nil {
+		t.Fatalf("unexpected cycle: %v", cycle)
+	}
+
+	cs := configset.ConfigSet{
+		"a": configset.NewControllerConfigWithOpts(1, &boilerplate.Config{}, nil, []string{"b"}),
+		"b": configset.NewControllerConfigWithOpts(1, &boilerplate.Config{}, nil, []string{"a"}),
+	}
+	var cycleErr *configset.DependencyCycleError
+	if err := cs.CheckDependencies(); !errors.As(err, &cycleErr) || !slices.Equal(cycleErr.Cycle, []string{"a", "b", "a"}) {
+		t.Fatalf("expected dependency cycle error but got %v", err)
+	}
+
+	if err := configset.ValidateDependsOn([]string{"a", "a"}); err == nil {
+		t.Fatal("expected duplicate dependency to fail validation")
+	}
+}
+
+// TestDependsOn tests starting and stopping configs in dependency order.
+func TestDependsOn(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	factory := &controller_mock.MockFactory{}
+	sr.AddFactory(factory)
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	// getRunning returns the keys of the running controllers.
+	getRunning := func() []string {
+		var running []string
+		for _, st := range csCtrl.GetControllerStates() {
+			if st.GetController() != nil {
+				running = append(running, st.GetId())
+			}
+		}
+		return running
+	}
+	// waitRunning waits for the keys of the running controllers to match.
+	waitRunning := func(keys ...string) {
+		for !slices.Equal(getRunning(), keys) {
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected running %v but got %v", keys, getRunning())
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	app := configset.NewControllerConfigWithOpts(1, &boilerplate.Config{ExampleField: "app"}, nil, []string{"db"})
+	db := configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: "db"})
+
+	// the dependent waits for the dependency to be applied
+	applier := configset.NewApplier(b)
+	if _, err := applier.Apply(configset.ConfigSet{"app": app}); err != nil {
+		t.Fatal(err.Error())
+	}
+	<-time.After(50 * time.Millisecond)
+	if running := getRunning(); len(running) != 0 {
+		t.Fatalf("expected app to wait for db but got %v", running)
+	}
+	if _, err := applier.Apply(configset.ConfigSet{"app": app, "db": db}); err != nil {
+		t.Fatal(err.Error())
+	}
+	waitRunning("app", "db")
+
+	// cycles are rejected without applying any changes
+	cycle := configset.ConfigSet{
+		"app": app,
+		"db":  configset.NewControllerConfigWithOpts(1, &boilerplate.Config{ExampleField: "db"}, nil, []string{"app"}),
+	}
+	var cycleErr *configset.DependencyCycleError
+	if _, err := applier.Apply(cycle); !errors.As(err, &cycleErr) {
+		t.Fatalf("expected dependency cycle error but got %v", err)
+	}
+
+	// dependents are shut down before their dependencies
+	if failed := applier.Shutdown(ctx); len(failed) != 0 {
+		t.Fatalf("unexpected failed controllers: %v", failed)
+	}
+	if exited := factory.TakeExited(); !slices.Equal(exited, []string{"app", "db"}) {
+		t.Fatalf("expected dependent to stop first but got %v", exited)
+	}
+
+	// dependents are stopped first when a configset is released
+	_, ref, err := b.AddDirective(configset.NewApplyConfigSet(configset.ConfigSet{"app": app, "db": db}), nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	waitRunning("app", "db")
+	ref.Release()
+	for len(csCtrl.GetControllerStates()) != 0 {
+		select {
+		case <-ctx.Done():
+			t.Fatal(ctx.Err().Error())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	for len(factory.GetExited()) != 2 {
+		select {
+		case <-ctx.Done():
+			t.Fatal(ctx.Err().Error())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	if exited := factory.TakeExited(); !slices.Equal(exited, []string{"app", "db"}) {
+		t.Fatalf("expected dependent to stop first but got %v", exited)
+	}
+}
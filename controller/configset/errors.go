@@ -0,0 +1,19 @@
+package configset
+
+import "errors"
+
+// ErrControllerStopped is the state error of a controller stopped remotely.
+var ErrControllerStopped = errors.New("controller was stopped")
+
+// ErrControllerQuarantined is the state error of a controller which exited
+// with an error too many times and will not be restarted until the config
+// changes or the controller is restarted remotely.
+var ErrControllerQuarantined = errors.New("controller was quarantined")
+
+// ErrApplyRolledBack is returned by Applier.ApplyTransactional if a controller
+// failed to start and the previous configs were restored.
+var ErrApplyRolledBack = errors.New("configset apply was rolled back")
+
+// ErrApplyAborted is the error of a key which had not started yet when a
+// transactional apply was rolled back due to another key.
+var ErrApplyAborted = errors.New("apply was aborted before the controller started")
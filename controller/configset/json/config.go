@@ -28,16 +28,28 @@ func NewConfigWithJSON(data string) *Config {
 }
 
 // Resolve constructs the underlying config from the pending parse data.
+//
+// Waits for a factory with the config id to be available.
 func (c *Config) Resolve(ctx context.Context, configID string, b bus.Bus) error {
+	return c.resolve(ctx, configID, b, nil)
+}
+
+// resolve constructs the underlying config from the pending parse data.
+//
+// Returns ErrUnknownConfigID if the lookup becomes idle without a value.
+func (c *Config) resolve(ctx context.Context, configID string, b bus.Bus, idleCb bus.ExecIdleCallback) error {
 	if c == nil {
 		return errors.New("cannot resolve nil config")
 	}
 
 	configCtorDir := resolver.NewLoadConfigConstructorByID(configID)
-	configCtorVal, _, configCtorRef, err := bus.ExecOneOff(ctx, b, configCtorDir, nil, nil)
+	configCtorVal, _, configCtorRef, err := bus.ExecOneOff(ctx, b, configCtorDir, idleCb, nil)
 	if err != nil {
 		return errors.WithMessage(err, "resolve config object")
 	}
+	if configCtorVal == nil {
+		return errors.Wrap(ErrUnknownConfigID, configID)
+	}
 	defer configCtorRef.Release()
 
 	ctor, ctorOk := configCtorVal.GetValue().(config.Constructor)
@@ -49,7 +61,7 @@ func (c *Config) Resolve(ctx context.Context, configID string, b bus.Bus) error
 		return errors.New("load config constructor returned nil object")
 	}
 	if err := c.underlying.UnmarshalJSON([]byte(c.pendingParseData)); err != nil {
-		return err
+		return errors.Wrap(err, "decode config")
 	}
 	c.pendingParseData = ""
 	return nil
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aperturerobotics/controllerbus/bus"
 	"github.com/aperturerobotics/controllerbus/controller/configset"
+	"github.com/aperturerobotics/controllerbus/controller/loader"
 	cbyaml "github.com/aperturerobotics/controllerbus/yaml"
 )
 
@@ -18,6 +19,10 @@ type ControllerConfig struct {
 	Id string `json:"id"`
 	// Config is the configuration object.
 	Config *Config `json:"config,omitempty"`
+	// RestartPolicy is the policy for restarting the controller.
+	RestartPolicy *loader.RestartPolicy `json:"restartPolicy,omitempty"`
+	// DependsOn contains the configset keys the controller depends on.
+	DependsOn []string `json:"dependsOn,omitempty"`
 }
 
 // NewControllerConfig builds a new controller config.
@@ -28,6 +33,8 @@ func NewControllerConfig(c configset.ControllerConfig) *ControllerConfig {
 		Config: &Config{
 			underlying: c.GetConfig(),
 		},
+		RestartPolicy: c.GetRestartPolicy(),
+		DependsOn:     c.GetDependsOn(),
 	}
 }
 
@@ -69,5 +76,5 @@ func (c *ControllerConfig) Resolve(ctx context.Context, b bus.Bus) (configset.Co
 		return nil, errors.New("config cannot be nil")
 	}
 
-	return configset.NewControllerConfig(c.Rev, conf), nil
+	return configset.NewControllerConfigWithOpts(c.Rev, conf, c.RestartPolicy, c.DependsOn), nil
 }
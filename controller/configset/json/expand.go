@@ -0,0 +1,302 @@
+package configset_json
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	cbyaml "github.com/aperturerobotics/controllerbus/yaml"
+)
+
+// VarsKey is the top-level key containing the configset variables.
+//
+// The key is reserved and removed from the configset when expanding.
+const VarsKey = "vars"
+
+// ErrUnsetVariable is returned if a referenced variable is not set and has no default.
+var ErrUnsetVariable = errors.New("variable is not set")
+
+// ExpandOpts are options for expanding the variable references in a configset.
+type ExpandOpts struct {
+	// Dir is the directory relative ${file:path} references are resolved against.
+	// If empty, uses the working directory.
+	Dir string
+	// LookupEnv looks up an environment variable.
+	// If nil, uses os.LookupEnv.
+	LookupEnv func(key string) (string, bool)
+}
+
+// ExpandError is an error expanding a variable reference in a configset.
+type ExpandError struct {
+	// Path is the path to the value containing the reference, ex: key.config.field
+	Path string
+	// Ref is the reference, ex: ${FOO}
+	Ref string
+	// Err is the error expanding the reference.
+	Err error
+}
+
+// Error returns the error string prefixed with the path and reference.
+func (e *ExpandError) Error() string {
+	return e.Path + ": " + e.Ref + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error expanding the reference.
+func (e *ExpandError) Unwrap() error {
+	return e.Err
+}
+
+// ExpandConfigSetYAML expands the variable references in the string values
+// of a yaml configset and returns the expanded configset as json.
+//
+// References have the following forms:
+//
+//	${NAME}          the configset variable or environment variable NAME
+//	${NAME:-default} as above, or default if unset or empty
+//	${file:path}     the contents of the file with trailing newlines trimmed
+//	$${              a literal ${
+//
+// Configset variables are defined in a top-level VarsKey map and take
+// precedence over environment variables. Variables can reference other
+// variables. Returns the ExpandError for each unresolved reference joined.
+//
+// Expanded values are strings, except a value consisting of a single reference
+// which expands to true or false becomes a bool. Numeric fields accept strings.
+func ExpandConfigSetYAML(data []byte, opts *ExpandOpts) ([]byte, error) {
+	jdat, err := cbyaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(jdat))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return jdat, nil
+	}
+	m, ok := doc.(map[string]any)
+	if !ok {
+		return nil, errors.New("configset must be a map")
+	}
+
+	e := &expander{opts: opts, vars: make(map[string]string), resolved: make(map[string]string)}
+	if opts == nil {
+		e.opts = &ExpandOpts{}
+	}
+	if vars, ok := m[VarsKey]; ok {
+		varsMap, ok := vars.(map[string]any)
+		if vars != nil && !ok {
+			return nil, errors.New(VarsKey + " must be a map")
+		}
+		for name, val := range varsMap {
+			if !isVarName(name) {
+				return nil, errors.New(VarsKey + ": invalid variable name: " + strconv.Quote(name))
+			}
+			switch v := val.(type) {
+			case string:
+				e.vars[name] = v
+			case json.Number:
+				e.vars[name] = v.String()
+			case bool:
+				e.vars[name] = strconv.FormatBool(v)
+			case nil:
+				e.vars[name] = ""
+			default:
+				return nil, errors.New(VarsKey + "." + name + ": variable must be a scalar")
+			}
+		}
+		delete(m, VarsKey)
+
+		// expand all variables to report errors in unused variables
+		names := make([]string, 0, len(e.vars))
+		for name := range e.vars {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			_, _ = e.lookupVar(name, nil)
+		}
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		m[key] = e.expandValue(key, m[key])
+	}
+	if len(e.errs) != 0 {
+		slices.SortStableFunc(e.errs, func(a, b *ExpandError) int {
+			return strings.Compare(a.Path, b.Path)
+		})
+		errs := make([]error, len(e.errs))
+		for i, expandErr := range e.errs {
+			errs[i] = expandErr
+		}
+		return nil, errors.Join(errs...)
+	}
+	return json.Marshal(m)
+}
+
+// expander expands the references in a configset.
+type expander struct {
+	// opts are the expand options
+	opts *ExpandOpts
+	// vars contains the unexpanded configset variables
+	vars map[string]string
+	// resolved contains the expanded configset variables
+	resolved map[string]string
+	// errs contains the errors expanding references
+	errs []*ExpandError
+}
+
+// expandValue expands the references in the string values of val.
+func (e *expander) expandValue(path string, val any) any {
+	switch v := val.(type) {
+	case string:
+		expanded := e.expandString(path, v, nil)
+		if isSingleRef(v) && (expanded == "true" || expanded == "false") {
+			return expanded == "true"
+		}
+		return expanded
+	case map[string]any:
+		for key, elem := range v {
+			v[key] = e.expandValue(path+"."+key, elem)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = e.expandValue(path+"["+strconv.Itoa(i)+"]", elem)
+		}
+	}
+	return val
+}
+
+// expandString expands the references in the string value at path.
+//
+// stack contains the variables being expanded to detect cycles.
+func (e *expander) expandString(path, val string, stack []string) string {
+	if !strings.Contains(val, "${") {
+		return val
+	}
+	var out strings.Builder
+	for {
+		idx := strings.Index(val, "${")
+		if idx < 0 {
+			break
+		}
+		if idx != 0 && val[idx-1] == '$' {
+			// $${ is an escaped ${
+			out.WriteString(val[:idx-1])
+			out.WriteString("${")
+			val = val[idx+2:]
+			continue
+		}
+		out.WriteString(val[:idx])
+		end := strings.IndexByte(val[idx:], '}')
+		if end < 0 {
+			e.errs = append(e.errs, &ExpandError{Path: path, Ref: val[idx:], Err: errors.New("unterminated reference")})
+			return ""
+		}
+		ref := val[idx : idx+end+1]
+		expanded, err := e.expandRef(ref[2:len(ref)-1], stack)
+		if err != nil {
+			e.errs = append(e.errs, &ExpandError{Path: path, Ref: ref, Err: err})
+		}
+		out.WriteString(expanded)
+		val = val[idx+end+1:]
+	}
+	out.WriteString(val)
+	return out.String()
+}
+
+// expandRef expands the contents of a reference.
+func (e *expander) expandRef(ref string, stack []string) (string, error) {
+	if path, ok := strings.CutPrefix(ref, "file:"); ok {
+		return e.readFile(path)
+	}
+	name, def, hasDef := strings.Cut(ref, ":-")
+	if !isVarName(name) {
+		return "", errors.New("invalid variable name")
+	}
+	val, ok := e.lookupVar(name, stack)
+	if ok && val != "" {
+		return val, nil
+	}
+	if hasDef {
+		return def, nil
+	}
+	if !ok {
+		return "", ErrUnsetVariable
+	}
+	return val, nil
+}
+
+// lookupVar looks up the expanded value of the configset or environment variable.
+func (e *expander) lookupVar(name string, stack []string) (string, bool) {
+	if val, ok := e.resolved[name]; ok {
+		return val, true
+	}
+	val, ok := e.vars[name]
+	if !ok {
+		lookupEnv := e.opts.LookupEnv
+		if lookupEnv == nil {
+			lookupEnv = os.LookupEnv
+		}
+		return lookupEnv(name)
+	}
+	if slices.Contains(stack, name) {
+		e.errs = append(e.errs, &ExpandError{
+			Path: VarsKey + "." + name,
+			Ref:  "${" + name + "}",
+			Err:  errors.New("variable references itself: " + strings.Join(append(stack, name), " -> ")),
+		})
+		return "", true
+	}
+	val = e.expandString(VarsKey+"."+name, val, append(stack, name))
+	e.resolved[name] = val
+	return val, true
+}
+
+// readFile reads a file for a ${file:path} reference.
+func (e *expander) readFile(path string) (string, error) {
+	if path == "" {
+		return "", errors.New("file path cannot be empty")
+	}
+	if !filepath.IsAbs(path) && e.opts.Dir != "" {
+		path = filepath.Join(e.opts.Dir, path)
+	}
+	dat, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(dat), "\r\n"), nil
+}
+
+// isSingleRef checks if the value consists of a single reference.
+func isSingleRef(val string) bool {
+	return strings.HasPrefix(val, "${") && strings.IndexByte(val, '}') == len(val)-1
+}
+
+// isVarName checks if the name is a valid variable name.
+func isVarName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+		case i != 0 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
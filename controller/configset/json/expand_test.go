@@ -0,0 +1,152 @@
+package configset_json
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+)
+
+// TestExpandConfigSetYAML tests expanding variable references in a configset.
+func TestExpandConfigSetYAML(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "token"), []byte("secret\n"), 0o600); err != nil {
+		t.Fatal(err.Error())
+	}
+	env := map[string]string{"SITE": "lab", "EMPTY": ""}
+	opts := &ExpandOpts{
+		Dir: dir,
+		LookupEnv: func(key string) (string, bool) {
+			val, ok := env[key]
+			return val, ok
+		},
+	}
+
+	confPath := filepath.Join(dir, "config.yaml")
+	err := os.WriteFile(confPath, []byte(`vars:
+  host: ${SITE}.example.com
+  url: https://${host}:${PORT:-443}
+example:
+  id: controllerbus/example/boilerplate
+  config:
+    exampleField: ${url} ${EMPTY:-none} $${literal} ${file:token}
+`), 0o644)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	files, err := ReadConfigSetFiles(&ExpandOpts{LookupEnv: opts.LookupEnv}, confPath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	cs := files[0]
+	if _, ok := cs[VarsKey]; ok || len(cs) != 1 {
+		t.Fatalf("expected only the example key but got %v", cs)
+	}
+	dat := cs["example"].Config.pendingParseData
+	expected := `{"exampleField":"https://lab.example.com:443 none ${literal} secret"}`
+	if dat != expected {
+		t.Fatalf("expected %s but got %s", expected, dat)
+	}
+
+	// unresolved references are reported with their key paths
+	_, err = ExpandConfigSetYAML([]byte(`vars:
+  a: ${b}
+  b: ${a}
+example:
+  id: controllerbus/example/boilerplate
+  config:
+    list:
+      - ${MISSING}
+    other: ${file:missing}
+`), opts)
+	if err == nil {
+		t.Fatal("expected error expanding unresolved references")
+	}
+	var expandErr *ExpandError
+	if !errors.As(err, &expandErr) || !errors.Is(err, ErrUnsetVariable) {
+		t.Fatalf("expected unset variable error but got %v", err)
+	}
+	lines := strings.Split(err.Error(), "\n")
+	expectedPrefixes := []string{
+		"example.config.list[0]: ${MISSING}: ",
+		"example.config.other: ${file:missing}: ",
+		"vars.a: ${a}: ",
+	}
+	if len(lines) != len(expectedPrefixes) {
+		t.Fatalf("unexpected errors: %v", err)
+	}
+	for i, prefix := range expectedPrefixes {
+		if !strings.HasPrefix(lines[i], prefix) {
+			t.Fatalf("expected error with prefix %q but got %q", prefix, lines[i])
+		}
+	}
+}
+
+// TestExpandConfigSetYAMLTypes tests the types of the expanded values.
+func TestExpandConfigSetYAMLTypes(t *testing.T) {
+	env := map[string]string{"DEBUG": "true", "EXITS": "3"}
+	opts := &ExpandOpts{
+		LookupEnv: func(key string) (string, bool) {
+			val, ok := env[key]
+			return val, ok
+		},
+	}
+	out, err := ExpandConfigSetYAML([]byte(`vars:
+  enabled: false
+  window: 1m
+example:
+  id: controllerbus/configset
+  config:
+    debug: ${DEBUG}
+    enabled: ${enabled}
+    fallback: ${MISSING:-false}
+    mixed: ${DEBUG} ${enabled}
+    escaped: $${DEBUG}
+    quarantineExits: ${EXITS}
+    quarantineWindowDur: ${window}
+`), opts)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var cs map[string]struct {
+		Config map[string]any `json:"config"`
+	}
+	if err := json.Unmarshal(out, &cs); err != nil {
+		t.Fatal(err.Error())
+	}
+	conf := cs["example"].Config
+	expected := map[string]any{
+		"debug":               true,
+		"enabled":             false,
+		"fallback":            false,
+		"mixed":               "true false",
+		"escaped":             "${DEBUG}",
+		"quarantineExits":     "3",
+		"quarantineWindowDur": "1m",
+	}
+	for key, val := range expected {
+		if conf[key] != val {
+			t.Fatalf("expected %s to be %#v but got %#v", key, val, conf[key])
+		}
+	}
+
+	// numeric fields accept the expanded string value
+	confJSON, err := json.Marshal(map[string]any{
+		"quarantineExits":     conf["quarantineExits"],
+		"quarantineWindowDur": conf["quarantineWindowDur"],
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	ctrlConf := &configset_controller.Config{}
+	if err := ctrlConf.UnmarshalJSON(confJSON); err != nil {
+		t.Fatal(err.Error())
+	}
+	if ctrlConf.GetQuarantineExits() != 3 || ctrlConf.GetQuarantineWindowDur() != "1m" {
+		t.Fatalf("unexpected config: %v", ctrlConf.String())
+	}
+}
@@ -0,0 +1,133 @@
+package configset_json
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	"github.com/pkg/errors"
+)
+
+// ConfigFileExts are the file extensions loaded from a config directory.
+var ConfigFileExts = []string{".yaml", ".yml", ".json"}
+
+// ConfigSetFile is a configset loaded from a file.
+type ConfigSetFile struct {
+	// Path is the path to the file.
+	Path string
+	// ConfigSet is the configset in the file.
+	ConfigSet configset.ConfigSet
+}
+
+// ConfigConflict is a configset key defined in more than one file.
+type ConfigConflict struct {
+	// Key is the configset key.
+	Key string
+	// Source is the file the merged config was taken from.
+	Source string
+	// Files contains the files defining the key in merge order.
+	Files []string
+}
+
+// String returns a description of the conflict.
+func (c *ConfigConflict) String() string {
+	return c.Key + ": using " + c.Source + " (defined in " + strings.Join(c.Files, ", ") + ")"
+}
+
+// ListConfigFiles expands the paths to the list of configset files.
+//
+// Directories are expanded to the files with ConfigFileExts in lexical order.
+// Other paths are returned as-is in the order given.
+func ListConfigFiles(paths ...string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		st, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !st.IsDir() {
+			files = append(files, p)
+			continue
+		}
+
+		entries, err := os.ReadDir(p)
+		if err != nil {
+			return nil, err
+		}
+		// ReadDir returns the entries sorted by filename.
+		for _, ent := range entries {
+			if ent.IsDir() || !slices.Contains(ConfigFileExts, filepath.Ext(ent.Name())) {
+				continue
+			}
+			files = append(files, filepath.Join(p, ent.Name()))
+		}
+	}
+	return files, nil
+}
+
+// ReadConfigSetFiles reads and parses the configset files without resolving the configs.
+//
+// If expand is set, expands the variable references in each file with
+// ExpandConfigSetYAML, resolving relative file references against the
+// directory of the file.
+func ReadConfigSetFiles(expand *ExpandOpts, files ...string) ([]ConfigSet, error) {
+	sets := make([]ConfigSet, len(files))
+	for i, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if expand != nil {
+			fileOpts := *expand
+			fileOpts.Dir = filepath.Dir(file)
+			data, err = ExpandConfigSetYAML(data, &fileOpts)
+			if err != nil {
+				return nil, errors.Wrapf(err, "expand %s", file)
+			}
+		}
+		sets[i], err = UnmarshalConfigSetYAML(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s", file)
+		}
+	}
+	return sets, nil
+}
+
+// MergeConfigSetFiles merges the configset files with configset.MergeConfigSets.
+//
+// Returns the merged configset and the keys defined in more than one file
+// sorted by key.
+func MergeConfigSetFiles(files ...*ConfigSetFile) (configset.ConfigSet, []*ConfigConflict) {
+	sets := make([]configset.ConfigSet, len(files))
+	definedIn := make(map[string][]*ConfigSetFile)
+	for i, file := range files {
+		sets[i] = file.ConfigSet
+		for key, conf := range file.ConfigSet {
+			if conf != nil {
+				definedIn[key] = append(definedIn[key], file)
+			}
+		}
+	}
+	merged := configset.MergeConfigSets(sets...)
+
+	var conflicts []*ConfigConflict
+	for key, defs := range definedIn {
+		if len(defs) < 2 {
+			continue
+		}
+		conflict := &ConfigConflict{Key: key, Files: make([]string, len(defs))}
+		for i, def := range defs {
+			conflict.Files[i] = def.Path
+			if def.ConfigSet[key] == merged[key] {
+				conflict.Source = def.Path
+			}
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	slices.SortFunc(conflicts, func(a, b *ConfigConflict) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return merged, conflicts
+}
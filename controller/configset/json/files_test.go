@@ -0,0 +1,115 @@
+package configset_json
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestMergeConfigSetFiles tests loading and merging a config directory.
+func TestMergeConfigSetFiles(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate.NewFactory(b))
+
+	dir := t.TempDir()
+	confDir := filepath.Join(dir, "conf.d")
+	if err := os.Mkdir(confDir, 0o755); err != nil {
+		t.Fatal(err.Error())
+	}
+	writeFile := func(path, data string) {
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	basePath := filepath.Join(dir, "base.yaml")
+	writeFile(basePath, `base:
+  config:
+    exampleField: base
+  id: controllerbus/example/boilerplate
+override:
+  config:
+    exampleField: base
+  id: controllerbus/example/boilerplate
+  rev: 1
+pinned:
+  config:
+    exampleField: base
+  id: controllerbus/example/boilerplate
+  rev: 5
+`)
+	writeFile(filepath.Join(confDir, "20-site.json"), `{
+  "override": {"id": "controllerbus/example/boilerplate", "rev": 1, "config": {"exampleField": "site"}},
+  "pinned": {"id": "controllerbus/example/boilerplate", "rev": 2, "config": {"exampleField": "site"}}
+}`)
+	writeFile(filepath.Join(confDir, "10-extra.yaml"), `extra:
+  config:
+    exampleField: extra
+  id: controllerbus/example/boilerplate
+`)
+	writeFile(filepath.Join(confDir, "README.md"), "not a config")
+
+	files, err := ListConfigFiles(basePath, confDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedFiles := []string{
+		basePath,
+		filepath.Join(confDir, "10-extra.yaml"),
+		filepath.Join(confDir, "20-site.json"),
+	}
+	if !slices.Equal(files, expectedFiles) {
+		t.Fatalf("unexpected files: %v", files)
+	}
+
+	csFiles, confErrs, err := ValidateConfigSetFiles(ctx, b, nil, files...)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(confErrs) != 0 {
+		t.Fatal(confErrs[0].Error())
+	}
+
+	merged, conflicts := MergeConfigSetFiles(csFiles...)
+	if len(merged) != 4 {
+		t.Fatalf("expected 4 merged configs but got %d", len(merged))
+	}
+	exampleField := func(key string) string {
+		return merged[key].GetConfig().(*boilerplate.Config).GetExampleField()
+	}
+	if v := exampleField("override"); v != "site" {
+		t.Fatalf("expected later file to override but got %q", v)
+	}
+	if v := exampleField("pinned"); v != "base" {
+		t.Fatalf("expected higher revision to be kept but got %q", v)
+	}
+
+	if len(conflicts) != 2 {
+		t.Fatalf("expected 2 conflicts but got %d", len(conflicts))
+	}
+	for _, conflict := range conflicts {
+		t.Log(conflict.String())
+		if !slices.Equal(conflict.Files, []string{basePath, expectedFiles[2]}) {
+			t.Fatalf("unexpected conflict files: %v", conflict.Files)
+		}
+	}
+	if conflicts[0].Key != "override" || conflicts[0].Source != expectedFiles[2] {
+		t.Fatalf("unexpected conflict: %s", conflicts[0].String())
+	}
+	if conflicts[1].Key != "pinned" || conflicts[1].Source != basePath {
+		t.Fatalf("unexpected conflict: %s", conflicts[1].String())
+	}
+}
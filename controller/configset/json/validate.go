@@ -0,0 +1,135 @@
+package configset_json
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownConfigID is returned if no factory is available for a config id.
+var ErrUnknownConfigID = errors.New("unknown config id")
+
+// ConfigError is an error with a controller config in a configset.
+type ConfigError struct {
+	// File is the path to the file defining the config, if any.
+	File string
+	// Key is the configset key.
+	Key string
+	// Err is the error with the controller config.
+	Err error
+}
+
+// Error returns the error string prefixed with the file and configset key.
+func (e *ConfigError) Error() string {
+	if e.File != "" {
+		return e.File + ": " + e.Key + ": " + e.Err.Error()
+	}
+	return e.Key + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error with the controller config.
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// Validate resolves and validates the controller config.
+//
+// Unlike Resolve, returns ErrUnknownConfigID instead of waiting if there is no
+// factory for the config id.
+func (c *ControllerConfig) Validate(ctx context.Context, b bus.Bus) (configset.ControllerConfig, error) {
+	if c == nil {
+		return nil, errors.New("controller config cannot be nil")
+	}
+	if c.Id == "" {
+		return nil, errors.New("config id was not specified")
+	}
+
+	if c.Config == nil {
+		c.Config = &Config{}
+	}
+	if err := c.Config.resolve(ctx, c.Id, b, bus.ReturnWhenIdle()); err != nil {
+		return nil, err
+	}
+	conf := c.Config.underlying
+	if conf == nil {
+		return nil, errors.New("config cannot be nil")
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate config")
+	}
+	if err := c.RestartPolicy.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate restart policy")
+	}
+	if err := configset.ValidateDependsOn(c.DependsOn); err != nil {
+		return nil, errors.Wrap(err, "validate depends on")
+	}
+
+	return configset.NewControllerConfigWithOpts(c.Rev, conf, c.RestartPolicy, c.DependsOn), nil
+}
+
+// Validate resolves and validates each controller config in the configset.
+//
+// Returns the valid configs and the errors with the other controller configs
+// sorted by configset key.
+func (c ConfigSet) Validate(ctx context.Context, b bus.Bus) (configset.ConfigSet, []*ConfigError) {
+	cs := make(configset.ConfigSet, len(c))
+	var errs []*ConfigError
+	for key, cconf := range c {
+		conf, err := cconf.Validate(ctx, b)
+		if err != nil {
+			errs = append(errs, &ConfigError{Key: key, Err: err})
+			continue
+		}
+		cs[key] = conf
+	}
+	slices.SortFunc(errs, func(a, b *ConfigError) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return cs, errs
+}
+
+// ValidateYAML parses a yaml configset and validates each controller config.
+//
+// Returns an error if the yaml cannot be parsed. Otherwise returns the errors
+// with the controller configs sorted by configset key.
+func ValidateYAML(ctx context.Context, b bus.Bus, data []byte) ([]*ConfigError, error) {
+	cs, err := UnmarshalConfigSetYAML(data)
+	if err != nil {
+		return nil, err
+	}
+	_, errs := cs.Validate(ctx, b)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
+
+// ValidateConfigSetFiles reads the configset files and validates each controller config.
+//
+// Returns an error if a file cannot be read, expanded or parsed. Otherwise
+// returns the valid configs of each file and the errors with the other
+// controller configs. See ReadConfigSetFiles for expand.
+func ValidateConfigSetFiles(ctx context.Context, b bus.Bus, expand *ExpandOpts, files ...string) ([]*ConfigSetFile, []*ConfigError, error) {
+	sets, err := ReadConfigSetFiles(expand, files...)
+	if err != nil {
+		return nil, nil, err
+	}
+	out := make([]*ConfigSetFile, len(sets))
+	var errs []*ConfigError
+	for i, cs := range sets {
+		valid, fileErrs := cs.Validate(ctx, b)
+		for _, fileErr := range fileErrs {
+			fileErr.File = files[i]
+		}
+		errs = append(errs, fileErrs...)
+		out[i] = &ConfigSetFile{Path: files[i], ConfigSet: valid}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return out, errs, nil
+}
@@ -0,0 +1,63 @@
+package configset_json
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+var validateYAML = `valid:
+  config:
+    exampleField: test 123
+  id: controllerbus/example/boilerplate
+  rev: 1
+unknown:
+  id: controllerbus/example/does-not-exist
+  rev: 1
+bad-json:
+  config:
+    exampleField: 123
+    unknownField: true
+  id: controllerbus/example/boilerplate
+invalid:
+  config: {}
+  id: controllerbus/example/boilerplate
+`
+
+// TestValidateYAML tests validating a config set yaml.
+func TestValidateYAML(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate.NewFactory(b))
+
+	confErrs, err := ValidateYAML(ctx, b, []byte(validateYAML))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	keys := make([]string, 0, len(confErrs))
+	for _, confErr := range confErrs {
+		t.Log(confErr.Error())
+		keys = append(keys, confErr.Key)
+	}
+	if len(keys) != 3 || keys[0] != "bad-json" || keys[1] != "invalid" || keys[2] != "unknown" {
+		t.Fatalf("unexpected errors: %v", keys)
+	}
+	if !errors.Is(confErrs[2], ErrUnknownConfigID) {
+		t.Fatalf("expected unknown config id error: %v", confErrs[2])
+	}
+
+	if _, err := ValidateYAML(ctx, b, []byte("not: [valid")); err == nil {
+		t.Fatal("expected yaml parse error")
+	}
+}
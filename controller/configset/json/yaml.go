@@ -20,6 +20,23 @@ func MarshalYAML(cs configset.ConfigSet) ([]byte, error) {
 	return cbyaml.JSONToYAML(jdat)
 }
 
+// UnmarshalConfigSetYAML unmarshals a yaml to a ConfigSet without resolving the configs.
+func UnmarshalConfigSetYAML(data []byte) (ConfigSet, error) {
+	cs := make(ConfigSet)
+	jdat, err := cbyaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(jdat, &cs); err != nil {
+		return nil, err
+	}
+	if cs == nil {
+		// empty or null document
+		cs = make(ConfigSet)
+	}
+	return cs, nil
+}
+
 // UnmarshalYAML unmarshals a yaml to a config set, optionally overwriting existing.
 //
 // Returns all added configs.
@@ -30,14 +47,10 @@ func UnmarshalYAML(
 	ocs configset.ConfigSet,
 	overwriteExisting bool,
 ) ([]string, error) {
-	cs := make(ConfigSet)
-	jdat, err := cbyaml.YAMLToJSON(data)
+	cs, err := UnmarshalConfigSetYAML(data)
 	if err != nil {
 		return nil, err
 	}
-	if err := json.Unmarshal(jdat, &cs); err != nil {
-		return nil, err
-	}
 
 	var added []string
 	for id, cconf := range cs {
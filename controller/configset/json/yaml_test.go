@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/aperturerobotics/controllerbus/controller/configset"
+	"github.com/aperturerobotics/controllerbus/controller/loader"
 	"github.com/aperturerobotics/controllerbus/core"
 	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
 	"github.com/sirupsen/logrus"
@@ -55,3 +56,47 @@ func TestUnmarshalConfigSetYAML(t *testing.T) {
 		t.Fatalf("invalid output: %s", dat)
 	}
 }
+
+var restartPolicyYAML = `migrate:
+  config:
+    exampleField: test 123
+  id: controllerbus/example/boilerplate
+  restartPolicy:
+    maxRestarts: 3
+    mode: RestartMode_NEVER
+    windowDur: 1m
+  rev: 1
+`
+
+// TestConfigSetYAMLRestartPolicy tests the restart policy in a config set yaml.
+func TestConfigSetYAMLRestartPolicy(t *testing.T) {
+	le := logrus.NewEntry(logrus.New())
+	ctx := context.Background()
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate.NewFactory(b))
+
+	ocs := make(configset.ConfigSet)
+	if _, err := UnmarshalYAML(ctx, b, []byte(restartPolicyYAML), ocs, true); err != nil {
+		t.Fatal(err.Error())
+	}
+	policy := ocs["migrate"].GetRestartPolicy()
+	if policy.GetMode() != loader.RestartMode_RestartMode_NEVER || policy.GetMaxRestarts() != 3 || policy.GetWindowDur() != "1m" {
+		t.Fatalf("unexpected restart policy: %v", policy)
+	}
+
+	// enums are marshaled as numbers
+	dat, err := MarshalYAML(ocs)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	rcs := make(configset.ConfigSet)
+	if _, err := UnmarshalYAML(ctx, b, dat, rcs, true); err != nil {
+		t.Fatal(err.Error())
+	}
+	if !rcs["migrate"].GetRestartPolicy().EqualVT(policy) {
+		t.Fatalf("unexpected restart policy after marshal:\n%s", string(dat))
+	}
+}
@@ -13,6 +13,7 @@ import (
 	strconv "strconv"
 	strings "strings"
 
+	loader "github.com/aperturerobotics/controllerbus/controller/loader"
 	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
 	json "github.com/aperturerobotics/protobuf-go-lite/json"
 )
@@ -50,6 +51,12 @@ type ControllerConfig struct {
 	// Proto supports: protobuf (binary) and json (starting with {).
 	// Json supports: protobuf (base64) and json (inline object).
 	Config []byte `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// RestartPolicy is the policy for restarting the controller.
+	// If unset, restarts the controller when it exits with an error.
+	RestartPolicy *loader.RestartPolicy `protobuf:"bytes,4,opt,name=restart_policy,json=restartPolicy,proto3" json:"restartPolicy,omitempty"`
+	// DependsOn contains the configset keys this controller depends on.
+	// The controller is started once the dependencies are running or ready.
+	DependsOn []string `protobuf:"bytes,5,rep,name=depends_on,json=dependsOn,proto3" json:"dependsOn,omitempty"`
 }
 
 func (x *ControllerConfig) Reset() {
@@ -79,6 +86,20 @@ func (x *ControllerConfig) GetConfig() []byte {
 	return nil
 }
 
+func (x *ControllerConfig) GetRestartPolicy() *loader.RestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return nil
+}
+
+func (x *ControllerConfig) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
 type ConfigSet_ConfigsEntry struct {
 	unknownFields []byte
 	Key           string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -133,9 +154,13 @@ func (m *ControllerConfig) CloneVT() *ControllerConfig {
 	r := new(ControllerConfig)
 	r.Id = m.Id
 	r.Rev = m.Rev
+	r.RestartPolicy = m.RestartPolicy.CloneVT()
 	if rhs := m.Config; rhs != nil {
 		r.Config = slices.Clone(rhs)
 	}
+	if rhs := m.DependsOn; rhs != nil {
+		r.DependsOn = slices.Clone(rhs)
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = slices.Clone(m.unknownFields)
 	}
@@ -198,6 +223,18 @@ func (this *ControllerConfig) EqualVT(that *ControllerConfig) bool {
 	if string(this.Config) != string(that.Config) {
 		return false
 	}
+	if !this.RestartPolicy.EqualVT(that.RestartPolicy) {
+		return false
+	}
+	if len(this.DependsOn) != len(that.DependsOn) {
+		return false
+	}
+	for i, vx := range this.DependsOn {
+		vy := that.DependsOn[i]
+		if vx != vy {
+			return false
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -406,6 +443,25 @@ func (m *ControllerConfig) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RestartPolicy != nil {
+		size, err := m.RestartPolicy.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Config) > 0 {
 		i -= len(m.Config)
 		copy(dAtA[i:], m.Config)
@@ -468,6 +524,16 @@ func (m *ControllerConfig) SizeVT() (n int) {
 	if l > 0 {
 		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
 	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.SizeVT()
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -552,6 +618,26 @@ func (x *ControllerConfig) MarshalProtoText() string {
 		sb.WriteString(base64.StdEncoding.EncodeToString(x.Config))
 		sb.WriteString("\"")
 	}
+	if x.RestartPolicy != nil {
+		if sb.Len() > 18 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("restart_policy: ")
+		sb.WriteString(x.RestartPolicy.MarshalProtoText())
+	}
+	if len(x.DependsOn) > 0 {
+		if sb.Len() > 18 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("depends_on: [")
+		for i, v := range x.DependsOn {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(v))
+		}
+		sb.WriteString("]")
+	}
 	sb.WriteString("}")
 	return sb.String()
 }
@@ -773,6 +859,56 @@ func (m *ControllerConfig) UnmarshalVT(dAtA []byte) error {
 				m.Config = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &loader.RestartPolicy{}
+			}
+			if err := m.RestartPolicy.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
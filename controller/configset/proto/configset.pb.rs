@@ -23,5 +23,13 @@ pub struct ControllerConfig {
     /// Json supports: protobuf (base64) and json (inline object).
     #[prost(bytes="vec", tag="3")]
     pub config: ::prost::alloc::vec::Vec<u8>,
+    /// RestartPolicy is the policy for restarting the controller.
+    /// If unset, restarts the controller when it exits with an error.
+    #[prost(message, optional, tag="4")]
+    pub restart_policy: ::core::option::Option<super::super::loader::RestartPolicy>,
+    /// DependsOn contains the configset keys this controller depends on.
+    /// The controller is started once the dependencies are running or ready.
+    #[prost(string, repeated, tag="5")]
+    pub depends_on: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
 }
 // @@protoc_insertion_point(module)
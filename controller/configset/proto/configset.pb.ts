@@ -4,6 +4,7 @@
 
 import type { MessageType, PartialFieldInfo } from '@aptre/protobuf-es-lite'
 import { createMessageType, ScalarType } from '@aptre/protobuf-es-lite'
+import { RestartPolicy } from '../../loader/restart-policy.pb.js'
 
 export const protobufPackage = 'configset.proto'
 
@@ -35,6 +36,20 @@ export interface ControllerConfig {
    * @generated from field: bytes config = 3;
    */
   config?: Uint8Array
+  /**
+   * RestartPolicy is the policy for restarting the controller.
+   * If unset, restarts the controller when it exits with an error.
+   *
+   * @generated from field: loader.RestartPolicy restart_policy = 4;
+   */
+  restartPolicy?: RestartPolicy
+  /**
+   * DependsOn contains the configset keys this controller depends on.
+   * The controller is started once the dependencies are running or ready.
+   *
+   * @generated from field: repeated string depends_on = 5;
+   */
+  dependsOn?: string[]
 }
 
 // ControllerConfig contains the message type declaration for ControllerConfig.
@@ -45,6 +60,19 @@ export const ControllerConfig: MessageType<ControllerConfig> =
       { no: 1, name: 'id', kind: 'scalar', T: ScalarType.STRING },
       { no: 2, name: 'rev', kind: 'scalar', T: ScalarType.UINT64 },
       { no: 3, name: 'config', kind: 'scalar', T: ScalarType.BYTES },
+      {
+        no: 4,
+        name: 'restart_policy',
+        kind: 'message',
+        T: () => RestartPolicy,
+      },
+      {
+        no: 5,
+        name: 'depends_on',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
     ] as readonly PartialFieldInfo[],
     packedByDefault: true,
   })
@@ -5,10 +5,12 @@ package configset_proto
 import (
 	"context"
 	"encoding/base64"
+	"slices"
 
 	"github.com/aperturerobotics/controllerbus/bus"
 	"github.com/aperturerobotics/controllerbus/config"
 	"github.com/aperturerobotics/controllerbus/controller/configset"
+	"github.com/aperturerobotics/controllerbus/controller/loader"
 	"github.com/aperturerobotics/controllerbus/controller/resolver"
 	jsoniter "github.com/aperturerobotics/json-iterator-lite"
 	"github.com/aperturerobotics/protobuf-go-lite/json"
@@ -32,9 +34,11 @@ func NewControllerConfig(c configset.ControllerConfig, useJson bool) (*Controlle
 	}
 
 	return &ControllerConfig{
-		Id:     cID,
-		Config: confData,
-		Rev:    c.GetRev(),
+		Id:            cID,
+		Config:        confData,
+		Rev:           c.GetRev(),
+		RestartPolicy: c.GetRestartPolicy(),
+		DependsOn:     slices.Clone(c.GetDependsOn()),
 	}, nil
 }
 
@@ -43,6 +47,12 @@ func (c *ControllerConfig) Validate() error {
 	if len(c.GetId()) == 0 {
 		return ErrControllerConfigIdEmpty
 	}
+	if err := c.GetRestartPolicy().Validate(); err != nil {
+		return errors.Wrap(err, "restart_policy")
+	}
+	if err := configset.ValidateDependsOn(c.GetDependsOn()); err != nil {
+		return errors.Wrap(err, "depends_on")
+	}
 	if conf := c.GetConfig(); len(conf) != 0 {
 		// json if first character is {
 		if conf[0] == 123 {
@@ -91,7 +101,7 @@ func (c *ControllerConfig) Resolve(ctx context.Context, b bus.Bus) (configset.Co
 		}
 	}
 
-	return configset.NewControllerConfig(c.GetRev(), cf), nil
+	return configset.NewControllerConfigWithOpts(c.GetRev(), cf, c.GetRestartPolicy(), c.GetDependsOn()), nil
 }
 
 // MarshalProtoJSON marshals the ControllerConfig message to JSON.
@@ -133,6 +143,16 @@ func (c *ControllerConfig) MarshalProtoJSON(s *json.MarshalState) {
 			s.WriteString(base64.RawStdEncoding.EncodeToString(c.Config))
 		}
 	}
+	if c.RestartPolicy != nil || s.HasField("restartPolicy") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("restartPolicy")
+		c.RestartPolicy.MarshalProtoJSON(s.WithField("restartPolicy"))
+	}
+	if len(c.DependsOn) > 0 || s.HasField("dependsOn") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dependsOn")
+		s.WriteStringArray(c.DependsOn)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -173,6 +193,19 @@ func (c *ControllerConfig) UnmarshalProtoJSON(s *json.UnmarshalState) {
 				s.SetError(errors.Errorf("invalid json value for config: type %v", nextTok))
 				return
 			}
+		case "restart_policy", "restartPolicy":
+			if s.ReadNil() {
+				c.RestartPolicy = nil
+				break
+			}
+			c.RestartPolicy = &loader.RestartPolicy{}
+			c.RestartPolicy.UnmarshalProtoJSON(s.WithField("restart_policy", true))
+		case "depends_on", "dependsOn":
+			if s.ReadNil() {
+				c.DependsOn = nil
+				break
+			}
+			c.DependsOn = s.ReadStringArray()
 		default:
 			s.Skip()
 		}
@@ -5,6 +5,7 @@ package configset_proto
 import (
 	"encoding/base64"
 
+	"github.com/aperturerobotics/controllerbus/controller/loader"
 	jsoniter "github.com/aperturerobotics/json-iterator-lite"
 	"github.com/aperturerobotics/protobuf-go-lite/json"
 	"github.com/pkg/errors"
@@ -45,6 +46,16 @@ func (c *ControllerConfig) MarshalProtoJSON(s *json.MarshalState) {
 			s.WriteString(base64.RawStdEncoding.EncodeToString(c.Config))
 		}
 	}
+	if c.RestartPolicy != nil || s.HasField("restartPolicy") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("restartPolicy")
+		c.RestartPolicy.MarshalProtoJSON(s.WithField("restartPolicy"))
+	}
+	if len(c.DependsOn) > 0 || s.HasField("dependsOn") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dependsOn")
+		s.WriteStringArray(c.DependsOn)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -85,6 +96,19 @@ func (c *ControllerConfig) UnmarshalProtoJSON(s *json.UnmarshalState) {
 				s.SetError(errors.Errorf("invalid json value for config: type %v", next))
 				return
 			}
+		case "restart_policy", "restartPolicy":
+			if s.ReadNil() {
+				c.RestartPolicy = nil
+				break
+			}
+			c.RestartPolicy = &loader.RestartPolicy{}
+			c.RestartPolicy.UnmarshalProtoJSON(s.WithField("restart_policy", true))
+		case "depends_on", "dependsOn":
+			if s.ReadNil() {
+				c.DependsOn = nil
+				break
+			}
+			c.DependsOn = s.ReadStringArray()
 		default:
 			s.Skip()
 		}
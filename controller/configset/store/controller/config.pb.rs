@@ -0,0 +1,14 @@
+// @generated
+// This file is @generated by prost-build.
+/// Config is the configset store controller config.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct Config {
+    /// StoreDir is the directory to store the configsets in.
+    #[prost(string, tag="1")]
+    pub store_dir: ::prost::alloc::string::String,
+    /// MaxRevisions is the number of revisions to keep for each key.
+    /// If zero, all revisions are kept.
+    #[prost(uint32, tag="2")]
+    pub max_revisions: u32,
+}
+// @@protoc_insertion_point(module)
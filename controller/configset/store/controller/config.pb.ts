@@ -0,0 +1,39 @@
+// @generated by protoc-gen-es-lite unknown with parameter "target=ts,ts_nocheck=false"
+// @generated from file github.com/aperturerobotics/controllerbus/controller/configset/store/controller/config.proto (package configset.store.controller, syntax proto3)
+/* eslint-disable */
+
+import type { MessageType, PartialFieldInfo } from '@aptre/protobuf-es-lite'
+import { createMessageType, ScalarType } from '@aptre/protobuf-es-lite'
+
+export const protobufPackage = 'configset.store.controller'
+
+/**
+ * Config is the configset store controller config.
+ *
+ * @generated from message configset.store.controller.Config
+ */
+export interface Config {
+  /**
+   * StoreDir is the directory to store the configsets in.
+   *
+   * @generated from field: string store_dir = 1;
+   */
+  storeDir?: string
+  /**
+   * MaxRevisions is the number of revisions to keep for each key.
+   * If zero, all revisions are kept.
+   *
+   * @generated from field: uint32 max_revisions = 2;
+   */
+  maxRevisions?: number
+}
+
+// Config contains the message type declaration for Config.
+export const Config: MessageType<Config> = createMessageType({
+  typeName: 'configset.store.controller.Config',
+  fields: [
+    { no: 1, name: 'store_dir', kind: 'scalar', T: ScalarType.STRING },
+    { no: 2, name: 'max_revisions', kind: 'scalar', T: ScalarType.UINT32 },
+  ] as readonly PartialFieldInfo[],
+  packedByDefault: true,
+})
@@ -0,0 +1,262 @@
+package configset_store_controller
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_proto "github.com/aperturerobotics/controllerbus/controller/configset/proto"
+	configset_store "github.com/aperturerobotics/controllerbus/controller/configset/store"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Version is the version of the controller implementation.
+var Version = controller.MustParseVersion("0.0.1")
+
+// ControllerID is the ID of the controller.
+const ControllerID = "controllerbus/configset/store"
+
+// Controller is the configset store controller.
+//
+// Applies the latest revision of each stored key with a configset.Applier
+// while executing. The applied configsets are released when it exits.
+type Controller struct {
+	// le is the logger
+	le *logrus.Entry
+	// bus is the controller bus
+	bus bus.Bus
+	// store is the configset store
+	store configset_store.Store
+
+	// mtx guards below fields and appending revisions
+	mtx sync.Mutex
+	// appliers contains the appliers by store key
+	// nil if the controller is not executing
+	appliers map[string]*configset.Applier
+}
+
+// NewController constructs a new configset store controller.
+func NewController(le *logrus.Entry, bus bus.Bus, store configset_store.Store) *Controller {
+	return &Controller{le: le, bus: bus, store: store}
+}
+
+// GetControllerInfo returns information about the controller.
+func (c *Controller) GetControllerInfo() *controller.Info {
+	return controller.NewInfo(
+		ControllerID,
+		Version,
+		"configset store controller",
+	)
+}
+
+// GetStore returns the underlying store.
+func (c *Controller) GetStore() configset_store.Store {
+	return c.store
+}
+
+// Execute applies the latest revision of each stored key.
+// Returning nil ends execution.
+// Returning an error triggers a retry with backoff.
+func (c *Controller) Execute(ctx context.Context) error {
+	c.mtx.Lock()
+	c.appliers = make(map[string]*configset.Applier)
+	c.mtx.Unlock()
+	defer func() {
+		c.mtx.Lock()
+		for _, applier := range c.appliers {
+			applier.Release()
+		}
+		c.appliers = nil
+		c.mtx.Unlock()
+	}()
+
+	keys, err := c.store.ListKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list stored configsets")
+	}
+	for _, key := range keys {
+		if err := c.applyLatest(ctx, key); err != nil {
+			if ctx.Err() != nil {
+				return context.Canceled
+			}
+			c.le.WithError(err).WithField("store-key", key).Warn("unable to apply stored configset")
+		}
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+// PutConfigSet stores the configset as a new revision of the key and
+// applies it in place of the previous revision.
+func (c *Controller) PutConfigSet(
+	ctx context.Context,
+	key string,
+	cs configset.ConfigSet,
+	source string,
+) (*configset_store.ConfigSetRevision, error) {
+	if key == "" {
+		return nil, configset_store.ErrStoreKeyEmpty
+	}
+	if err := cs.CheckDependencies(); err != nil {
+		return nil, err
+	}
+	pcs, err := configset_proto.NewConfigSet(cs, true)
+	if err != nil {
+		return nil, err
+	}
+	return c.putRevision(ctx, key, cs, pcs, source, 0)
+}
+
+// RollbackConfigSet stores a copy of an earlier revision of the key as a
+// new revision and applies it. Returns ErrRevisionNotFound if the
+// revision is not in the history.
+func (c *Controller) RollbackConfigSet(
+	ctx context.Context,
+	key string,
+	rev uint64,
+	source string,
+) (*configset_store.ConfigSetRevision, error) {
+	hist, err := c.store.GetHistory(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	var target *configset_store.ConfigSetRevision
+	for _, hrev := range hist {
+		if hrev.GetRev() == rev {
+			target = hrev
+			break
+		}
+	}
+	if target == nil {
+		return nil, errors.Wrapf(configset_store.ErrRevisionNotFound, "%s revision %d", key, rev)
+	}
+
+	cs, err := target.GetConfigSet().Resolve(ctx, c.bus)
+	if err != nil {
+		return nil, errors.Wrapf(err, "resolve %s revision %d", key, rev)
+	}
+	return c.putRevision(ctx, key, cs, target.GetConfigSet().CloneVT(), source, rev)
+}
+
+// putRevision stores and applies a new revision of the key.
+func (c *Controller) putRevision(
+	ctx context.Context,
+	key string,
+	cs configset.ConfigSet,
+	pcs *configset_proto.ConfigSet,
+	source string,
+	rollbackRev uint64,
+) (*configset_store.ConfigSetRevision, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	latest, err := configset_store.GetLatestRevision(ctx, c.store, key)
+	if err != nil {
+		return nil, err
+	}
+	rev := &configset_store.ConfigSetRevision{
+		Rev:             latest.GetRev() + 1,
+		TimestampUnixMs: uint64(time.Now().UnixMilli()), //nolint:gosec
+		Source:          source,
+		ConfigSet:       pcs,
+		RollbackRev:     rollbackRev,
+	}
+	if err := c.store.AppendRevision(ctx, key, rev); err != nil {
+		return nil, errors.Wrap(err, "store configset")
+	}
+	if err := c.apply(key, rev.GetRev(), cs); err != nil {
+		return rev, errors.Wrap(err, "apply configset")
+	}
+	return rev, nil
+}
+
+// applyLatest resolves and applies the latest revision of the key unless a
+// newer revision was applied in the meantime.
+func (c *Controller) applyLatest(ctx context.Context, key string) error {
+	latest, err := configset_store.GetLatestRevision(ctx, c.store, key)
+	if err != nil || latest == nil {
+		return err
+	}
+	cs, err := latest.GetConfigSet().Resolve(ctx, c.bus)
+	if err != nil {
+		return errors.Wrapf(err, "resolve revision %d", latest.GetRev())
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.appliers[key]; ok {
+		return nil
+	}
+	return c.apply(key, latest.GetRev(), cs)
+}
+
+// apply applies the configset of the key if the controller is executing.
+// mtx is locked by the caller
+func (c *Controller) apply(key string, rev uint64, cs configset.ConfigSet) error {
+	if c.appliers == nil {
+		return nil
+	}
+	applier := c.appliers[key]
+	if applier == nil {
+		applier = configset.NewApplier(c.bus)
+		c.appliers[key] = applier
+	}
+	diff, err := applier.Apply(cs)
+	if err != nil {
+		return err
+	}
+	c.le.
+		WithField("store-key", key).
+		WithField("rev", rev).
+		WithField("added", diff.Added).
+		WithField("changed", diff.Changed).
+		WithField("removed", diff.Removed).
+		Info("applied stored configset")
+	return nil
+}
+
+// Shutdown releases the applied configsets of each stored key with
+// configset.Applier Shutdown, waiting for the controllers to exit.
+// Configsets stored afterwards are not applied until the controller restarts.
+//
+// Returns the controllers that did not exit before ctx was canceled as
+// store-key/config-key.
+func (c *Controller) Shutdown(ctx context.Context) []string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	keys := make([]string, 0, len(c.appliers))
+	for key := range c.appliers {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var failed []string
+	for _, key := range keys {
+		for _, confKey := range c.appliers[key].Shutdown(ctx) {
+			failed = append(failed, key+"/"+confKey)
+		}
+	}
+	c.appliers = nil
+	return failed
+}
+
+// HandleDirective asks if the handler can resolve the directive.
+func (c *Controller) HandleDirective(ctx context.Context, di directive.Instance) ([]directive.Resolver, error) {
+	return nil, nil
+}
+
+// Close releases any resources used by the controller.
+func (c *Controller) Close() error {
+	return nil
+}
+
+// _ is a type assertion
+var _ configset_store.Controller = ((*Controller)(nil))
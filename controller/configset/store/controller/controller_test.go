@@ -0,0 +1,282 @@
+package configset_store_controller_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	configset_store "github.com/aperturerobotics/controllerbus/controller/configset/store"
+	configset_store_controller "github.com/aperturerobotics/controllerbus/controller/configset/store/controller"
+	controller_mock "github.com/aperturerobotics/controllerbus/controller/mock"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestFileStore tests appending, pruning and listing revisions.
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := configset_store.NewFileStore(dir, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for i := uint64(1); i <= 3; i++ {
+		if err := s.AppendRevision(ctx, "a/b", &configset_store.ConfigSetRevision{Rev: i}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if err := s.AppendRevision(ctx, "a/b", &configset_store.ConfigSetRevision{Rev: 3}); err == nil {
+		t.Fatal("expected error appending an old revision")
+	}
+	if err := s.AppendRevision(ctx, "c", &configset_store.ConfigSetRevision{Rev: 1}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// reopen the store to read the files back
+	s, err = configset_store.NewFileStore(dir, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	keys, err := s.ListKeys(ctx)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !slices.Equal(keys, []string{"a/b", "c"}) {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+	hist, err := s.GetHistory(ctx, "a/b")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(hist) != 2 || hist[0].GetRev() != 2 || hist[1].GetRev() != 3 {
+		t.Fatalf("unexpected history: %v", hist)
+	}
+	if hist, err := s.GetHistory(ctx, "missing"); err != nil || len(hist) != 0 {
+		t.Fatalf("expected empty history but got %v: %v", hist, err)
+	}
+}
+
+// TestController tests storing, restoring and rolling back configsets.
+func TestController(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sr.AddFactory(boilerplate.NewFactory(b))
+	sr.AddFactory(configset_store_controller.NewFactory(b))
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	storeDir := t.TempDir()
+	loadStore := func() (configset_store.Controller, func()) {
+		val, _, ref, err := bus.ExecOneOff(
+			ctx,
+			b,
+			resolver.NewLoadControllerWithConfig(&configset_store_controller.Config{StoreDir: storeDir}),
+			nil,
+			nil,
+		)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return val.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset_store.Controller), ref.Release
+	}
+	// waitApplied waits for the applied example field of each key to match.
+	waitApplied := func(expected map[string]string) {
+		for {
+			applied := make(map[string]string)
+			for key, conf := range csCtrl.GetConfigSet() {
+				applied[key] = conf.GetConfig().(*boilerplate.Config).GetExampleField()
+			}
+			match := len(applied) == len(expected)
+			for key, val := range expected {
+				match = match && applied[key] == val
+			}
+			if match {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected applied %v but got %v", expected, applied)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	newConfigSet := func(val string) configset.ConfigSet {
+		return configset.ConfigSet{"example": configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: val})}
+	}
+
+	storeCtrl, releaseStore := loadStore()
+	if _, err := storeCtrl.PutConfigSet(ctx, "", newConfigSet("first"), "test"); !errors.Is(err, configset_store.ErrStoreKeyEmpty) {
+		t.Fatalf("expected empty key error but got %v", err)
+	}
+	rev, err := storeCtrl.PutConfigSet(ctx, "main", newConfigSet("first"), "test")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if rev.GetRev() != 1 || rev.GetSource() != "test" || rev.GetTimestamp().IsZero() {
+		t.Fatalf("unexpected revision: %v", rev)
+	}
+	waitApplied(map[string]string{"example": "first"})
+	rev, err = storeCtrl.PutConfigSet(ctx, "main", newConfigSet("second"), "test")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if rev.GetRev() != 2 {
+		t.Fatalf("unexpected revision: %v", rev)
+	}
+	waitApplied(map[string]string{"example": "second"})
+
+	// releasing the store removes the configs, restarting applies the latest
+	releaseStore()
+	waitApplied(map[string]string{})
+	storeCtrl, releaseStore = loadStore()
+	defer releaseStore()
+	waitApplied(map[string]string{"example": "second"})
+
+	if _, err := storeCtrl.RollbackConfigSet(ctx, "main", 5, "test"); !errors.Is(err, configset_store.ErrRevisionNotFound) {
+		t.Fatalf("expected revision not found but got %v", err)
+	}
+	rev, err = storeCtrl.RollbackConfigSet(ctx, "main", 1, "rollback")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if rev.GetRev() != 3 || rev.GetRollbackRev() != 1 || rev.GetSource() != "rollback" {
+		t.Fatalf("unexpected revision: %v", rev)
+	}
+	waitApplied(map[string]string{"example": "first"})
+
+	hist, err := storeCtrl.GetStore().GetHistory(ctx, "main")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(hist) != 3 {
+		t.Fatalf("expected 3 revisions but got %d", len(hist))
+	}
+}
+
+// TestControllerShutdown tests shutting down the stored configsets.
+func TestControllerShutdown(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// stuck is closed to allow the controller named stuck to exit
+	stuck := make(chan struct{})
+	defer close(stuck)
+	factory := &controller_mock.MockFactory{
+		ExecuteFn: func(ctx context.Context, c *controller_mock.MockController) error {
+			<-ctx.Done()
+			if c.GetName() == "stuck" {
+				<-stuck
+			}
+			return ctx.Err()
+		},
+	}
+	sr.AddFactory(factory)
+	sr.AddFactory(configset_store_controller.NewFactory(b))
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	val, _, ref, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_store_controller.Config{StoreDir: t.TempDir()}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ref.Release()
+	storeCtrl := val.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset_store.Controller)
+
+	// waitRunning waits for the number of running controllers to match.
+	waitRunning := func(expected int) {
+		for {
+			var running int
+			for _, st := range csCtrl.GetControllerStates() {
+				if st.GetController() != nil {
+					running++
+				}
+			}
+			if running == expected {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected %d running controllers but got %d", expected, running)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	// the keys are shut down in order, c contains the stuck controller
+	for key, name := range map[string]string{"a": "a-running", "b": "b-running", "c": "stuck"} {
+		cs := configset.ConfigSet{name: configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: name})}
+		if _, err := storeCtrl.PutConfigSet(ctx, key, cs, "test"); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	waitRunning(3)
+
+	shutdownCtx, shutdownCtxCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer shutdownCtxCancel()
+	if failed := storeCtrl.Shutdown(shutdownCtx); !slices.Equal(failed, []string{"c/stuck"}) {
+		t.Fatalf("expected stuck controller to fail to stop but got %v", failed)
+	}
+	exited := factory.GetExited()
+	slices.Sort(exited)
+	if !slices.Equal(exited, []string{"a-running", "b-running"}) {
+		t.Fatalf("expected running controllers to exit but got %v", exited)
+	}
+	if len(csCtrl.GetConfigSet()) != 0 {
+		t.Fatalf("expected no applied configs but got %v", csCtrl.GetConfigSet())
+	}
+
+	// configsets stored after shutdown are not applied
+	cs := configset.ConfigSet{"a-running": configset.NewControllerConfig(1, &boilerplate.Config{ExampleField: "late"})}
+	if _, err := storeCtrl.PutConfigSet(ctx, "a", cs, "test"); err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(csCtrl.GetConfigSet()) != 0 {
+		t.Fatalf("expected no applied configs but got %v", csCtrl.GetConfigSet())
+	}
+}
@@ -0,0 +1,142 @@
+package configset_store
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// fileExt is the extension of the history files.
+const fileExt = ".pb"
+
+// FileStore is a Store keeping the history of each key in a file in a
+// directory. Files are replaced atomically when a revision is appended.
+type FileStore struct {
+	// dir is the store directory
+	dir string
+	// maxRevisions is the number of revisions to keep, zero keeps all
+	maxRevisions int
+
+	// mtx guards writing the files
+	mtx sync.Mutex
+}
+
+// NewFileStore constructs a new FileStore in dir, creating it if needed.
+//
+// If maxRevisions is not zero, only the most recent revisions are kept.
+func NewFileStore(dir string, maxRevisions int) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "create store dir")
+	}
+	return &FileStore{dir: dir, maxRevisions: maxRevisions}, nil
+}
+
+// ListKeys returns the stored keys, sorted.
+func (s *FileStore) ListKeys(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, fileExt) {
+			continue
+		}
+		key, err := url.PathUnescape(strings.TrimSuffix(name, fileExt))
+		if err != nil || key == "" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+// GetHistory returns the revisions of the key, oldest first.
+// Returns nil if the key is not stored.
+func (s *FileStore) GetHistory(ctx context.Context, key string) ([]*ConfigSetRevision, error) {
+	if key == "" {
+		return nil, ErrStoreKeyEmpty
+	}
+	hist, err := s.readHistory(key)
+	if err != nil {
+		return nil, err
+	}
+	return hist.GetRevisions(), nil
+}
+
+// AppendRevision appends a revision to the history of the key.
+// The revision number must be greater than the latest revision.
+func (s *FileStore) AppendRevision(ctx context.Context, key string, rev *ConfigSetRevision) error {
+	if key == "" {
+		return ErrStoreKeyEmpty
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	hist, err := s.readHistory(key)
+	if err != nil {
+		return err
+	}
+	if revs := hist.GetRevisions(); len(revs) != 0 && revs[len(revs)-1].GetRev() >= rev.GetRev() {
+		return errors.Errorf("revision %d is not newer than the latest revision %d", rev.GetRev(), revs[len(revs)-1].GetRev())
+	}
+	hist.Revisions = append(hist.Revisions, rev)
+	if s.maxRevisions > 0 && len(hist.Revisions) > s.maxRevisions {
+		hist.Revisions = hist.Revisions[len(hist.Revisions)-s.maxRevisions:]
+	}
+
+	dat, err := hist.MarshalVT()
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) //nolint:errcheck
+	_, err = tmp.Write(dat)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errors.Wrap(err, "write history")
+	}
+	return os.Rename(tmpPath, s.getPath(key))
+}
+
+// readHistory reads the history file of the key.
+func (s *FileStore) readHistory(key string) (*ConfigSetHistory, error) {
+	hist := &ConfigSetHistory{}
+	dat, err := os.ReadFile(s.getPath(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return hist, nil
+		}
+		return nil, err
+	}
+	if err := hist.UnmarshalVT(dat); err != nil {
+		return nil, errors.Wrapf(err, "parse history of %s", key)
+	}
+	return hist, nil
+}
+
+// getPath returns the path to the history file of the key.
+func (s *FileStore) getPath(key string) string {
+	return filepath.Join(s.dir, url.PathEscape(key)+fileExt)
+}
+
+// _ is a type assertion
+var _ Store = ((*FileStore)(nil))
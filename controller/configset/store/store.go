@@ -0,0 +1,71 @@
+package configset_store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+)
+
+// ErrStoreKeyEmpty is returned if the store key is empty.
+var ErrStoreKeyEmpty = errors.New("store key cannot be empty")
+
+// ErrRevisionNotFound is returned if the revision is not in the history.
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// Store is a durable store for the revision histories of configsets by key.
+type Store interface {
+	// ListKeys returns the stored keys, sorted.
+	ListKeys(ctx context.Context) ([]string, error)
+	// GetHistory returns the revisions of the key, oldest first.
+	// Returns nil if the key is not stored.
+	GetHistory(ctx context.Context, key string) ([]*ConfigSetRevision, error)
+	// AppendRevision appends a revision to the history of the key.
+	// The revision number must be greater than the latest revision.
+	AppendRevision(ctx context.Context, key string, rev *ConfigSetRevision) error
+}
+
+// Controller is the configset store controller.
+//
+// The latest revision of each stored key is applied while the controller is
+// running, including after a restart.
+type Controller interface {
+	// Controller indicates this is a controllerbus controller.
+	controller.Controller
+
+	// GetStore returns the underlying store.
+	GetStore() Store
+	// PutConfigSet stores the configset as a new revision of the key and
+	// applies it in place of the previous revision.
+	PutConfigSet(ctx context.Context, key string, cs configset.ConfigSet, source string) (*ConfigSetRevision, error)
+	// RollbackConfigSet stores a copy of an earlier revision of the key as a
+	// new revision and applies it. Returns ErrRevisionNotFound if the
+	// revision is not in the history.
+	RollbackConfigSet(ctx context.Context, key string, rev uint64, source string) (*ConfigSetRevision, error)
+	// Shutdown releases the applied configsets of each stored key with
+	// configset.Applier Shutdown, waiting for the controllers to exit.
+	//
+	// Returns the controllers that did not exit before ctx was canceled as
+	// store-key/config-key.
+	Shutdown(ctx context.Context) []string
+}
+
+// GetLatestRevision returns the latest revision of the key.
+// Returns nil if the key is not stored.
+func GetLatestRevision(ctx context.Context, s Store, key string) (*ConfigSetRevision, error) {
+	hist, err := s.GetHistory(ctx, key)
+	if err != nil || len(hist) == 0 {
+		return nil, err
+	}
+	return hist[len(hist)-1], nil
+}
+
+// GetTimestamp returns the time the revision was stored.
+func (r *ConfigSetRevision) GetTimestamp() time.Time {
+	if r.GetTimestampUnixMs() == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(r.GetTimestampUnixMs())) //nolint:gosec
+}
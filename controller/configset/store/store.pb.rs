@@ -0,0 +1,29 @@
+// @generated
+// This file is @generated by prost-build.
+/// ConfigSetRevision is a revision of a stored configset.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ConfigSetRevision {
+    /// Rev is the revision number, starting at 1.
+    #[prost(uint64, tag="1")]
+    pub rev: u64,
+    /// TimestampUnixMs is the time the revision was stored in unix milliseconds.
+    #[prost(uint64, tag="2")]
+    pub timestamp_unix_ms: u64,
+    /// Source describes who or what stored the revision.
+    #[prost(string, tag="3")]
+    pub source: ::prost::alloc::string::String,
+    /// ConfigSet is the stored configset.
+    #[prost(message, optional, tag="4")]
+    pub config_set: ::core::option::Option<super::proto::ConfigSet>,
+    /// RollbackRev is the revision this revision rolled back to, if any.
+    #[prost(uint64, tag="5")]
+    pub rollback_rev: u64,
+}
+/// ConfigSetHistory is the revision history of a stored configset.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ConfigSetHistory {
+    /// Revisions contains the revisions, oldest first.
+    #[prost(message, repeated, tag="1")]
+    pub revisions: ::prost::alloc::vec::Vec<ConfigSetRevision>,
+}
+// @@protoc_insertion_point(module)
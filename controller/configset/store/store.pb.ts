@@ -0,0 +1,96 @@
+// @generated by protoc-gen-es-lite unknown with parameter "target=ts,ts_nocheck=false"
+// @generated from file github.com/aperturerobotics/controllerbus/controller/configset/store/store.proto (package configset.store, syntax proto3)
+/* eslint-disable */
+
+import type { MessageType, PartialFieldInfo } from '@aptre/protobuf-es-lite'
+import { createMessageType, ScalarType } from '@aptre/protobuf-es-lite'
+import { ConfigSet } from '../proto/configset.pb.js'
+
+export const protobufPackage = 'configset.store'
+
+/**
+ * ConfigSetRevision is a revision of a stored configset.
+ *
+ * @generated from message configset.store.ConfigSetRevision
+ */
+export interface ConfigSetRevision {
+  /**
+   * Rev is the revision number, starting at 1.
+   *
+   * @generated from field: uint64 rev = 1;
+   */
+  rev?: bigint
+  /**
+   * TimestampUnixMs is the time the revision was stored in unix milliseconds.
+   *
+   * @generated from field: uint64 timestamp_unix_ms = 2;
+   */
+  timestampUnixMs?: bigint
+  /**
+   * Source describes who or what stored the revision.
+   *
+   * @generated from field: string source = 3;
+   */
+  source?: string
+  /**
+   * ConfigSet is the stored configset.
+   *
+   * @generated from field: configset.proto.ConfigSet config_set = 4;
+   */
+  configSet?: ConfigSet
+  /**
+   * RollbackRev is the revision this revision rolled back to, if any.
+   *
+   * @generated from field: uint64 rollback_rev = 5;
+   */
+  rollbackRev?: bigint
+}
+
+// ConfigSetRevision contains the message type declaration for ConfigSetRevision.
+export const ConfigSetRevision: MessageType<ConfigSetRevision> =
+  createMessageType({
+    typeName: 'configset.store.ConfigSetRevision',
+    fields: [
+      { no: 1, name: 'rev', kind: 'scalar', T: ScalarType.UINT64 },
+      {
+        no: 2,
+        name: 'timestamp_unix_ms',
+        kind: 'scalar',
+        T: ScalarType.UINT64,
+      },
+      { no: 3, name: 'source', kind: 'scalar', T: ScalarType.STRING },
+      { no: 4, name: 'config_set', kind: 'message', T: () => ConfigSet },
+      { no: 5, name: 'rollback_rev', kind: 'scalar', T: ScalarType.UINT64 },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ConfigSetHistory is the revision history of a stored configset.
+ *
+ * @generated from message configset.store.ConfigSetHistory
+ */
+export interface ConfigSetHistory {
+  /**
+   * Revisions contains the revisions, oldest first.
+   *
+   * @generated from field: repeated configset.store.ConfigSetRevision revisions = 1;
+   */
+  revisions?: ConfigSetRevision[]
+}
+
+// ConfigSetHistory contains the message type declaration for ConfigSetHistory.
+export const ConfigSetHistory: MessageType<ConfigSetHistory> =
+  createMessageType({
+    typeName: 'configset.store.ConfigSetHistory',
+    fields: [
+      {
+        no: 1,
+        name: 'revisions',
+        kind: 'message',
+        T: () => ConfigSetRevision,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
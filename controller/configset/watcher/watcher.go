@@ -0,0 +1,84 @@
+//go:build !js && !wasm
+
+package configset_watcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aperturerobotics/fsnotify"
+	debounce_fswatcher "github.com/aperturerobotics/util/debounce-fswatcher"
+	"github.com/sirupsen/logrus"
+)
+
+// DebounceTime is the quiet period after a change before reloading.
+var DebounceTime = 500 * time.Millisecond
+
+// WatchFiles watches config files and directories and calls reload after they change.
+//
+// Watches the parent directory of each file to handle editors replacing the
+// file with a rename. Changes to any file in a watched directory path trigger
+// a reload. Returns when ctx is canceled or the watcher fails.
+func WatchFiles(
+	ctx context.Context,
+	le *logrus.Entry,
+	paths []string,
+	reload func(ctx context.Context),
+) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	files := make(map[string]struct{}, len(paths))
+	configDirs := make(map[string]struct{})
+	dirs := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		p, err := filepath.Abs(p)
+		if err != nil {
+			return err
+		}
+		dir := filepath.Dir(p)
+		if st, err := os.Stat(p); err == nil && st.IsDir() {
+			configDirs[p] = struct{}{}
+			dir = p
+		} else {
+			files[p] = struct{}{}
+		}
+		if _, ok := dirs[dir]; ok {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			return err
+		}
+		dirs[dir] = struct{}{}
+	}
+
+	filter := func(event fsnotify.Event) (bool, error) {
+		p, err := filepath.Abs(event.Name)
+		if err != nil {
+			return false, nil
+		}
+		if _, ok := files[p]; ok {
+			return true, nil
+		}
+		_, ok := configDirs[filepath.Dir(p)]
+		return ok, nil
+	}
+	for {
+		happened, err := debounce_fswatcher.DebounceFSWatcherEvents(ctx, watcher, DebounceTime, filter)
+		if err != nil {
+			return err
+		}
+		if len(happened) == 0 {
+			// watcher closed
+			return nil
+		}
+
+		le.Debugf("reloading config after %d filesystem events", len(happened))
+		reload(ctx)
+	}
+}
@@ -0,0 +1,114 @@
+//go:build !js && !wasm
+
+package configset_watcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TestWatchFiles tests reloading after the watched files and directories change.
+func TestWatchFiles(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	prevDebounceTime := DebounceTime
+	DebounceTime = 50 * time.Millisecond
+	defer func() {
+		DebounceTime = prevDebounceTime
+	}()
+
+	fileDir, confDir := t.TempDir(), t.TempDir()
+	confPath := filepath.Join(fileDir, "config.yaml")
+	writeFile := func(p, data string) {
+		if err := os.WriteFile(p, []byte(data), 0o644); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	writeFile(confPath, "first")
+
+	le := logrus.NewEntry(logrus.New())
+	watchCtx, watchCtxCancel := context.WithCancel(ctx)
+	defer watchCtxCancel()
+	reloads := make(chan struct{}, 10)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- WatchFiles(watchCtx, le, []string{confPath, confDir}, func(ctx context.Context) {
+			reloads <- struct{}{}
+		})
+	}()
+
+	// waitReload waits for a reload, calling change every 100ms until it
+	// happens, then drains any reloads from the repeated changes.
+	waitReload := func(change func()) {
+		for {
+			change()
+			select {
+			case <-ctx.Done():
+				t.Fatal("expected config to be reloaded")
+			case <-reloads:
+				for {
+					select {
+					case <-reloads:
+					case <-time.After(5 * DebounceTime):
+						return
+					}
+				}
+			case <-time.After(100 * time.Millisecond):
+			}
+		}
+	}
+	// expectNoReload checks that no reload happens after change.
+	expectNoReload := func(change func()) {
+		change()
+		select {
+		case <-reloads:
+			t.Fatal("unexpected config reload")
+		case <-time.After(10 * DebounceTime):
+		}
+	}
+
+	// writing the file reloads once the watcher has started
+	waitReload(func() {
+		writeFile(confPath, "second")
+	})
+
+	// editors write a temporary file and rename it over the config file
+	waitReload(func() {
+		tmpPath := filepath.Join(fileDir, ".config.yaml.swp")
+		writeFile(tmpPath, "third")
+		if err := os.Rename(tmpPath, confPath); err != nil {
+			t.Fatal(err.Error())
+		}
+	})
+
+	// other files next to the config file are ignored
+	expectNoReload(func() {
+		writeFile(filepath.Join(fileDir, "other.txt"), "other")
+	})
+
+	// adding a file to a watched config directory reloads
+	waitReload(func() {
+		writeFile(filepath.Join(confDir, "added.yaml"), "added")
+	})
+
+	// removing the config file reloads
+	waitReload(func() {
+		_ = os.Remove(confPath)
+	})
+
+	watchCtxCancel()
+	select {
+	case <-ctx.Done():
+		t.Fatal("expected watcher to exit")
+	case err := <-errCh:
+		if err != nil && err != context.Canceled {
+			t.Fatal(err.Error())
+		}
+	}
+}
@@ -3,9 +3,15 @@ package controller
 import (
 	"context"
 
+	"github.com/aperturerobotics/controllerbus/config"
 	"github.com/aperturerobotics/controllerbus/directive"
 )
 
+// PprofLabel is the pprof goroutine label set to the controller id.
+//
+// The bus sets the label while executing the controller and its resolvers.
+const PprofLabel = "controller"
+
 // Controller tracks a particular process.
 type Controller interface {
 	// Handler handles directives.
@@ -22,3 +28,27 @@ type Controller interface {
 	// Error indicates any issue encountered releasing.
 	Close() error
 }
+
+// ControllerWithReady is a Controller which reports when it is ready.
+//
+// A controller is running once Execute is called, but may need some time to
+// start serving, for example binding a listener. Controllers which do not
+// implement this interface are never reported as ready, only as running.
+type ControllerWithReady interface {
+	Controller
+	// WaitReady waits for the controller to be ready.
+	// Called concurrently with Execute and canceled when Execute returns.
+	// Returns nil once ready or an error if ctx is canceled.
+	WaitReady(ctx context.Context) error
+}
+
+// ControllerWithConfigUpdate is a Controller which can apply a new config
+// without being restarted.
+type ControllerWithConfigUpdate interface {
+	Controller
+	// UpdateConfig applies the new config to the running controller.
+	// The config has the same config ID as the config the controller was
+	// constructed with and has been validated.
+	// Returns false if the controller must be restarted to apply the config.
+	UpdateConfig(ctx context.Context, conf config.Config) (applied bool, err error)
+}
@@ -53,6 +53,7 @@ func (r *ExecControllerRequest) Execute(
 	}
 	confsList := rConfSet.GetConfigs()
 	prevStates := make(map[string]ControllerStatus, len(confsList))
+	prevResps := make(map[string]*ExecControllerResponse, len(confsList))
 	if !allowPartialSuccess && len(rConfSet.GetConfigs()) != 0 {
 		confSet, err = rConfSet.Resolve(ctx, cbus)
 	}
@@ -155,21 +156,10 @@ func (r *ExecControllerRequest) Execute(
 			return subCtx.Err()
 		case csv := <-addedCh:
 			csvID := csv.GetId()
-			csvErr := csv.GetError()
-			resp.Id = csvID
-			if csvErr != nil {
-				resp.Status = ControllerStatus_ControllerStatus_ERROR
-				resp.ErrorInfo = csvErr.Error()
-			} else if ctrl := csv.GetController(); ctrl != nil {
-				ctrlInfo := ctrl.GetControllerInfo()
-				resp.Status = ControllerStatus_ControllerStatus_RUNNING
-				resp.ControllerInfo = ctrlInfo
-			} else {
-				resp.Status = ControllerStatus_ControllerStatus_CONFIGURING
-			}
-			if prevStates[csvID] != resp.Status ||
-				resp.Status != ControllerStatus_ControllerStatus_CONFIGURING {
+			resp.ApplyState(csv)
+			if prevStates[csvID] != resp.Status || !resp.EqualVT(prevResps[csvID]) {
 				prevStates[csvID] = resp.Status
+				prevResps[csvID] = resp.CloneVT()
 				if err := callCb(); err != nil {
 					return err
 				}
@@ -3,13 +3,88 @@ package controller_exec
 import (
 	"errors"
 	"strings"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/controller/configset"
 )
 
+// NewExecControllerResponse constructs a response from a configset controller state.
+func NewExecControllerResponse(st configset.State) *ExecControllerResponse {
+	resp := &ExecControllerResponse{}
+	resp.ApplyState(st)
+	return resp
+}
+
+// ApplyState sets the id, status, controller info and error info from a
+// configset controller state.
+func (e *ExecControllerResponse) ApplyState(st configset.State) {
+	e.Id = st.GetId()
+	if err := st.GetError(); err != nil {
+		e.Status = ControllerStatus_ControllerStatus_ERROR
+		if st.GetQuarantined() {
+			e.Status = ControllerStatus_ControllerStatus_QUARANTINED
+		}
+		e.ErrorInfo = err.Error()
+	} else if ctrl := st.GetController(); ctrl != nil {
+		e.Status = ControllerStatus_ControllerStatus_RUNNING
+		if st.GetReady() {
+			e.Status = ControllerStatus_ControllerStatus_READY
+		}
+		e.ControllerInfo = ctrl.GetControllerInfo()
+	} else {
+		e.Status = ControllerStatus_ControllerStatus_CONFIGURING
+	}
+	if conf := st.GetControllerConfig(); conf != nil {
+		e.ConfigRev = conf.GetRev()
+	}
+	if val := st.GetExecValue(); val != nil {
+		e.RestartCount = val.GetRestartCount()
+		if err := val.GetLastExitError(); err != nil {
+			e.LastExitError = err.Error()
+		}
+		e.LastExitUnixMs = toUnixMs(val.GetLastExitTimestamp())
+		e.NextRetryUnixMs = toUnixMs(val.GetNextRetryTimestamp())
+		if factory := val.GetFactory(); factory != nil {
+			e.FactoryVersion = factory.GetVersion().String()
+		}
+		e.ResolverId = val.GetResolverID()
+	}
+}
+
+// GetLastExitTime returns the last exit time or zero if unset.
+func (e *ExecControllerResponse) GetLastExitTime() time.Time {
+	return fromUnixMs(e.GetLastExitUnixMs())
+}
+
+// GetNextRetryTime returns the next retry time or zero if unset.
+func (e *ExecControllerResponse) GetNextRetryTime() time.Time {
+	return fromUnixMs(e.GetNextRetryUnixMs())
+}
+
+// toUnixMs converts a time to unix milliseconds, zero if t is zero.
+func toUnixMs(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixMilli()) //nolint:gosec
+}
+
+// fromUnixMs converts unix milliseconds to a time, zero if ms is zero.
+func fromUnixMs(ms uint64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(ms)) //nolint:gosec
+}
+
 // GetError returns an error if the response indicated one, or nil for success.
 //
 // If no error info was provided, assumes ErrAllControllersFailed
 func (e *ExecControllerResponse) GetError() error {
-	if e.GetStatus() != ControllerStatus_ControllerStatus_ERROR {
+	switch e.GetStatus() {
+	case ControllerStatus_ControllerStatus_ERROR,
+		ControllerStatus_ControllerStatus_QUARANTINED:
+	default:
 		return nil
 	}
 
@@ -32,6 +107,13 @@ func (e *ExecControllerResponse) FormatLogString() string {
 	}
 	if err := e.GetError(); err != nil {
 		pts = append(pts, err.Error())
+	} else if e.GetStatus() == ControllerStatus_ControllerStatus_CONFIGURING {
+		if lastExitErr := e.GetLastExitError(); lastExitErr != "" {
+			pts = append(pts, "last exit: "+lastExitErr)
+		}
+		if retry := e.GetNextRetryTime(); !retry.IsZero() {
+			pts = append(pts, "retry in "+time.Until(retry).Round(time.Millisecond).String())
+		}
 	}
 	return strings.Join(pts, ": ")
 }
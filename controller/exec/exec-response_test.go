@@ -0,0 +1,136 @@
+package controller_exec
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/configset"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	"github.com/aperturerobotics/controllerbus/controller/loader"
+	controller_mock "github.com/aperturerobotics/controllerbus/controller/mock"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestExecControllerResponseApplyState tests the restart, exit and factory
+// details of the response built from a configset controller state.
+func TestExecControllerResponseApplyState(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// the controller named exiting returns nil immediately
+	sr.AddFactory(&controller_mock.MockFactory{
+		ExecuteFn: func(ctx context.Context, c *controller_mock.MockController) error {
+			if c.GetName() != "exiting" {
+				<-ctx.Done()
+			}
+			return nil
+		},
+	})
+
+	csVal, _, csRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer csRef.Release()
+	csCtrl := csVal.GetValue().(resolver.LoadControllerWithConfigValue).GetController().(configset.Controller)
+
+	// waitResponse waits for the response for the key to match.
+	waitResponse := func(key string, match func(resp *ExecControllerResponse) bool) *ExecControllerResponse {
+		var resp *ExecControllerResponse
+		for {
+			for _, st := range csCtrl.GetControllerStates() {
+				if st.GetId() == key {
+					resp = NewExecControllerResponse(st)
+				}
+			}
+			if resp != nil && match(resp) {
+				return resp
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("unexpected response for %s: %v", key, resp.String())
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	applier := configset.NewApplier(b)
+	defer applier.Release()
+	if _, err := applier.Apply(configset.ConfigSet{
+		"running": configset.NewControllerConfig(2, &boilerplate.Config{ExampleField: "running"}),
+		"failing": configset.NewControllerConfig(3, &boilerplate.Config{ExampleField: controller_mock.FailName}),
+		"exiting": configset.NewControllerConfigWithOpts(
+			4,
+			&boilerplate.Config{ExampleField: "exiting"},
+			&loader.RestartPolicy{Mode: loader.RestartMode_RestartMode_ALWAYS},
+			nil,
+		),
+	}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// a running controller has the factory and resolver details and no exits
+	resp := waitResponse("running", func(resp *ExecControllerResponse) bool {
+		return resp.GetStatus() == ControllerStatus_ControllerStatus_RUNNING
+	})
+	if resp.GetConfigRev() != 2 ||
+		resp.GetRestartCount() != 0 ||
+		resp.GetLastExitError() != "" ||
+		!resp.GetLastExitTime().IsZero() ||
+		!resp.GetNextRetryTime().IsZero() ||
+		resp.GetFactoryVersion() != boilerplate.Version.String() ||
+		resp.GetResolverId() == "" ||
+		resp.GetControllerInfo().GetId() != boilerplate.ControllerID {
+		t.Fatalf("unexpected running response: %v", resp.String())
+	}
+
+	// a controller which failed has the error and the last exit details
+	resp = waitResponse("failing", func(resp *ExecControllerResponse) bool {
+		return resp.GetStatus() == ControllerStatus_ControllerStatus_ERROR &&
+			resp.GetRestartCount() != 0 &&
+			!resp.GetNextRetryTime().IsZero()
+	})
+	if resp.GetConfigRev() != 3 ||
+		resp.GetErrorInfo() != controller_mock.ErrMockExit.Error() ||
+		resp.GetLastExitError() != controller_mock.ErrMockExit.Error() ||
+		resp.GetLastExitTime().IsZero() ||
+		resp.GetNextRetryTime().Before(resp.GetLastExitTime()) ||
+		resp.GetFactoryVersion() != boilerplate.Version.String() ||
+		resp.GetResolverId() == "" {
+		t.Fatalf("unexpected failing response: %v", resp.String())
+	}
+
+	// a controller waiting to be restarted after exiting without an error is
+	// configuring with the last exit details and no error
+	resp = waitResponse("exiting", func(resp *ExecControllerResponse) bool {
+		return resp.GetStatus() == ControllerStatus_ControllerStatus_CONFIGURING &&
+			resp.GetRestartCount() != 0 &&
+			!resp.GetNextRetryTime().IsZero()
+	})
+	if resp.GetConfigRev() != 4 ||
+		resp.GetErrorInfo() != "" ||
+		resp.GetError() != nil ||
+		resp.GetLastExitError() != "" ||
+		resp.GetLastExitTime().IsZero() ||
+		resp.GetNextRetryTime().Before(resp.GetLastExitTime()) ||
+		resp.GetFactoryVersion() != boilerplate.Version.String() ||
+		resp.GetResolverId() == "" {
+		t.Fatalf("unexpected configuring response: %v", resp.String())
+	}
+}
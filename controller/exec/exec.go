@@ -72,6 +72,8 @@ func ExecuteController(
 		prevState = nextState
 		cb(nextState)
 	}
+	// curr is the most recently added value
+	var curr resolver.LoadControllerWithConfigValue
 	for {
 		select {
 		case <-ctx.Done():
@@ -79,20 +81,30 @@ func ExecuteController(
 		case <-subCtx.Done():
 			return subCtx.Err()
 		case csv := <-addedCh:
+			curr = csv
 			ctrl := csv.GetController()
 			csvErr := csv.GetError()
 			if csvErr != nil {
 				callCb(ControllerStatus_ControllerStatus_ERROR)
 				return csvErr
 			}
-			if ctrl != nil {
-				callCb(ControllerStatus_ControllerStatus_RUNNING)
-			} else {
+			if ctrl == nil {
 				callCb(ControllerStatus_ControllerStatus_CONFIGURING)
+			} else if csv.GetReady() {
+				callCb(ControllerStatus_ControllerStatus_READY)
+			} else {
+				callCb(ControllerStatus_ControllerStatus_RUNNING)
 			}
 		case csv := <-removedCh:
 			// removed == value is no longer applicable.
-			if csv.GetController() != nil && prevState == ControllerStatus_ControllerStatus_RUNNING {
+			// the value is replaced when the controller becomes ready.
+			if csv != curr {
+				continue
+			}
+			curr = nil
+			if csv.GetController() != nil &&
+				(prevState == ControllerStatus_ControllerStatus_RUNNING ||
+					prevState == ControllerStatus_ControllerStatus_READY) {
 				callCb(ControllerStatus_ControllerStatus_CONFIGURING)
 			}
 		}
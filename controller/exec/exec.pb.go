@@ -29,6 +29,12 @@ const (
 	ControllerStatus_ControllerStatus_RUNNING ControllerStatus = 2
 	// ControllerStatus_ERROR indicates the controller is terminated with an error.
 	ControllerStatus_ControllerStatus_ERROR ControllerStatus = 3
+	// ControllerStatus_READY indicates the controller is running and reported it is ready.
+	// Only controllers which report readiness enter this state.
+	ControllerStatus_ControllerStatus_READY ControllerStatus = 4
+	// ControllerStatus_QUARANTINED indicates the controller exited with an error
+	// too many times and will not be restarted until the config changes.
+	ControllerStatus_ControllerStatus_QUARANTINED ControllerStatus = 5
 )
 
 // Enum value maps for ControllerStatus.
@@ -38,12 +44,16 @@ var (
 		1: "ControllerStatus_CONFIGURING",
 		2: "ControllerStatus_RUNNING",
 		3: "ControllerStatus_ERROR",
+		4: "ControllerStatus_READY",
+		5: "ControllerStatus_QUARANTINED",
 	}
 	ControllerStatus_value = map[string]int32{
 		"ControllerStatus_UNKNOWN":     0,
 		"ControllerStatus_CONFIGURING": 1,
 		"ControllerStatus_RUNNING":     2,
 		"ControllerStatus_ERROR":       3,
+		"ControllerStatus_READY":       4,
+		"ControllerStatus_QUARANTINED": 5,
 	}
 )
 
@@ -111,6 +121,23 @@ type ExecControllerResponse struct {
 	ControllerInfo *controller.Info `protobuf:"bytes,3,opt,name=controller_info,json=controllerInfo,proto3" json:"controllerInfo,omitempty"`
 	// ErrorInfo may contain the error information.
 	ErrorInfo string `protobuf:"bytes,4,opt,name=error_info,json=errorInfo,proto3" json:"errorInfo,omitempty"`
+	// ConfigRev is the revision of the controller config in use.
+	ConfigRev uint64 `protobuf:"varint,5,opt,name=config_rev,json=configRev,proto3" json:"configRev,omitempty"`
+	// RestartCount is the number of times the controller was restarted.
+	RestartCount uint32 `protobuf:"varint,6,opt,name=restart_count,json=restartCount,proto3" json:"restartCount,omitempty"`
+	// LastExitError is the error from the last time the controller failed to
+	// construct or exited. Empty if it exited without an error.
+	LastExitError string `protobuf:"bytes,7,opt,name=last_exit_error,json=lastExitError,proto3" json:"lastExitError,omitempty"`
+	// LastExitUnixMs is the last time the controller failed to construct or
+	// exited in unix milliseconds. Zero if it has not exited.
+	LastExitUnixMs uint64 `protobuf:"varint,8,opt,name=last_exit_unix_ms,json=lastExitUnixMs,proto3" json:"lastExitUnixMs,omitempty"`
+	// NextRetryUnixMs is the next time the controller will be started in unix
+	// milliseconds. Zero if no retry is scheduled.
+	NextRetryUnixMs uint64 `protobuf:"varint,9,opt,name=next_retry_unix_ms,json=nextRetryUnixMs,proto3" json:"nextRetryUnixMs,omitempty"`
+	// FactoryVersion is the version of the factory constructing the controller.
+	FactoryVersion string `protobuf:"bytes,10,opt,name=factory_version,json=factoryVersion,proto3" json:"factoryVersion,omitempty"`
+	// ResolverId is the id of the resolver which provided the factory.
+	ResolverId string `protobuf:"bytes,11,opt,name=resolver_id,json=resolverId,proto3" json:"resolverId,omitempty"`
 }
 
 func (x *ExecControllerResponse) Reset() {
@@ -147,6 +174,55 @@ func (x *ExecControllerResponse) GetErrorInfo() string {
 	return ""
 }
 
+func (x *ExecControllerResponse) GetConfigRev() uint64 {
+	if x != nil {
+		return x.ConfigRev
+	}
+	return 0
+}
+
+func (x *ExecControllerResponse) GetRestartCount() uint32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *ExecControllerResponse) GetLastExitError() string {
+	if x != nil {
+		return x.LastExitError
+	}
+	return ""
+}
+
+func (x *ExecControllerResponse) GetLastExitUnixMs() uint64 {
+	if x != nil {
+		return x.LastExitUnixMs
+	}
+	return 0
+}
+
+func (x *ExecControllerResponse) GetNextRetryUnixMs() uint64 {
+	if x != nil {
+		return x.NextRetryUnixMs
+	}
+	return 0
+}
+
+func (x *ExecControllerResponse) GetFactoryVersion() string {
+	if x != nil {
+		return x.FactoryVersion
+	}
+	return ""
+}
+
+func (x *ExecControllerResponse) GetResolverId() string {
+	if x != nil {
+		return x.ResolverId
+	}
+	return ""
+}
+
 func (m *ExecControllerRequest) CloneVT() *ExecControllerRequest {
 	if m == nil {
 		return (*ExecControllerRequest)(nil)
@@ -174,6 +250,13 @@ func (m *ExecControllerResponse) CloneVT() *ExecControllerResponse {
 	r.Status = m.Status
 	r.ControllerInfo = m.ControllerInfo.CloneVT()
 	r.ErrorInfo = m.ErrorInfo
+	r.ConfigRev = m.ConfigRev
+	r.RestartCount = m.RestartCount
+	r.LastExitError = m.LastExitError
+	r.LastExitUnixMs = m.LastExitUnixMs
+	r.NextRetryUnixMs = m.NextRetryUnixMs
+	r.FactoryVersion = m.FactoryVersion
+	r.ResolverId = m.ResolverId
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = slices.Clone(m.unknownFields)
 	}
@@ -228,6 +311,27 @@ func (this *ExecControllerResponse) EqualVT(that *ExecControllerResponse) bool {
 	if this.ErrorInfo != that.ErrorInfo {
 		return false
 	}
+	if this.ConfigRev != that.ConfigRev {
+		return false
+	}
+	if this.RestartCount != that.RestartCount {
+		return false
+	}
+	if this.LastExitError != that.LastExitError {
+		return false
+	}
+	if this.LastExitUnixMs != that.LastExitUnixMs {
+		return false
+	}
+	if this.NextRetryUnixMs != that.NextRetryUnixMs {
+		return false
+	}
+	if this.FactoryVersion != that.FactoryVersion {
+		return false
+	}
+	if this.ResolverId != that.ResolverId {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -369,6 +473,41 @@ func (x *ExecControllerResponse) MarshalProtoJSON(s *json.MarshalState) {
 		s.WriteObjectField("errorInfo")
 		s.WriteString(x.ErrorInfo)
 	}
+	if x.ConfigRev != 0 || s.HasField("configRev") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configRev")
+		s.WriteUint64(x.ConfigRev)
+	}
+	if x.RestartCount != 0 || s.HasField("restartCount") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("restartCount")
+		s.WriteUint32(x.RestartCount)
+	}
+	if x.LastExitError != "" || s.HasField("lastExitError") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("lastExitError")
+		s.WriteString(x.LastExitError)
+	}
+	if x.LastExitUnixMs != 0 || s.HasField("lastExitUnixMs") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("lastExitUnixMs")
+		s.WriteUint64(x.LastExitUnixMs)
+	}
+	if x.NextRetryUnixMs != 0 || s.HasField("nextRetryUnixMs") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("nextRetryUnixMs")
+		s.WriteUint64(x.NextRetryUnixMs)
+	}
+	if x.FactoryVersion != "" || s.HasField("factoryVersion") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("factoryVersion")
+		s.WriteString(x.FactoryVersion)
+	}
+	if x.ResolverId != "" || s.HasField("resolverId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("resolverId")
+		s.WriteString(x.ResolverId)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -402,6 +541,27 @@ func (x *ExecControllerResponse) UnmarshalProtoJSON(s *json.UnmarshalState) {
 		case "error_info", "errorInfo":
 			s.AddField("error_info")
 			x.ErrorInfo = s.ReadString()
+		case "config_rev", "configRev":
+			s.AddField("config_rev")
+			x.ConfigRev = s.ReadUint64()
+		case "restart_count", "restartCount":
+			s.AddField("restart_count")
+			x.RestartCount = s.ReadUint32()
+		case "last_exit_error", "lastExitError":
+			s.AddField("last_exit_error")
+			x.LastExitError = s.ReadString()
+		case "last_exit_unix_ms", "lastExitUnixMs":
+			s.AddField("last_exit_unix_ms")
+			x.LastExitUnixMs = s.ReadUint64()
+		case "next_retry_unix_ms", "nextRetryUnixMs":
+			s.AddField("next_retry_unix_ms")
+			x.NextRetryUnixMs = s.ReadUint64()
+		case "factory_version", "factoryVersion":
+			s.AddField("factory_version")
+			x.FactoryVersion = s.ReadString()
+		case "resolver_id", "resolverId":
+			s.AddField("resolver_id")
+			x.ResolverId = s.ReadString()
 		}
 	})
 }
@@ -501,6 +661,47 @@ func (m *ExecControllerResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.ResolverId) > 0 {
+		i -= len(m.ResolverId)
+		copy(dAtA[i:], m.ResolverId)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ResolverId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FactoryVersion) > 0 {
+		i -= len(m.FactoryVersion)
+		copy(dAtA[i:], m.FactoryVersion)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.FactoryVersion)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.NextRetryUnixMs != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.NextRetryUnixMs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastExitUnixMs != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.LastExitUnixMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.LastExitError) > 0 {
+		i -= len(m.LastExitError)
+		copy(dAtA[i:], m.LastExitError)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.LastExitError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RestartCount != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RestartCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ConfigRev != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.ConfigRev))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.ErrorInfo) > 0 {
 		i -= len(m.ErrorInfo)
 		copy(dAtA[i:], m.ErrorInfo)
@@ -575,6 +776,30 @@ func (m *ExecControllerResponse) SizeVT() (n int) {
 	if l > 0 {
 		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
 	}
+	if m.ConfigRev != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.ConfigRev))
+	}
+	if m.RestartCount != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.RestartCount))
+	}
+	l = len(m.LastExitError)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.LastExitUnixMs != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.LastExitUnixMs))
+	}
+	if m.NextRetryUnixMs != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.NextRetryUnixMs))
+	}
+	l = len(m.FactoryVersion)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	l = len(m.ResolverId)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -648,6 +873,55 @@ func (x *ExecControllerResponse) MarshalProtoText() string {
 		sb.WriteString("error_info: ")
 		sb.WriteString(strconv.Quote(x.ErrorInfo))
 	}
+	if x.ConfigRev != 0 {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("config_rev: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.ConfigRev), 10))
+	}
+	if x.RestartCount != 0 {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("restart_count: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.RestartCount), 10))
+	}
+	if x.LastExitError != "" {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("last_exit_error: ")
+		sb.WriteString(strconv.Quote(x.LastExitError))
+	}
+	if x.LastExitUnixMs != 0 {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("last_exit_unix_ms: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.LastExitUnixMs), 10))
+	}
+	if x.NextRetryUnixMs != 0 {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("next_retry_unix_ms: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.NextRetryUnixMs), 10))
+	}
+	if x.FactoryVersion != "" {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("factory_version: ")
+		sb.WriteString(strconv.Quote(x.FactoryVersion))
+	}
+	if x.ResolverId != "" {
+		if sb.Len() > 24 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("resolver_id: ")
+		sb.WriteString(strconv.Quote(x.ResolverId))
+	}
 	sb.WriteString("}")
 	return sb.String()
 }
@@ -864,6 +1138,108 @@ func (m *ExecControllerResponse) UnmarshalVT(dAtA []byte) error {
 			}
 			m.ErrorInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigRev", wireType)
+			}
+			m.ConfigRev = 0
+			m.ConfigRev, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartCount", wireType)
+			}
+			m.RestartCount = 0
+			m.RestartCount, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExitError", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastExitError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExitUnixMs", wireType)
+			}
+			m.LastExitUnixMs = 0
+			m.LastExitUnixMs, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryUnixMs", wireType)
+			}
+			m.NextRetryUnixMs = 0
+			m.NextRetryUnixMs, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryVersion", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverId", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolverId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
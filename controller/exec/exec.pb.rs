@@ -29,6 +29,30 @@ pub struct ExecControllerResponse {
     /// ErrorInfo may contain the error information.
     #[prost(string, tag="4")]
     pub error_info: ::prost::alloc::string::String,
+    /// ConfigRev is the revision of the controller config in use.
+    #[prost(uint64, tag="5")]
+    pub config_rev: u64,
+    /// RestartCount is the number of times the controller was restarted.
+    #[prost(uint32, tag="6")]
+    pub restart_count: u32,
+    /// LastExitError is the error from the last time the controller failed to
+    /// construct or exited. Empty if it exited without an error.
+    #[prost(string, tag="7")]
+    pub last_exit_error: ::prost::alloc::string::String,
+    /// LastExitUnixMs is the last time the controller failed to construct or
+    /// exited in unix milliseconds. Zero if it has not exited.
+    #[prost(uint64, tag="8")]
+    pub last_exit_unix_ms: u64,
+    /// NextRetryUnixMs is the next time the controller will be started in unix
+    /// milliseconds. Zero if no retry is scheduled.
+    #[prost(uint64, tag="9")]
+    pub next_retry_unix_ms: u64,
+    /// FactoryVersion is the version of the factory constructing the controller.
+    #[prost(string, tag="10")]
+    pub factory_version: ::prost::alloc::string::String,
+    /// ResolverId is the id of the resolver which provided the factory.
+    #[prost(string, tag="11")]
+    pub resolver_id: ::prost::alloc::string::String,
 }
 /// ControllerStatus holds basic status for a controller.
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
@@ -42,6 +66,12 @@ pub enum ControllerStatus {
     Running = 2,
     /// ControllerStatus_ERROR indicates the controller is terminated with an error.
     Error = 3,
+    /// ControllerStatus_READY indicates the controller is running and reported it is ready.
+    /// Only controllers which report readiness enter this state.
+    Ready = 4,
+    /// ControllerStatus_QUARANTINED indicates the controller exited with an error
+    /// too many times and will not be restarted until the config changes.
+    Quarantined = 5,
 }
 impl ControllerStatus {
     /// String value of the enum field names used in the ProtoBuf definition.
@@ -54,6 +84,8 @@ impl ControllerStatus {
             Self::Configuring => "ControllerStatus_CONFIGURING",
             Self::Running => "ControllerStatus_RUNNING",
             Self::Error => "ControllerStatus_ERROR",
+            Self::Ready => "ControllerStatus_READY",
+            Self::Quarantined => "ControllerStatus_QUARANTINED",
         }
     }
     /// Creates an enum from field names used in the ProtoBuf definition.
@@ -63,6 +95,8 @@ impl ControllerStatus {
             "ControllerStatus_CONFIGURING" => Some(Self::Configuring),
             "ControllerStatus_RUNNING" => Some(Self::Running),
             "ControllerStatus_ERROR" => Some(Self::Error),
+            "ControllerStatus_READY" => Some(Self::Ready),
+            "ControllerStatus_QUARANTINED" => Some(Self::Quarantined),
             _ => None,
         }
     }
@@ -46,6 +46,22 @@ export enum ControllerStatus {
    * @generated from enum value: ControllerStatus_ERROR = 3;
    */
   ControllerStatus_ERROR = 3,
+
+  /**
+   * ControllerStatus_READY indicates the controller is running and reported it is ready.
+   * Only controllers which report readiness enter this state.
+   *
+   * @generated from enum value: ControllerStatus_READY = 4;
+   */
+  ControllerStatus_READY = 4,
+
+  /**
+   * ControllerStatus_QUARANTINED indicates the controller exited with an error
+   * too many times and will not be restarted until the config changes.
+   *
+   * @generated from enum value: ControllerStatus_QUARANTINED = 5;
+   */
+  ControllerStatus_QUARANTINED = 5,
 }
 
 // ControllerStatus_Enum is the enum type for ControllerStatus.
@@ -56,6 +72,8 @@ export const ControllerStatus_Enum = createEnumType(
     { no: 1, name: 'ControllerStatus_CONFIGURING' },
     { no: 2, name: 'ControllerStatus_RUNNING' },
     { no: 3, name: 'ControllerStatus_ERROR' },
+    { no: 4, name: 'ControllerStatus_READY' },
+    { no: 5, name: 'ControllerStatus_QUARANTINED' },
   ],
 )
 
@@ -133,6 +151,51 @@ export interface ExecControllerResponse {
    * @generated from field: string error_info = 4;
    */
   errorInfo?: string
+  /**
+   * ConfigRev is the revision of the controller config in use.
+   *
+   * @generated from field: uint64 config_rev = 5;
+   */
+  configRev?: bigint
+  /**
+   * RestartCount is the number of times the controller was restarted.
+   *
+   * @generated from field: uint32 restart_count = 6;
+   */
+  restartCount?: number
+  /**
+   * LastExitError is the error from the last time the controller failed to
+   * construct or exited. Empty if it exited without an error.
+   *
+   * @generated from field: string last_exit_error = 7;
+   */
+  lastExitError?: string
+  /**
+   * LastExitUnixMs is the last time the controller failed to construct or
+   * exited in unix milliseconds. Zero if it has not exited.
+   *
+   * @generated from field: uint64 last_exit_unix_ms = 8;
+   */
+  lastExitUnixMs?: bigint
+  /**
+   * NextRetryUnixMs is the next time the controller will be started in unix
+   * milliseconds. Zero if no retry is scheduled.
+   *
+   * @generated from field: uint64 next_retry_unix_ms = 9;
+   */
+  nextRetryUnixMs?: bigint
+  /**
+   * FactoryVersion is the version of the factory constructing the controller.
+   *
+   * @generated from field: string factory_version = 10;
+   */
+  factoryVersion?: string
+  /**
+   * ResolverId is the id of the resolver which provided the factory.
+   *
+   * @generated from field: string resolver_id = 11;
+   */
+  resolverId?: string
 }
 
 // ExecControllerResponse contains the message type declaration for ExecControllerResponse.
@@ -144,6 +207,23 @@ export const ExecControllerResponse: MessageType<ExecControllerResponse> =
       { no: 2, name: 'status', kind: 'enum', T: ControllerStatus_Enum },
       { no: 3, name: 'controller_info', kind: 'message', T: () => Info },
       { no: 4, name: 'error_info', kind: 'scalar', T: ScalarType.STRING },
+      { no: 5, name: 'config_rev', kind: 'scalar', T: ScalarType.UINT64 },
+      { no: 6, name: 'restart_count', kind: 'scalar', T: ScalarType.UINT32 },
+      { no: 7, name: 'last_exit_error', kind: 'scalar', T: ScalarType.STRING },
+      {
+        no: 8,
+        name: 'last_exit_unix_ms',
+        kind: 'scalar',
+        T: ScalarType.UINT64,
+      },
+      {
+        no: 9,
+        name: 'next_retry_unix_ms',
+        kind: 'scalar',
+        T: ScalarType.UINT64,
+      },
+      { no: 10, name: 'factory_version', kind: 'scalar', T: ScalarType.STRING },
+      { no: 11, name: 'resolver_id', kind: 'scalar', T: ScalarType.STRING },
     ] as readonly PartialFieldInfo[],
     packedByDefault: true,
   })
@@ -59,3 +59,10 @@ type FactoryResolver interface {
 	// If an unexpected error occurs, return it.
 	GetFactoryMatchingConfig(ctx context.Context, conf config.Config) (Factory, error)
 }
+
+// FactoryLister is an optional side interface for FactoryResolver which can
+// enumerate the available factories.
+type FactoryLister interface {
+	// ListFactories returns the factories available from the resolver.
+	ListFactories(ctx context.Context) ([]Factory, error)
+}
@@ -12,6 +12,12 @@ type execControllerValue struct {
 	retryTimestamp   time.Time
 	ctrl             controller.Controller
 	err              error
+	restartCount     uint32
+	ready            bool
+
+	factory           controller.Factory
+	lastExitErr       error
+	lastExitTimestamp time.Time
 }
 
 // NewExecControllerValue builds a new ExecControllerValue
@@ -29,6 +35,26 @@ func NewExecControllerValue(
 	}
 }
 
+// NewExecControllerValueWithStatus builds a new ExecControllerValue with the
+// number of times the controller was restarted and if it is ready.
+func NewExecControllerValueWithStatus(
+	updatedTimestamp time.Time,
+	retryTimestamp time.Time,
+	ctrl controller.Controller,
+	err error,
+	restartCount uint32,
+	ready bool,
+) ExecControllerValue {
+	return &execControllerValue{
+		updatedTimestamp: updatedTimestamp,
+		retryTimestamp:   retryTimestamp,
+		ctrl:             ctrl,
+		err:              err,
+		restartCount:     restartCount,
+		ready:            ready,
+	}
+}
+
 // GetUpdatedTimestamp returns the last time this info changed.
 func (v *execControllerValue) GetUpdatedTimestamp() time.Time {
 	return v.updatedTimestamp
@@ -50,5 +76,33 @@ func (v *execControllerValue) GetError() error {
 	return v.err
 }
 
+// GetRestartCount returns the number of times the controller was restarted.
+func (v *execControllerValue) GetRestartCount() uint32 {
+	return v.restartCount
+}
+
+// GetReady returns if the controller reported it is ready.
+func (v *execControllerValue) GetReady() bool {
+	return v.ready
+}
+
+// GetFactory returns the factory constructing the controller.
+// May be nil.
+func (v *execControllerValue) GetFactory() controller.Factory {
+	return v.factory
+}
+
+// GetLastExitError returns the error from the last time the controller
+// failed to construct or Execute returned.
+func (v *execControllerValue) GetLastExitError() error {
+	return v.lastExitErr
+}
+
+// GetLastExitTimestamp returns the last time the controller failed to
+// construct or Execute returned.
+func (v *execControllerValue) GetLastExitTimestamp() time.Time {
+	return v.lastExitTimestamp
+}
+
 // _ is a type assertion
 var _ ExecControllerValue = ((*execControllerValue)(nil))
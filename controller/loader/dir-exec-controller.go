@@ -12,10 +12,11 @@ import (
 // execController implements the ExecController directive.
 // Will override or yield to exiting directives for the controller.
 type execController struct {
-	factory      controller.Factory
-	config       config.Config
-	retryBackoff func() backoff.BackOff
-	valueOpts    directive.ValueOptions
+	factory       controller.Factory
+	config        config.Config
+	retryBackoff  func() backoff.BackOff
+	restartPolicy *RestartPolicy
+	valueOpts     directive.ValueOptions
 }
 
 // NewExecController constructs a new ExecController directive.
@@ -44,6 +45,24 @@ func NewExecControllerWithOpts(
 	}
 }
 
+// NewExecControllerWithRestartPolicy constructs a new ExecController directive
+// with a policy for restarting the controller after Execute returns.
+func NewExecControllerWithRestartPolicy(
+	factory controller.Factory,
+	config config.Config,
+	retryBackoff func() backoff.BackOff,
+	restartPolicy *RestartPolicy,
+	valueOpts directive.ValueOptions,
+) ExecController {
+	return &execController{
+		factory:       factory,
+		config:        config,
+		retryBackoff:  retryBackoff,
+		restartPolicy: restartPolicy,
+		valueOpts:     valueOpts,
+	}
+}
+
 // GetExecControllerFactory returns the factory desired to load.
 func (d *execController) GetExecControllerFactory() controller.Factory {
 	return d.factory
@@ -60,6 +79,13 @@ func (d *execController) GetExecControllerRetryBackoff() func() backoff.BackOff
 	return d.retryBackoff
 }
 
+// GetExecControllerRestartPolicy returns the policy for restarting the
+// controller after Execute returns.
+// If nil, restarts the controller when Execute returns an error.
+func (d *execController) GetExecControllerRestartPolicy() *RestartPolicy {
+	return d.restartPolicy
+}
+
 // GetValueOptions returns options relating to value handling.
 func (d *execController) GetValueOptions() directive.ValueOptions {
 	return d.valueOpts
@@ -76,6 +102,10 @@ func (d *execController) Validate() error {
 		return err
 	}
 
+	if err := d.restartPolicy.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -94,6 +124,10 @@ func (d *execController) IsEquivalent(other directive.Directive) bool {
 		return false
 	}
 
+	if !d.GetExecControllerRestartPolicy().EqualVT(otherExec.GetExecControllerRestartPolicy()) {
+		return false
+	}
+
 	// If the two configurations are identical, de-duplicate the directive.
 	return d.GetExecControllerConfig().EqualsConfig(otherExec.GetExecControllerConfig())
 }
@@ -123,6 +157,9 @@ func (d *execController) GetName() string {
 func (d *execController) GetDebugVals() directive.DebugValues {
 	vals := directive.NewDebugValues()
 	vals["config-id"] = []string{d.GetExecControllerConfig().GetConfigID()}
+	if d.restartPolicy != nil {
+		vals["restart-mode"] = []string{d.restartPolicy.GetMode().String()}
+	}
 	dbg, dbgOk := d.config.(config.Debuggable)
 	if dbgOk {
 		dbgVals := dbg.GetDebugVals()
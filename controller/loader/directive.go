@@ -23,6 +23,10 @@ type ExecController interface {
 	// GetExecControllerRetryBackoff returns the backoff to use for retries.
 	// If empty / nil, uses the default.
 	GetExecControllerRetryBackoff() func() backoff.BackOff
+	// GetExecControllerRestartPolicy returns the policy for restarting the
+	// controller after Execute returns.
+	// If nil, restarts the controller when Execute returns an error.
+	GetExecControllerRestartPolicy() *RestartPolicy
 }
 
 // ExecControllerValue is the value emitted to satisfy the ExecController
@@ -37,6 +41,20 @@ type ExecControllerValue interface {
 	// GetError returns the error running the controller.
 	// Controller may still be set in this case.
 	GetError() error
+	// GetRestartCount returns the number of times the controller was restarted.
+	GetRestartCount() uint32
+	// GetReady returns if the controller reported it is ready.
+	// Only controllers implementing controller.ControllerWithReady are ready.
+	GetReady() bool
+	// GetFactory returns the factory constructing the controller.
+	// May be nil.
+	GetFactory() controller.Factory
+	// GetLastExitError returns the error from the last time the controller
+	// failed to construct or Execute returned. Nil if it returned nil.
+	GetLastExitError() error
+	// GetLastExitTimestamp returns the last time the controller failed to
+	// construct or Execute returned. Zero if it has not exited yet.
+	GetLastExitTimestamp() time.Time
 }
 
 // _ is a type assertion
@@ -17,6 +17,32 @@ func WaitExecControllerRunning(
 	b bus.Bus,
 	dir directive.Directive,
 	disposeCb func(),
+) (controller.Controller, directive.Instance, directive.Reference, error) {
+	return waitExecController(ctx, b, dir, disposeCb, false)
+}
+
+// WaitExecControllerReady executes any directive which yields
+// ExecControllerValue and waits for either a error or ready state before
+// returning. Disposed is called if the state leaves RUNNING.
+//
+// Controllers which do not implement controller.ControllerWithReady are
+// returned once running.
+func WaitExecControllerReady(
+	ctx context.Context,
+	b bus.Bus,
+	dir directive.Directive,
+	disposeCb func(),
+) (controller.Controller, directive.Instance, directive.Reference, error) {
+	return waitExecController(ctx, b, dir, disposeCb, true)
+}
+
+// waitExecController waits for the controller to be running or ready.
+func waitExecController(
+	ctx context.Context,
+	b bus.Bus,
+	dir directive.Directive,
+	disposeCb func(),
+	waitReady bool,
 ) (controller.Controller, directive.Instance, directive.Reference, error) {
 	subCtx, subCtxCancel := context.WithCancel(ctx)
 	dispose := func() {
@@ -57,9 +83,16 @@ func WaitExecControllerRunning(
 				diRef.Release()
 				return nil, nil, nil, err
 			}
-			if ctrl := val.GetController(); ctrl != nil {
-				return ctrl, di, diRef, nil
+			ctrl := val.GetController()
+			if ctrl == nil {
+				continue
+			}
+			if waitReady && !val.GetReady() {
+				if _, ok := ctrl.(controller.ControllerWithReady); ok {
+					continue
+				}
 			}
+			return ctrl, di, diRef, nil
 		}
 	}
 }
@@ -81,9 +114,40 @@ func WaitExecControllerRunningTyped[T controller.Controller](
 	if err != nil {
 		return empty, di, diRef, err
 	}
+	return checkExecControllerType[T](ctrl, di, diRef)
+}
+
+// WaitExecControllerReadyTyped executes any directive which yields
+// ExecControllerValue and waits for either a error or ready state before
+// returning. Disposed is called if the state leaves RUNNING.
+//
+// If the controller is not of type T an error will be returned and the
+// reference will be released immediately.
+func WaitExecControllerReadyTyped[T controller.Controller](
+	ctx context.Context,
+	b bus.Bus,
+	dir directive.Directive,
+	disposeCb func(),
+) (T, directive.Instance, directive.Reference, error) {
+	var empty T
+	ctrl, di, diRef, err := WaitExecControllerReady(ctx, b, dir, disposeCb)
+	if err != nil {
+		return empty, di, diRef, err
+	}
+	return checkExecControllerType[T](ctrl, di, diRef)
+}
+
+// checkExecControllerType checks the controller is of type T.
+// Releases the reference if not.
+func checkExecControllerType[T controller.Controller](
+	ctrl controller.Controller,
+	di directive.Instance,
+	diRef directive.Reference,
+) (T, directive.Instance, directive.Reference, error) {
 	ctrlt, ok := ctrl.(T)
 	if !ok {
 		diRef.Release()
+		var empty T
 		return empty, di, diRef, errors.New("exec controller constructed unexpected controller type")
 	}
 	return ctrlt, di, diRef, nil
@@ -0,0 +1,70 @@
+package loader_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	controller_exec "github.com/aperturerobotics/controllerbus/controller/exec"
+	"github.com/aperturerobotics/controllerbus/controller/loader"
+	controller_mock "github.com/aperturerobotics/controllerbus/controller/mock"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	"github.com/sirupsen/logrus"
+)
+
+// TestWaitExecControllerReady tests waiting for a controller to report ready.
+func TestWaitExecControllerReady(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, sr, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	factory := &controller_mock.MockFactory{ReadyCh: make(chan struct{})}
+	sr.AddFactory(factory)
+
+	// the status is running until the controller is ready
+	statusCh := make(chan controller_exec.ControllerStatus, 10)
+	go func() {
+		_ = controller_exec.ExecuteController(ctx, b, &boilerplate.Config{ExampleField: "ready"}, func(st controller_exec.ControllerStatus) {
+			statusCh <- st
+		})
+	}()
+	waitStatus := func(status controller_exec.ControllerStatus) {
+		for {
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected status %v", status)
+			case st := <-statusCh:
+				if st == status {
+					return
+				}
+			}
+		}
+	}
+	waitStatus(controller_exec.ControllerStatus_ControllerStatus_RUNNING)
+
+	readyErrCh := make(chan error, 1)
+	go func() {
+		_, _, ref, err := loader.WaitExecControllerReady(ctx, b, loader.NewExecController(factory, &boilerplate.Config{ExampleField: "ready"}), nil)
+		if err == nil {
+			defer ref.Release()
+		}
+		readyErrCh <- err
+	}()
+
+	select {
+	case err := <-readyErrCh:
+		t.Fatalf("expected to wait for ready but got: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(factory.ReadyCh)
+	waitStatus(controller_exec.ControllerStatus_ControllerStatus_READY)
+	if err := <-readyErrCh; err != nil {
+		t.Fatal(err.Error())
+	}
+}
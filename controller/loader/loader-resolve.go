@@ -65,12 +65,18 @@ func (c *resolver) Resolve(ctx context.Context, vh directive.ResolverHandler) er
 		execBackoff = newExecBackoff()
 	}
 
+	restartPolicy := c.dir.GetExecControllerRestartPolicy()
+	restarts := newRestartCounter(restartPolicy)
+
 	configID := factory.GetConfigID()
 	le := c.controller.le.WithField("config", configID)
 	bus := c.controller.bus
 
 	// execute the controller w/ retry backoff.
 	var lastErr error
+	// lastExit is the last time the controller failed to construct or exited
+	var lastExit time.Time
+	var restart bool
 	var execNextBo time.Duration
 	var ci controller.Controller
 	closeCi := func() {
@@ -83,15 +89,38 @@ func (c *resolver) Resolve(ctx context.Context, vh directive.ResolverHandler) er
 		}
 	}
 
+	// newValue builds a value with the current restart count and last exit.
+	newValue := func(
+		updated, retry time.Time,
+		ctrl controller.Controller,
+		err error,
+		ready bool,
+	) ExecControllerValue {
+		return &execControllerValue{
+			updatedTimestamp:  updated,
+			retryTimestamp:    retry,
+			ctrl:              ctrl,
+			err:               err,
+			restartCount:      restarts.count,
+			ready:             ready,
+			factory:           factory,
+			lastExitErr:       lastErr,
+			lastExitTimestamp: lastExit,
+		}
+	}
+
 	for {
 		// Clear any old values
 		_ = vh.ClearValues()
 
-		// if lastErr == nil: first run
-		if lastErr != nil {
+		// if lastErr == nil && !restart: first run
+		if lastErr != nil || restart {
 			execNextBo = execBackoff.NextBackOff()
 			if execNextBo == backoff.Stop {
 				closeCi()
+				if lastErr == nil {
+					return errors.New("backoff timeout exceeded")
+				}
 				return errors.Wrap(lastErr, "backoff timeout exceeded")
 			}
 		} else {
@@ -108,12 +137,7 @@ func (c *resolver) Resolve(ctx context.Context, vh directive.ResolverHandler) er
 
 			// emit the value
 			now := time.Now()
-			vid, vidOk := vh.AddValue(NewExecControllerValue(
-				now,
-				now.Add(execNextBo),
-				nil,
-				lastErr,
-			))
+			vid, vidOk := vh.AddValue(newValue(now, now.Add(execNextBo), nil, lastErr, false))
 
 			select {
 			case <-ctx.Done():
@@ -136,6 +160,7 @@ func (c *resolver) Resolve(ctx context.Context, vh directive.ResolverHandler) er
 			)
 			if lastErr != nil {
 				ci = nil
+				lastExit = time.Now()
 				continue
 			}
 			if ci == nil {
@@ -146,26 +171,45 @@ func (c *resolver) Resolve(ctx context.Context, vh directive.ResolverHandler) er
 		}
 
 		// emit the value
-		vid, vidOk := vh.AddValue(NewExecControllerValue(
-			t1,
-			time.Time{},
-			ci,
-			nil,
-		))
+		vid, vidOk := vh.AddValue(newValue(t1, time.Time{}, ci, nil, false))
+
+		// replace the value once the controller reports it is ready
+		readyCtx, readyCtxCancel := context.WithCancel(ctx)
+		var readyDone chan struct{}
+		if readyCi, ok := ci.(controller.ControllerWithReady); ok {
+			readyDone = make(chan struct{})
+			go func() {
+				defer close(readyDone)
+				if err := readyCi.WaitReady(readyCtx); err != nil || readyCtx.Err() != nil {
+					return
+				}
+				le.Debug("controller is ready")
+				readyVid, readyVidOk := vh.AddValue(newValue(t1, time.Time{}, ci, nil, true))
+				if vidOk {
+					vh.RemoveValue(vid)
+				}
+				vid, vidOk = readyVid, readyVidOk
+			}()
+		}
 
 		// run execute
 		le.Debug("starting controller")
 		execErr := bus.ExecuteController(c.ctx, ci)
+		readyCtxCancel()
+		if readyDone != nil {
+			<-readyDone
+		}
 
 		le := le.WithField("exec-dur", time.Since(t1).String())
 		ctxCanceled := ctx.Err() != nil
 		if execErr != nil && (!ctxCanceled || execErr != context.Canceled) {
 			le.WithError(execErr).Warn("controller exited with error")
-			lastErr = execErr
 		} else {
 			// controller was canceled or returned nil error
 			le.Debug("controller exited normally")
 		}
+		lastErr = execErr
+		lastExit = time.Now()
 
 		// context was canceled, return now.
 		if ctxCanceled {
@@ -175,11 +219,37 @@ func (c *resolver) Resolve(ctx context.Context, vh directive.ResolverHandler) er
 			return context.Canceled
 		}
 
-		// an error occurred, try again.
-		if execErr != nil {
+		// check if the controller should be restarted.
+		restart = restartPolicy.ShouldRestart(execErr)
+		var stopErr error
+		if restart && !restarts.add(time.Now()) {
+			restart = false
+			stopErr = restarts.errMaxRestarts(execErr)
+		} else if !restart {
+			stopErr = execErr
+		}
+
+		if restart {
+			// the controller stays attached if Execute returned nil.
+			if execErr == nil {
+				bus.RemoveController(ci)
+			}
+			le.
+				WithField("restart-count", restarts.count).
+				Debug("restarting controller")
 			continue
 		}
 
+		// the controller will not be restarted: emit the error and exit.
+		if stopErr != nil {
+			le.WithError(stopErr).Warn("controller will not be restarted")
+			_ = vh.ClearValues()
+			bus.RemoveController(ci)
+			closeCi()
+			_, _ = vh.AddValue(newValue(time.Now(), time.Time{}, nil, stopErr, false))
+			return stopErr
+		}
+
 		// controller Execute() is complete.
 		// note: we need to take care to RemoveController later
 		if vidOk {
@@ -0,0 +1,105 @@
+package loader
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Validate validates the restart policy.
+func (p *RestartPolicy) Validate() error {
+	if _, ok := RestartMode_name[int32(p.GetMode())]; !ok {
+		return errors.Errorf("unknown restart mode: %v", p.GetMode())
+	}
+	if _, err := p.ParseWindowDur(); err != nil {
+		return errors.Wrap(err, "window_dur")
+	}
+	return nil
+}
+
+// ParseWindowDur parses the restart window duration if set.
+func (p *RestartPolicy) ParseWindowDur() (time.Duration, error) {
+	var dur time.Duration
+	if windowDur := p.GetWindowDur(); windowDur != "" {
+		var err error
+		dur, err = time.ParseDuration(windowDur)
+		if err != nil {
+			return 0, err
+		}
+		if dur < 0 {
+			return 0, errors.Errorf("duration cannot be negative: %s", windowDur)
+		}
+	}
+	return dur, nil
+}
+
+// ShouldRestart checks if the mode restarts a controller which returned execErr.
+func (p *RestartPolicy) ShouldRestart(execErr error) bool {
+	switch p.GetMode() {
+	case RestartMode_RestartMode_ALWAYS:
+		return true
+	case RestartMode_RestartMode_NEVER:
+		return false
+	default:
+		return execErr != nil
+	}
+}
+
+// restartCounter counts controller restarts within the policy window.
+type restartCounter struct {
+	// maxRestarts is the max number of restarts within the window
+	// if zero, the number of restarts is not limited
+	maxRestarts uint32
+	// window is the window to count restarts within
+	// if zero, all restarts are counted
+	window time.Duration
+	// restarts contains the timestamps of the restarts within the window
+	// only tracked if maxRestarts is set
+	restarts []time.Time
+	// count is the total number of restarts
+	count uint32
+}
+
+// newRestartCounter constructs a restartCounter for the policy.
+func newRestartCounter(policy *RestartPolicy) *restartCounter {
+	// the policy is validated with the directive
+	window, _ := policy.ParseWindowDur()
+	return &restartCounter{
+		maxRestarts: policy.GetMaxRestarts(),
+		window:      window,
+	}
+}
+
+// add records a restart at the given time.
+// returns false if the restart would exceed the max restarts within the window.
+func (r *restartCounter) add(now time.Time) bool {
+	if r.maxRestarts != 0 {
+		if r.window != 0 {
+			cutoff := now.Add(-r.window)
+			var i int
+			for i < len(r.restarts) && !r.restarts[i].After(cutoff) {
+				i++
+			}
+			r.restarts = r.restarts[i:]
+		}
+		if uint32(len(r.restarts)) >= r.maxRestarts {
+			return false
+		}
+		r.restarts = append(r.restarts, now)
+	}
+	r.count++
+	return true
+}
+
+// errMaxRestarts builds the error returned when the max restarts is exceeded.
+func (r *restartCounter) errMaxRestarts(execErr error) error {
+	msg := "exceeded " + strconv.FormatUint(uint64(r.maxRestarts), 10) + " restarts"
+	if r.window != 0 {
+		msg += " within " + r.window.String()
+	}
+	if execErr != nil {
+		return errors.Wrap(execErr, msg)
+	}
+	return errors.New(msg)
+}
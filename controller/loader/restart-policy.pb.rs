@@ -0,0 +1,54 @@
+// @generated
+// This file is @generated by prost-build.
+/// RestartPolicy controls restarting a controller after Execute returns.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct RestartPolicy {
+    /// Mode controls when the controller is restarted.
+    #[prost(enumeration="RestartMode", tag="1")]
+    pub mode: i32,
+    /// MaxRestarts is the maximum number of restarts within window_dur.
+    /// If zero, the number of restarts is not limited.
+    #[prost(uint32, tag="2")]
+    pub max_restarts: u32,
+    /// WindowDur is the window to count restarts within as a duration string.
+    /// If empty, all restarts are counted.
+    /// Example: 5m
+    #[prost(string, tag="3")]
+    pub window_dur: ::prost::alloc::string::String,
+}
+/// RestartMode controls when the loader restarts an exited controller.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum RestartMode {
+    /// RestartMode_ON_FAILURE restarts the controller when Execute returns an error.
+    /// A controller returning nil stays attached to the bus and is not restarted.
+    OnFailure = 0,
+    /// RestartMode_ALWAYS restarts the controller whenever Execute returns.
+    Always = 1,
+    /// RestartMode_NEVER never restarts the controller.
+    /// A controller returning an error is removed and the error is returned.
+    Never = 2,
+}
+impl RestartMode {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::OnFailure => "RestartMode_ON_FAILURE",
+            Self::Always => "RestartMode_ALWAYS",
+            Self::Never => "RestartMode_NEVER",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "RestartMode_ON_FAILURE" => Some(Self::OnFailure),
+            "RestartMode_ALWAYS" => Some(Self::Always),
+            "RestartMode_NEVER" => Some(Self::Never),
+            _ => None,
+        }
+    }
+}
+// @@protoc_insertion_point(module)
@@ -0,0 +1,92 @@
+// @generated by protoc-gen-es-lite unknown with parameter "target=ts,ts_nocheck=false"
+// @generated from file github.com/aperturerobotics/controllerbus/controller/loader/restart-policy.proto (package loader, syntax proto3)
+/* eslint-disable */
+
+import type { MessageType, PartialFieldInfo } from '@aptre/protobuf-es-lite'
+import {
+  createEnumType,
+  createMessageType,
+  ScalarType,
+} from '@aptre/protobuf-es-lite'
+
+export const protobufPackage = 'loader'
+
+/**
+ * RestartMode controls when the loader restarts an exited controller.
+ *
+ * @generated from enum loader.RestartMode
+ */
+export enum RestartMode {
+  /**
+   * RestartMode_ON_FAILURE restarts the controller when Execute returns an error.
+   * A controller returning nil stays attached to the bus and is not restarted.
+   *
+   * @generated from enum value: RestartMode_ON_FAILURE = 0;
+   */
+  RestartMode_ON_FAILURE = 0,
+
+  /**
+   * RestartMode_ALWAYS restarts the controller whenever Execute returns.
+   *
+   * @generated from enum value: RestartMode_ALWAYS = 1;
+   */
+  RestartMode_ALWAYS = 1,
+
+  /**
+   * RestartMode_NEVER never restarts the controller.
+   * A controller returning an error is removed and the error is returned.
+   *
+   * @generated from enum value: RestartMode_NEVER = 2;
+   */
+  RestartMode_NEVER = 2,
+}
+
+// RestartMode_Enum is the enum type for RestartMode.
+export const RestartMode_Enum = createEnumType(
+  'loader.RestartMode',
+  [
+    { no: 0, name: 'RestartMode_ON_FAILURE' },
+    { no: 1, name: 'RestartMode_ALWAYS' },
+    { no: 2, name: 'RestartMode_NEVER' },
+  ],
+)
+
+/**
+ * RestartPolicy controls restarting a controller after Execute returns.
+ *
+ * @generated from message loader.RestartPolicy
+ */
+export interface RestartPolicy {
+  /**
+   * Mode controls when the controller is restarted.
+   *
+   * @generated from field: loader.RestartMode mode = 1;
+   */
+  mode?: RestartMode
+  /**
+   * MaxRestarts is the maximum number of restarts within window_dur.
+   * If zero, the number of restarts is not limited.
+   *
+   * @generated from field: uint32 max_restarts = 2;
+   */
+  maxRestarts?: number
+  /**
+   * WindowDur is the window to count restarts within as a duration string.
+   * If empty, all restarts are counted.
+   * Example: 5m
+   *
+   * @generated from field: string window_dur = 3;
+   */
+  windowDur?: string
+}
+
+// RestartPolicy contains the message type declaration for RestartPolicy.
+export const RestartPolicy: MessageType<RestartPolicy> = createMessageType({
+  typeName: 'loader.RestartPolicy',
+  fields: [
+    { no: 1, name: 'mode', kind: 'enum', T: RestartMode_Enum },
+    { no: 2, name: 'max_restarts', kind: 'scalar', T: ScalarType.UINT32 },
+    { no: 3, name: 'window_dur', kind: 'scalar', T: ScalarType.STRING },
+  ] as readonly PartialFieldInfo[],
+  packedByDefault: true,
+})
@@ -0,0 +1,113 @@
+package loader_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller/loader"
+	controller_mock "github.com/aperturerobotics/controllerbus/controller/mock"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	backoff "github.com/aperturerobotics/util/backoff/cbackoff"
+	"github.com/sirupsen/logrus"
+)
+
+// TestRestartPolicy tests restarting controllers with the restart policies.
+func TestRestartPolicy(t *testing.T) {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	retryBackoff := func() backoff.BackOff {
+		return backoff.NewConstantBackOff(time.Millisecond)
+	}
+
+	// exitImmediately returns immediately instead of waiting for ctx.
+	exitImmediately := func(ctx context.Context, c *controller_mock.MockController) error {
+		return nil
+	}
+
+	// exec runs the controller until the value matches.
+	exec := func(
+		name string,
+		policy *loader.RestartPolicy,
+		match func(val loader.ExecControllerValue) bool,
+	) (*controller_mock.MockFactory, loader.ExecControllerValue, directive.Reference) {
+		factory := &controller_mock.MockFactory{ExecuteFn: exitImmediately}
+		dir := loader.NewExecControllerWithRestartPolicy(
+			factory,
+			&boilerplate.Config{ExampleField: name},
+			retryBackoff,
+			policy,
+			directive.ValueOptions{},
+		)
+		av, _, ref, err := bus.ExecOneOffWithFilter(ctx, b, dir, bus.WaitWhenIdle(true), nil, func(av directive.AttachedValue) (bool, error) {
+			val, ok := av.GetValue().(loader.ExecControllerValue)
+			return ok && match(val), nil
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		return factory, av.GetValue().(loader.ExecControllerValue), ref
+	}
+	// isStopped checks if the value indicates the controller will not be restarted.
+	isStopped := func(val loader.ExecControllerValue) bool {
+		return val.GetController() == nil && val.GetError() != nil && val.GetNextRetryTimestamp().IsZero()
+	}
+
+	// never: the error is returned without restarting
+	factory, val, ref := exec(controller_mock.FailName, &loader.RestartPolicy{Mode: loader.RestartMode_RestartMode_NEVER}, isStopped)
+	if !errors.Is(val.GetError(), controller_mock.ErrMockExit) || val.GetRestartCount() != 0 || factory.GetExecs() != 1 {
+		t.Fatalf("unexpected never value: %v restarts %d execs %d", val.GetError(), val.GetRestartCount(), factory.GetExecs())
+	}
+	ref.Release()
+
+	// always: restarts after returning nil until the max restarts is exceeded
+	factory, val, ref = exec("ok", &loader.RestartPolicy{
+		Mode:        loader.RestartMode_RestartMode_ALWAYS,
+		MaxRestarts: 3,
+	}, isStopped)
+	if val.GetRestartCount() != 3 || factory.GetExecs() != 4 {
+		t.Fatalf("unexpected always value: %v restarts %d execs %d", val.GetError(), val.GetRestartCount(), factory.GetExecs())
+	}
+	t.Log(val.GetError().Error())
+	ref.Release()
+
+	// on-failure: restarts after an error and wraps the error once exceeded
+	factory, val, ref = exec(controller_mock.FailName, &loader.RestartPolicy{MaxRestarts: 2}, isStopped)
+	if !errors.Is(val.GetError(), controller_mock.ErrMockExit) || val.GetRestartCount() != 2 || factory.GetExecs() != 3 {
+		t.Fatalf("unexpected on-failure value: %v restarts %d execs %d", val.GetError(), val.GetRestartCount(), factory.GetExecs())
+	}
+	ref.Release()
+
+	// on-failure: restarts outside of the window are not counted
+	_, val, ref = exec(controller_mock.FailName, &loader.RestartPolicy{MaxRestarts: 1, WindowDur: "1ns"}, func(val loader.ExecControllerValue) bool {
+		return isStopped(val) || val.GetRestartCount() >= 5
+	})
+	if val.GetRestartCount() < 5 {
+		t.Fatalf("expected restarts outside of the window to be ignored: %v", val.GetError())
+	}
+	ref.Release()
+
+	// on-failure: a controller returning nil stays running
+	factory, val, ref = exec("ok", nil, func(val loader.ExecControllerValue) bool {
+		return val.GetController() != nil
+	})
+	<-time.After(50 * time.Millisecond)
+	if val.GetRestartCount() != 0 || factory.GetExecs() != 1 {
+		t.Fatalf("unexpected on-failure value: restarts %d execs %d", val.GetRestartCount(), factory.GetExecs())
+	}
+	ref.Release()
+
+	if err := (&loader.RestartPolicy{WindowDur: "-1s"}).Validate(); err == nil {
+		t.Fatal("expected negative window to fail validation")
+	}
+}
@@ -0,0 +1,192 @@
+package controller_mock
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
+	boilerplate "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+)
+
+// FailName is the name of a controller which exits with ErrMockExit.
+const FailName = "fail"
+
+// ErrMockExit is returned by Execute if the controller name is FailName.
+var ErrMockExit = errors.New("exit with error")
+
+// MockFactory constructs MockController with the boilerplate config.
+//
+// The name of the controller is the example field of the config.
+type MockFactory struct {
+	// ExecuteFn is called by Execute if set.
+	// If nil, Execute waits for ctx to be canceled.
+	// Execute returns ErrMockExit without calling ExecuteFn if the name is FailName.
+	ExecuteFn func(ctx context.Context, c *MockController) error
+	// UpdateConfigFn is called by UpdateConfig with the new name if set.
+	// If nil, UpdateConfig returns false to restart the controller.
+	// If it returns true, the controller name is updated.
+	UpdateConfigFn func(c *MockController, name string) bool
+	// ReadyCh is closed to mark the controllers as ready.
+	// If set, constructs MockReadyController instead.
+	ReadyCh chan struct{}
+
+	// constructs is the number of constructed controllers
+	constructs atomic.Int32
+	// execs is the number of calls to Execute
+	execs atomic.Int32
+	// mtx guards exited
+	mtx sync.Mutex
+	// exited contains the names of the exited controllers in order
+	exited []string
+}
+
+// GetConfigID returns the unique config ID for the controller.
+func (f *MockFactory) GetConfigID() string {
+	return boilerplate.ConfigID
+}
+
+// GetControllerID returns the unique ID for the controller.
+func (f *MockFactory) GetControllerID() string {
+	return boilerplate.ControllerID
+}
+
+// ConstructConfig constructs an instance of the controller configuration.
+func (f *MockFactory) ConstructConfig() config.Config {
+	return &boilerplate.Config{}
+}
+
+// GetVersion returns the version of this controller.
+func (f *MockFactory) GetVersion() controller.Version {
+	return boilerplate.Version
+}
+
+// Construct constructs the associated controller given configuration.
+func (f *MockFactory) Construct(
+	ctx context.Context,
+	conf config.Config,
+	opts controller.ConstructOpts,
+) (controller.Controller, error) {
+	f.constructs.Add(1)
+	c := &MockController{f: f, name: conf.(*boilerplate.Config).GetExampleField()}
+	if f.ReadyCh != nil {
+		return &MockReadyController{MockController: c}, nil
+	}
+	return c, nil
+}
+
+// GetConstructs returns the number of constructed controllers.
+func (f *MockFactory) GetConstructs() int {
+	return int(f.constructs.Load())
+}
+
+// GetExecs returns the number of calls to Execute.
+func (f *MockFactory) GetExecs() int {
+	return int(f.execs.Load())
+}
+
+// GetExited returns the names of the exited controllers in order.
+func (f *MockFactory) GetExited() []string {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	exited := make([]string, len(f.exited))
+	copy(exited, f.exited)
+	return exited
+}
+
+// TakeExited returns and clears the names of the exited controllers.
+func (f *MockFactory) TakeExited() []string {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	exited := f.exited
+	f.exited = nil
+	return exited
+}
+
+// MockController is a controller constructed by MockFactory.
+type MockController struct {
+	// f is the factory
+	f *MockFactory
+	// mtx guards name
+	mtx sync.Mutex
+	// name is the example field of the config
+	name string
+}
+
+// GetName returns the name of the controller.
+func (c *MockController) GetName() string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.name
+}
+
+// GetControllerInfo returns information about the controller.
+func (c *MockController) GetControllerInfo() *controller.Info {
+	return controller.NewInfo(boilerplate.ControllerID, boilerplate.Version, c.GetName())
+}
+
+// Execute executes the controller.
+func (c *MockController) Execute(ctx context.Context) error {
+	c.f.execs.Add(1)
+	name := c.GetName()
+	if name == FailName {
+		return ErrMockExit
+	}
+	var err error
+	if c.f.ExecuteFn != nil {
+		err = c.f.ExecuteFn(ctx, c)
+	} else {
+		<-ctx.Done()
+	}
+	c.f.mtx.Lock()
+	c.f.exited = append(c.f.exited, name)
+	c.f.mtx.Unlock()
+	return err
+}
+
+// UpdateConfig updates the name of the controller with UpdateConfigFn.
+func (c *MockController) UpdateConfig(ctx context.Context, conf config.Config) (bool, error) {
+	name := conf.(*boilerplate.Config).GetExampleField()
+	if c.f.UpdateConfigFn == nil || !c.f.UpdateConfigFn(c, name) {
+		return false, nil
+	}
+	c.mtx.Lock()
+	c.name = name
+	c.mtx.Unlock()
+	return true, nil
+}
+
+// HandleDirective asks if the handler can resolve the directive.
+func (c *MockController) HandleDirective(ctx context.Context, di directive.Instance) ([]directive.Resolver, error) {
+	return nil, nil
+}
+
+// Close releases any resources used by the controller.
+func (c *MockController) Close() error {
+	return nil
+}
+
+// MockReadyController is a MockController which is ready once ReadyCh is closed.
+type MockReadyController struct {
+	*MockController
+}
+
+// WaitReady waits for ReadyCh to be closed.
+func (c *MockReadyController) WaitReady(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.f.ReadyCh:
+		return nil
+	}
+}
+
+// _ is a type assertion
+var (
+	_ controller.Factory                    = ((*MockFactory)(nil))
+	_ controller.ControllerWithConfigUpdate = ((*MockController)(nil))
+	_ controller.ControllerWithReady        = ((*MockReadyController)(nil))
+)
@@ -0,0 +1,30 @@
+package resolver
+
+import (
+	loader "github.com/aperturerobotics/controllerbus/controller/loader"
+)
+
+// loadControllerWithConfigValue implements LoadControllerWithConfigValue.
+type loadControllerWithConfigValue struct {
+	loader.ExecControllerValue
+	resolverID string
+}
+
+// NewLoadControllerWithConfigValue builds a new LoadControllerWithConfigValue.
+func NewLoadControllerWithConfigValue(
+	val loader.ExecControllerValue,
+	resolverID string,
+) LoadControllerWithConfigValue {
+	return &loadControllerWithConfigValue{
+		ExecControllerValue: val,
+		resolverID:          resolverID,
+	}
+}
+
+// GetResolverID returns the id of the resolver which provided the factory.
+func (v *loadControllerWithConfigValue) GetResolverID() string {
+	return v.resolverID
+}
+
+// _ is a type assertion
+var _ LoadControllerWithConfigValue = ((*loadControllerWithConfigValue)(nil))
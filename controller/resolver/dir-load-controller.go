@@ -20,18 +20,28 @@ type LoadControllerWithConfig interface {
 	// GetExecControllerRetryBackoff returns the backoff to use for retries.
 	// If empty / nil, uses the default.
 	GetExecControllerRetryBackoff() func() backoff.BackOff
+	// GetExecControllerRestartPolicy returns the policy for restarting the
+	// controller after Execute returns.
+	// If nil, restarts the controller when Execute returns an error.
+	GetExecControllerRestartPolicy() *loader.RestartPolicy
 }
 
-// LoadControllerWithConfig is the value emitted to satisfy the
+// LoadControllerWithConfigValue is the value emitted to satisfy the
 // LoadControllerWithConfig directive.
-type LoadControllerWithConfigValue = loader.ExecControllerValue
+type LoadControllerWithConfigValue interface {
+	loader.ExecControllerValue
+
+	// GetResolverID returns the id of the resolver which provided the factory.
+	GetResolverID() string
+}
 
 // loadControllerWithConfig is an LoadControllerWithConfig directive.
 // Will override or yield to exiting directives for the controller.
 type loadControllerWithConfig struct {
-	config      config.Config
-	valueOpts   directive.ValueOptions
-	execBackoff func() backoff.BackOff
+	config        config.Config
+	valueOpts     directive.ValueOptions
+	execBackoff   func() backoff.BackOff
+	restartPolicy *loader.RestartPolicy
 }
 
 // NewLoadControllerWithConfig constructs a new LoadControllerWithConfig directive.
@@ -56,6 +66,23 @@ func NewLoadControllerWithConfigAndOpts(
 	}
 }
 
+// NewLoadControllerWithConfigAndRestartPolicy constructs a new
+// LoadControllerWithConfig directive with a policy for restarting the
+// controller after Execute returns.
+func NewLoadControllerWithConfigAndRestartPolicy(
+	config config.Config,
+	valueOpts directive.ValueOptions,
+	execBackoff func() backoff.BackOff,
+	restartPolicy *loader.RestartPolicy,
+) LoadControllerWithConfig {
+	return &loadControllerWithConfig{
+		config:        config,
+		valueOpts:     valueOpts,
+		execBackoff:   execBackoff,
+		restartPolicy: restartPolicy,
+	}
+}
+
 // GetLoadControllerConfig returns the factory desired to load.
 func (d *loadControllerWithConfig) GetLoadControllerConfig() config.Config {
 	return d.config
@@ -77,6 +104,10 @@ func (d *loadControllerWithConfig) Validate() error {
 		return err
 	}
 
+	if err := d.restartPolicy.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -88,6 +119,10 @@ func (d *loadControllerWithConfig) IsEquivalent(other directive.Directive) bool
 		return false
 	}
 
+	if !d.GetExecControllerRestartPolicy().EqualVT(otherExec.GetExecControllerRestartPolicy()) {
+		return false
+	}
+
 	f := d.GetLoadControllerConfig()
 	return f.EqualsConfig(otherExec.GetLoadControllerConfig())
 }
@@ -110,6 +145,9 @@ func (d *loadControllerWithConfig) GetDebugVals() directive.DebugValues {
 	vals := directive.NewDebugValues()
 	confID := d.GetLoadControllerConfig().GetConfigID()
 	vals["config-id"] = []string{confID}
+	if d.restartPolicy != nil {
+		vals["restart-mode"] = []string{d.restartPolicy.GetMode().String()}
+	}
 	dbg, dbgOk := d.GetLoadControllerConfig().(config.Debuggable)
 	if dbgOk {
 		dbgVals := dbg.GetDebugVals()
@@ -130,6 +168,13 @@ func (d *loadControllerWithConfig) GetExecControllerRetryBackoff() func() backof
 	return d.execBackoff
 }
 
+// GetExecControllerRestartPolicy returns the policy for restarting the
+// controller after Execute returns.
+// If nil, restarts the controller when Execute returns an error.
+func (d *loadControllerWithConfig) GetExecControllerRestartPolicy() *loader.RestartPolicy {
+	return d.restartPolicy
+}
+
 // _ is a type assertion
 var _ LoadControllerWithConfig = ((*loadControllerWithConfig)(nil))
 
@@ -24,6 +24,8 @@ type LoadFactoryByConfig interface {
 }
 
 // LoadFactoryByConfigValue is the value type for LoadFactoryByConfig.
+//
+// The resolver controller emits a ResolvedFactory.
 type LoadFactoryByConfigValue = controller.Factory
 
 // ExLoadFactoryByConfig executes the LoadFactoryByConfig directive.
@@ -74,7 +74,7 @@ func (r *LoadFactoryByConfigResolver) Resolve(
 			return err
 		}
 		if factory != nil {
-			id, accepted := vh.AddValue(factory)
+			id, accepted := vh.AddValue(NewResolvedFactory(factory, r.res.GetResolverID()))
 			if accepted {
 				<-ctx.Done()
 				vh.RemoveValue(id)
@@ -49,6 +49,7 @@ func (r *loadWithConfigResolver) Resolve(ctx context.Context, vh directive.Resol
 	if err != nil {
 		return err
 	}
+	resolverID := r.res.GetResolverID()
 
 	if factory == nil {
 		// create a directive to lookup the factory.
@@ -62,6 +63,9 @@ func (r *loadWithConfigResolver) Resolve(ctx context.Context, vh directive.Resol
 			}
 			return nil
 		}
+		if rf, ok := factory.(ResolvedFactory); ok {
+			factory, resolverID = rf.GetFactory(), rf.GetResolverID()
+		}
 	}
 
 	factoryCtx := context.Background()
@@ -71,17 +75,33 @@ func (r *loadWithConfigResolver) Resolve(ctx context.Context, vh directive.Resol
 	}
 
 	valCtx, valCtxCancel := context.WithCancel(r.ctx)
-	execDir := loader.NewExecController(factory, conf)
+	execDir := loader.NewExecControllerWithRestartPolicy(
+		factory,
+		conf,
+		r.dir.GetExecControllerRetryBackoff(),
+		r.dir.GetExecControllerRestartPolicy(),
+		directive.ValueOptions{},
+	)
 
-	// pass through all values.
-	_, execRef, err := r.bus.AddDirective(execDir, bus.NewPassThruHandler(vh, valCtxCancel))
+	// pass through all values with the resolver id.
+	_, execRef, err := r.bus.AddDirective(execDir, bus.NewTransformHandler(
+		vh,
+		func(val directive.AttachedValue) (directive.Value, bool) {
+			ev, ok := val.GetValue().(loader.ExecControllerValue)
+			if !ok {
+				return nil, false
+			}
+			return NewLoadControllerWithConfigValue(ev, resolverID), true
+		},
+		valCtxCancel,
+	))
 	if err != nil {
-		_, _ = vh.AddValue(loader.NewExecControllerValue(
+		_, _ = vh.AddValue(NewLoadControllerWithConfigValue(loader.NewExecControllerValue(
 			time.Now(),
 			time.Time{},
 			nil,
 			err,
-		))
+		), resolverID))
 		// config has to be invalid for it to have failed here.
 		// give up permanently
 		// return err
@@ -0,0 +1,39 @@
+package resolver
+
+import "github.com/aperturerobotics/controllerbus/controller"
+
+// ResolvedFactory is a factory with the id of the resolver which provided it.
+//
+// LoadFactoryByConfig values implement ResolvedFactory.
+type ResolvedFactory interface {
+	controller.Factory
+
+	// GetResolverID returns the id of the resolver which provided the factory.
+	GetResolverID() string
+	// GetFactory returns the underlying factory.
+	GetFactory() controller.Factory
+}
+
+// resolvedFactory implements ResolvedFactory.
+type resolvedFactory struct {
+	controller.Factory
+	resolverID string
+}
+
+// NewResolvedFactory constructs a new ResolvedFactory.
+func NewResolvedFactory(factory controller.Factory, resolverID string) ResolvedFactory {
+	return &resolvedFactory{Factory: factory, resolverID: resolverID}
+}
+
+// GetResolverID returns the id of the resolver which provided the factory.
+func (f *resolvedFactory) GetResolverID() string {
+	return f.resolverID
+}
+
+// GetFactory returns the underlying factory.
+func (f *resolvedFactory) GetFactory() controller.Factory {
+	return f.Factory
+}
+
+// _ is a type assertion
+var _ ResolvedFactory = ((*resolvedFactory)(nil))
@@ -79,6 +79,11 @@ func (r *Resolver) GetFactories() []controller.Factory {
 	return vals
 }
 
+// ListFactories returns the factories associated w/ the resolver.
+func (r *Resolver) ListFactories(ctx context.Context) ([]controller.Factory, error) {
+	return r.GetFactories(), nil
+}
+
 // GetConfigCtorByID returns a config constructor matching the ID.
 // If none found, return nil, nil
 func (r *Resolver) GetConfigCtorByID(
@@ -131,4 +136,7 @@ func (r *Resolver) GetFactoryMatchingConfigWatch(
 }
 
 // _ is a type assertion
-var _ controller.FactoryResolver = ((*Resolver)(nil))
+var (
+	_ controller.FactoryResolver = ((*Resolver)(nil))
+	_ controller.FactoryLister   = ((*Resolver)(nil))
+)
@@ -21,13 +21,15 @@ type Controller struct {
 
 	// mtx guards below fields
 	mtx sync.Mutex
-	// dirID contains the next directive instance id
+	// dirID contains the last assigned directive instance id
 	dirID uint32
 	// dir contains the list of running directive instances
 	// sorted by ID
 	dir []*directiveInstance
 	// hnd contains the list of attached handlers
 	hnd []*handler
+	// dirCbs contains the directive added and removed callbacks
+	dirCbs []*callback[directive.DirectivesCallback]
 }
 
 // NewController builds a new directive controller.
@@ -55,6 +57,39 @@ func (c *Controller) GetDirectivesBroadcast() *broadcast.Broadcast {
 	return &c.bcast
 }
 
+// AddDirectivesCallback adds a callback that is called when a directive is
+// added or removed. Calls cb with the existing directives when added.
+// cb is called while the controller is locked: it should not block or
+// call any directive controller or instance functions.
+// Returns a callback release function.
+func (c *Controller) AddDirectivesCallback(cb directive.DirectivesCallback) func() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	cbCtr := newCallback(cb)
+	c.dirCbs = append(c.dirCbs, cbCtr)
+	for _, di := range c.dir {
+		cb(di, true)
+	}
+
+	return func() {
+		if !cbCtr.released.Swap(true) {
+			c.mtx.Lock()
+			c.dirCbs = removeFromCallbacks(c.dirCbs, cbCtr)
+			c.mtx.Unlock()
+		}
+	}
+}
+
+// callDirectivesCallbacksLocked calls the directive added or removed callbacks.
+func (c *Controller) callDirectivesCallbacksLocked(di *directiveInstance, added bool) {
+	for _, cb := range c.dirCbs {
+		if !cb.released.Load() {
+			cb.cb(di, added)
+		}
+	}
+}
+
 // AddDirective adds a directive to the controller.
 // This call de-duplicates equivalent directives.
 //
@@ -88,10 +123,11 @@ func (c *Controller) AddDirective(
 	}
 
 	// Push the new directive to the list.
-	di, diRef := newDirectiveInstance(c, c.dirID, dir, ref)
 	c.dirID++
+	di, diRef := newDirectiveInstance(c, c.dirID, dir, ref)
 	di.logger().Debug("added directive")
 	c.dir = append(c.dir, di)
+	c.callDirectivesCallbacksLocked(di, true)
 
 	// signal directive list changed
 	c.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
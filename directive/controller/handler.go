@@ -1,8 +1,11 @@
 package controller
 
 import (
+	"context"
+	"runtime/pprof"
 	"sync/atomic"
 
+	cb_controller "github.com/aperturerobotics/controllerbus/controller"
 	"github.com/aperturerobotics/controllerbus/directive"
 )
 
@@ -12,9 +15,26 @@ type handler struct {
 	rel atomic.Bool
 	// h is the directive handler
 	h directive.Handler
+	// controllerID is the id of the controller if h is a controller
+	controllerID string
 }
 
 // newHandler constructs a new handler.
 func newHandler(h directive.Handler) *handler {
-	return &handler{h: h}
+	hnd := &handler{h: h}
+	if ctrl, ok := h.(cb_controller.Controller); ok {
+		hnd.controllerID = ctrl.GetControllerInfo().GetId()
+	}
+	return hnd
+}
+
+// withPprofLabels adds the controller id and directive name pprof labels to ctx.
+func (h *handler) withPprofLabels(ctx context.Context, dir directive.Directive) context.Context {
+	if h.controllerID == "" {
+		return pprof.WithLabels(ctx, pprof.Labels(directive.PprofLabel, dir.GetName()))
+	}
+	return pprof.WithLabels(ctx, pprof.Labels(
+		cb_controller.PprofLabel, h.controllerID,
+		directive.PprofLabel, dir.GetName(),
+	))
 }
@@ -24,7 +24,7 @@ type directiveInstance struct {
 	// ctxCancel cancels ctx
 	ctxCancel context.CancelFunc
 	// id is the id of this instance
-	// incremented by 1 each time a directive is added
+	// incremented by 1 each time a directive is added, starting at 1
 	id uint32
 	// dir is the directive.
 	dir directive.Directive
@@ -125,6 +125,13 @@ func (i *directiveInstance) GetDirectiveIdent() string {
 	return dirNameDebugStr
 }
 
+// GetInstanceID returns the id of the directive instance.
+//
+// Unique among the directive instances of the directive controller.
+func (i *directiveInstance) GetInstanceID() uint32 {
+	return i.id
+}
+
 // GetResolverErrors returns a snapshot of any errors returned by resolvers.
 func (i *directiveInstance) GetResolverErrors() []error {
 	i.c.mtx.Lock()
@@ -443,6 +450,8 @@ func (i *directiveInstance) checkFullLocked() {
 
 // handleIdleStateLocked checks if the idle state of the instance changes & handles that if so.
 func (i *directiveInstance) handleIdleStateLocked() {
+	i.handleSiblingsIdleLocked()
+
 	// true if there are no running resolvers and the instance is ready.
 	idle := i.countRunningResolversLocked() == 0 && i.ready
 	if i.idle == idle {
@@ -470,6 +479,48 @@ func (i *directiveInstance) handleIdleStateLocked() {
 	i.callCallbacksLocked(cbs...)
 }
 
+// handleSiblingsIdleLocked calls the sibling idle callbacks of the resolvers if
+// the idle state of the other resolvers changed.
+func (i *directiveInstance) handleSiblingsIdleLocked() {
+	var cbs []func()
+	var errs []error
+	for _, res := range i.res {
+		if len(res.siblingIdles) == 0 {
+			continue
+		}
+		idle := i.isSiblingsIdleLocked(res)
+		if res.siblingIdle == idle {
+			continue
+		}
+		res.siblingIdle = idle
+		if errs == nil {
+			errs = i.getResolverErrsLocked()
+		}
+		for _, idleCb := range res.siblingIdles {
+			if !idleCb.released.Load() && idleCb.cb != nil {
+				idleCbFn, idleErrs := idleCb.cb, errs
+				cbs = append(cbs, func() {
+					idleCbFn(idle, idleErrs)
+				})
+			}
+		}
+	}
+	i.callCallbacksLocked(cbs...)
+}
+
+// isSiblingsIdleLocked checks if the resolvers other than res are idle.
+func (i *directiveInstance) isSiblingsIdleLocked(res *resolver) bool {
+	if !i.ready {
+		return false
+	}
+	for _, other := range i.res {
+		if other != res && !other.idle && !other.exited {
+			return false
+		}
+	}
+	return true
+}
+
 // removeReleaseCallbackLocked removes a release callback while i.c.mtx is locked.
 func (i *directiveInstance) removeReleaseCallbackLocked(cb *callback[func()]) {
 	i.rels = removeFromCallbacks(i.rels, cb)
@@ -801,6 +852,7 @@ func (i *directiveInstance) removeLocked(diIdx int) {
 	// remove from list of instances
 	i.logger().Debug("removed directive")
 	i.c.dir = append(i.c.dir[:diIdx], i.c.dir[diIdx+1:]...)
+	i.c.callDirectivesCallbacksLocked(i, false)
 
 	// signal directive list changed
 	i.c.bcast.HoldLock(func(broadcast func(), getWaitCh func() <-chan struct{}) {
@@ -46,6 +46,60 @@ func TestDisposeSkipsWeakRefReleasedByValueRemovedCallback(t *testing.T) {
 	}
 }
 
+func TestSiblingsIdleCallback(t *testing.T) {
+	otherIdleCh := make(chan struct{})
+	siblingsIdleCh := make(chan bool, 10)
+	waiter := directive.NewFuncResolver(func(ctx context.Context, handler directive.ResolverHandler) error {
+		rel := handler.AddSiblingsIdleCallback(func(isIdle bool, errs []error) {
+			siblingsIdleCh <- isIdle
+		})
+		defer rel()
+		<-ctx.Done()
+		return nil
+	})
+	other := directive.NewFuncResolver(func(ctx context.Context, handler directive.ResolverHandler) error {
+		select {
+		case <-ctx.Done():
+		case <-otherIdleCh:
+			handler.MarkIdle(true)
+			<-ctx.Done()
+		}
+		return nil
+	})
+
+	ctrl := controller.NewController(context.Background(), logrus.NewEntry(logrus.New()))
+	removeHandler, err := ctrl.AddHandler(directive.NewFuncHandler(func(context.Context, directive.Instance) ([]directive.Resolver, error) {
+		return []directive.Resolver{waiter, other}, nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer removeHandler()
+
+	di, ref, err := ctrl.AddDirective(&directive_mock.MockDirective{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ref.Release()
+
+	if <-siblingsIdleCh {
+		t.Fatal("expected other resolver to be running")
+	}
+	close(otherIdleCh)
+	if !<-siblingsIdleCh {
+		t.Fatal("expected other resolver to be idle")
+	}
+
+	// the waiter is still running: the instance is not idle
+	idleCh := make(chan bool, 1)
+	di.AddIdleCallback(func(isIdle bool, errs []error) {
+		idleCh <- isIdle
+	})()
+	if <-idleCh {
+		t.Fatal("expected instance not to be idle")
+	}
+}
+
 type releaseWeakRefResolver struct {
 	releaseWeak *atomic.Pointer[func()]
 	readyOnce   sync.Once
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"runtime/pprof"
 
 	"github.com/aperturerobotics/controllerbus/directive"
 )
@@ -53,6 +54,39 @@ func (r *resolverHandler) MarkIdle(idle bool) {
 	r.r.setIdleLocked(idle)
 }
 
+// AddSiblingsIdleCallback adds a callback that will be called when the idle
+// state of the other resolvers on the directive instance changes.
+// Called immediately with the initial state.
+func (r *resolverHandler) AddSiblingsIdleCallback(cb directive.IdleCallback) func() {
+	emptyFn := func() {}
+	if cb == nil {
+		return emptyFn
+	}
+
+	r.r.di.c.mtx.Lock()
+	defer r.r.di.c.mtx.Unlock()
+	if r.r.ctx != r.ctx {
+		return emptyFn
+	}
+
+	cbCtr := newCallback(cb)
+	r.r.siblingIdles = append(r.r.siblingIdles, cbCtr)
+	isIdle := r.r.di.isSiblingsIdleLocked(r.r)
+	errs := r.r.di.getResolverErrsLocked()
+	r.r.siblingIdle = isIdle
+	r.r.di.callCallbacksLocked(func() {
+		cb(isIdle, errs)
+	})
+
+	return func() {
+		if !cbCtr.released.Swap(true) {
+			r.r.di.c.mtx.Lock()
+			r.r.siblingIdles = removeFromCallbacks(r.r.siblingIdles, cbCtr)
+			r.r.di.c.mtx.Unlock()
+		}
+	}
+}
+
 // CountValues returns the number of values that were set.
 // if allResolvers=false, returns the number set by this ResolverHandler.
 // if allResolvers=true, returns the number set by all resolvers.
@@ -178,6 +212,7 @@ func (r *resolverHandler) executeResolver(ctx context.Context, exitedCh chan<- s
 		}
 	}
 
+	pprof.SetGoroutineLabels(r.r.hnd.withPprofLabels(ctx, r.r.di.dir))
 	err := r.r.res.Resolve(r.ctx, r)
 
 	r.r.di.c.mtx.Lock()
@@ -34,6 +34,11 @@ type resolver struct {
 	exited bool
 	// stopped indicates we stopped this resolver due to reaching the value cap
 	stopped bool
+	// siblingIdles contains the idle callbacks for the other resolvers
+	// cleared when the resolver context changes
+	siblingIdles []*callback[directive.IdleCallback]
+	// siblingIdle is the last idle state passed to siblingIdles
+	siblingIdle bool
 }
 
 // newResolver constructs a new resolver.
@@ -62,6 +67,7 @@ func (r *resolver) updateContextLocked(ctx *context.Context) {
 	if r.ctxCancel != nil {
 		r.ctxCancel()
 		r.ctx, r.ctxCancel = nil, nil
+		r.siblingIdles = nil
 	}
 	if ctx == nil {
 		r.exited = true
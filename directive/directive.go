@@ -7,12 +7,18 @@ package directive
 import (
 	"cmp"
 	"context"
+	"fmt"
 	"slices"
 	"time"
 
 	"github.com/aperturerobotics/util/broadcast"
 )
 
+// PprofLabel is the pprof goroutine label set to the directive name.
+//
+// The directive controller sets the label while executing resolvers.
+const PprofLabel = "directive"
+
 // DebugValues maps string key to a list of values.
 // It is used for debug visualizations.
 type DebugValues map[string][]string
@@ -54,8 +60,30 @@ func NewDirectiveInfo(dir Directive) *DirectiveInfo {
 // NewDirectiveState constructs a new state snapshot from a running directive.
 func NewDirectiveState(di Instance) *DirectiveState {
 	return &DirectiveState{
-		Info: NewDirectiveInfo(di.GetDirective()),
-		// TODO state
+		Info:       NewDirectiveInfo(di.GetDirective()),
+		Ident:      di.GetDirectiveIdent(),
+		InstanceId: di.GetInstanceID(),
+	}
+}
+
+// NewValueInfo constructs a new ValueInfo from an attached value.
+//
+// Uses GetDebugVals if the value implements Debuggable, otherwise String if
+// the value implements fmt.Stringer.
+func NewValueInfo(av AttachedValue) *ValueInfo {
+	val := av.GetValue()
+	var debugVals []*ProtoDebugValue
+	switch v := val.(type) {
+	case Debuggable:
+		debugVals = NewProtoDebugValues(v.GetDebugVals())
+	case fmt.Stringer:
+		debugVals = []*ProtoDebugValue{{Key: "value", Values: []string{v.String()}}}
+	}
+
+	return &ValueInfo{
+		ValueId:   av.GetValueID(),
+		ValueType: fmt.Sprintf("%T", val),
+		DebugVals: debugVals,
 	}
 }
 
@@ -179,6 +207,12 @@ type Controller interface {
 	// GetDirectivesBroadcast returns the broadcast that is signaled when
 	// directives are added or removed.
 	GetDirectivesBroadcast() *broadcast.Broadcast
+	// AddDirectivesCallback adds a callback that is called when a directive is
+	// added or removed. Calls cb with the existing directives when added.
+	// cb is called while the controller is locked: it should not block or
+	// call any directive controller or instance functions.
+	// Returns a callback release function.
+	AddDirectivesCallback(cb DirectivesCallback) func()
 
 	// DirectiveAdder has AddDirective.
 	DirectiveAdder
@@ -210,6 +244,10 @@ type ReferenceHandler interface {
 	HandleInstanceDisposed(Instance)
 }
 
+// DirectivesCallback is called when a directive is added to or removed from
+// the directive controller.
+type DirectivesCallback func(di Instance, added bool)
+
 // IdleCallback is called when the directive becomes idle or not-idle.
 // Errs is the list of non-nil resolver errors.
 type IdleCallback func(isIdle bool, errs []error)
@@ -230,6 +268,11 @@ type Instance interface {
 	// Ex: DoSomething or DoSomething<param=foo>
 	GetDirectiveIdent() string
 
+	// GetInstanceID returns the id of the directive instance.
+	//
+	// Unique among the directive instances of the directive controller.
+	GetInstanceID() uint32
+
 	// GetResolverErrors returns a snapshot of any errors returned by resolvers.
 	GetResolverErrors() []error
 
@@ -410,6 +453,16 @@ type ResolverHandler interface {
 	// If the resolver returns nil or an error, it's also marked as idle.
 	MarkIdle(idle bool)
 
+	// AddSiblingsIdleCallback adds a callback that will be called when the idle
+	// state of the other resolvers on the directive instance changes.
+	//
+	// The idle state does not include this resolver, so the resolver can wait
+	// for the other resolvers before marking itself as idle.
+	// Called immediately with the initial state.
+	//
+	// Returns a release function to clear the callback early.
+	AddSiblingsIdleCallback(cb IdleCallback) func()
+
 	// AddValueRemovedCallback adds a callback that will be called when the
 	// given value id is disposed or removed.
 	//
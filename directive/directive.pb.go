@@ -48,7 +48,13 @@ func (x *DirectiveInfo) GetDebugVals() []*ProtoDebugValue {
 type DirectiveState struct {
 	unknownFields []byte
 	// Info is the directive info.
-	Info *DirectiveInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"` // TODO result info
+	Info *DirectiveInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// Ident is the human-readable directive identifier.
+	// Ex: DoSomething or DoSomething<param=foo>
+	Ident string `protobuf:"bytes,2,opt,name=ident,proto3" json:"ident,omitempty"`
+	// InstanceId is the id of the directive instance.
+	// Unique among the directive instances of the directive controller.
+	InstanceId uint32 `protobuf:"varint,3,opt,name=instance_id,json=instanceId,proto3" json:"instanceId,omitempty"`
 }
 
 func (x *DirectiveState) Reset() {
@@ -64,6 +70,58 @@ func (x *DirectiveState) GetInfo() *DirectiveInfo {
 	return nil
 }
 
+func (x *DirectiveState) GetIdent() string {
+	if x != nil {
+		return x.Ident
+	}
+	return ""
+}
+
+func (x *DirectiveState) GetInstanceId() uint32 {
+	if x != nil {
+		return x.InstanceId
+	}
+	return 0
+}
+
+// ValueInfo contains directive value information in protobuf form.
+type ValueInfo struct {
+	unknownFields []byte
+	// ValueId is the id of the value on the directive instance.
+	ValueId uint32 `protobuf:"varint,1,opt,name=value_id,json=valueId,proto3" json:"valueId,omitempty"`
+	// ValueType is the Go type of the value.
+	ValueType string `protobuf:"bytes,2,opt,name=value_type,json=valueType,proto3" json:"valueType,omitempty"`
+	// DebugVals contains the value debug values.
+	DebugVals []*ProtoDebugValue `protobuf:"bytes,3,rep,name=debug_vals,json=debugVals,proto3" json:"debugVals,omitempty"`
+}
+
+func (x *ValueInfo) Reset() {
+	*x = ValueInfo{}
+}
+
+func (*ValueInfo) ProtoMessage() {}
+
+func (x *ValueInfo) GetValueId() uint32 {
+	if x != nil {
+		return x.ValueId
+	}
+	return 0
+}
+
+func (x *ValueInfo) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+func (x *ValueInfo) GetDebugVals() []*ProtoDebugValue {
+	if x != nil {
+		return x.DebugVals
+	}
+	return nil
+}
+
 // ProtoDebugValue is a debug value.
 type ProtoDebugValue struct {
 	unknownFields []byte
@@ -121,6 +179,8 @@ func (m *DirectiveState) CloneVT() *DirectiveState {
 	}
 	r := new(DirectiveState)
 	r.Info = m.Info.CloneVT()
+	r.Ident = m.Ident
+	r.InstanceId = m.InstanceId
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = slices.Clone(m.unknownFields)
 	}
@@ -131,6 +191,29 @@ func (m *DirectiveState) CloneMessageVT() protobuf_go_lite.CloneMessage {
 	return m.CloneVT()
 }
 
+func (m *ValueInfo) CloneVT() *ValueInfo {
+	if m == nil {
+		return (*ValueInfo)(nil)
+	}
+	r := new(ValueInfo)
+	r.ValueId = m.ValueId
+	r.ValueType = m.ValueType
+	if rhs := m.DebugVals; rhs != nil {
+		r.DebugVals = make([]*ProtoDebugValue, len(rhs))
+		for k, v := range rhs {
+			r.DebugVals[k] = v.CloneVT()
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *ValueInfo) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
 func (m *ProtoDebugValue) CloneVT() *ProtoDebugValue {
 	if m == nil {
 		return (*ProtoDebugValue)(nil)
@@ -196,6 +279,12 @@ func (this *DirectiveState) EqualVT(that *DirectiveState) bool {
 	if !this.Info.EqualVT(that.Info) {
 		return false
 	}
+	if this.Ident != that.Ident {
+		return false
+	}
+	if this.InstanceId != that.InstanceId {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -207,6 +296,46 @@ func (this *DirectiveState) EqualMessageVT(thatMsg any) bool {
 	return this.EqualVT(that)
 }
 
+func (this *ValueInfo) EqualVT(that *ValueInfo) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.ValueId != that.ValueId {
+		return false
+	}
+	if this.ValueType != that.ValueType {
+		return false
+	}
+	if len(this.DebugVals) != len(that.DebugVals) {
+		return false
+	}
+	for i, vx := range this.DebugVals {
+		vy := that.DebugVals[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ProtoDebugValue{}
+			}
+			if q == nil {
+				q = &ProtoDebugValue{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ValueInfo) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*ValueInfo)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
 func (this *ProtoDebugValue) EqualVT(that *ProtoDebugValue) bool {
 	if this == that {
 		return true
@@ -320,6 +449,16 @@ func (x *DirectiveState) MarshalProtoJSON(s *json.MarshalState) {
 		s.WriteObjectField("info")
 		x.Info.MarshalProtoJSON(s.WithField("info"))
 	}
+	if x.Ident != "" || s.HasField("ident") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ident")
+		s.WriteString(x.Ident)
+	}
+	if x.InstanceId != 0 || s.HasField("instanceId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("instanceId")
+		s.WriteUint32(x.InstanceId)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -344,6 +483,12 @@ func (x *DirectiveState) UnmarshalProtoJSON(s *json.UnmarshalState) {
 			}
 			x.Info = &DirectiveInfo{}
 			x.Info.UnmarshalProtoJSON(s.WithField("info", true))
+		case "ident":
+			s.AddField("ident")
+			x.Ident = s.ReadString()
+		case "instance_id", "instanceId":
+			s.AddField("instance_id")
+			x.InstanceId = s.ReadUint32()
 		}
 	})
 }
@@ -353,6 +498,85 @@ func (x *DirectiveState) UnmarshalJSON(b []byte) error {
 	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the ValueInfo message to JSON.
+func (x *ValueInfo) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ValueId != 0 || s.HasField("valueId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("valueId")
+		s.WriteUint32(x.ValueId)
+	}
+	if x.ValueType != "" || s.HasField("valueType") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("valueType")
+		s.WriteString(x.ValueType)
+	}
+	if len(x.DebugVals) > 0 || s.HasField("debugVals") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("debugVals")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.DebugVals {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("debugVals"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ValueInfo to JSON.
+func (x *ValueInfo) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ValueInfo message from JSON.
+func (x *ValueInfo) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "value_id", "valueId":
+			s.AddField("value_id")
+			x.ValueId = s.ReadUint32()
+		case "value_type", "valueType":
+			s.AddField("value_type")
+			x.ValueType = s.ReadString()
+		case "debug_vals", "debugVals":
+			s.AddField("debug_vals")
+			if s.ReadNil() {
+				x.DebugVals = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.DebugVals = append(x.DebugVals, nil)
+					return
+				}
+				v := &ProtoDebugValue{}
+				v.UnmarshalProtoJSON(s.WithField("debug_vals", false))
+				if s.Err() != nil {
+					return
+				}
+				x.DebugVals = append(x.DebugVals, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ValueInfo from JSON.
+func (x *ValueInfo) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the ProtoDebugValue message to JSON.
 func (x *ProtoDebugValue) MarshalProtoJSON(s *json.MarshalState) {
 	if x == nil {
@@ -489,6 +713,18 @@ func (m *DirectiveState) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.InstanceId != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.InstanceId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Ident) > 0 {
+		i -= len(m.Ident)
+		copy(dAtA[i:], m.Ident)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.Ident)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Info != nil {
 		size, err := m.Info.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
@@ -502,6 +738,63 @@ func (m *DirectiveState) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValueInfo) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueInfo) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ValueInfo) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.DebugVals) > 0 {
+		for iNdEx := len(m.DebugVals) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.DebugVals[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValueType) > 0 {
+		i -= len(m.ValueType)
+		copy(dAtA[i:], m.ValueType)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.ValueType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ValueId != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.ValueId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProtoDebugValue) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -581,6 +874,36 @@ func (m *DirectiveState) SizeVT() (n int) {
 		l = m.Info.SizeVT()
 		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
 	}
+	l = len(m.Ident)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if m.InstanceId != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.InstanceId))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ValueInfo) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValueId != 0 {
+		n += 1 + protobuf_go_lite.SizeOfVarint(uint64(m.ValueId))
+	}
+	l = len(m.ValueType)
+	if l > 0 {
+		n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+	}
+	if len(m.DebugVals) > 0 {
+		for _, e := range m.DebugVals {
+			l = e.SizeVT()
+			n += 1 + l + protobuf_go_lite.SizeOfVarint(uint64(l))
+		}
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -650,6 +973,20 @@ func (x *DirectiveState) MarshalProtoText() string {
 		sb.WriteString("info: ")
 		sb.WriteString(x.Info.MarshalProtoText())
 	}
+	if x.Ident != "" {
+		if sb.Len() > 16 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("ident: ")
+		sb.WriteString(strconv.Quote(x.Ident))
+	}
+	if x.InstanceId != 0 {
+		if sb.Len() > 16 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("instance_id: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.InstanceId), 10))
+	}
 	sb.WriteString("}")
 	return sb.String()
 }
@@ -658,6 +995,48 @@ func (x *DirectiveState) String() string {
 	return x.MarshalProtoText()
 }
 
+func (x *ValueInfo) MarshalProtoText() string {
+	var sb strings.Builder
+	sb.WriteString("ValueInfo {")
+	if x.ValueId != 0 {
+		if sb.Len() > 11 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("value_id: ")
+		sb.WriteString(strconv.FormatUint(uint64(x.ValueId), 10))
+	}
+	if x.ValueType != "" {
+		if sb.Len() > 11 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("value_type: ")
+		sb.WriteString(strconv.Quote(x.ValueType))
+	}
+	if len(x.DebugVals) > 0 {
+		if sb.Len() > 11 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("debug_vals: [")
+		for i, v := range x.DebugVals {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if v == nil {
+				sb.WriteString((&ProtoDebugValue{}).MarshalProtoText())
+			} else {
+				sb.WriteString(v.MarshalProtoText())
+			}
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+func (x *ValueInfo) String() string {
+	return x.MarshalProtoText()
+}
+
 func (x *ProtoDebugValue) MarshalProtoText() string {
 	var sb strings.Builder
 	sb.WriteString("ProtoDebugValue {")
@@ -828,6 +1207,137 @@ func (m *DirectiveState) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ident", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ident = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceId", wireType)
+			}
+			m.InstanceId = 0
+			m.InstanceId, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ValueInfo) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
+			}
+			m.ValueId = 0
+			m.ValueId, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			var stringLen uint64
+			stringLen, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebugVals", wireType)
+			}
+			var msglen int
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			msglen = int(_v)
+			if err != nil {
+				return err
+			}
+			if msglen < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DebugVals = append(m.DebugVals, &ProtoDebugValue{})
+			if err := m.DebugVals[len(m.DebugVals)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
@@ -14,10 +14,29 @@ pub struct DirectiveInfo {
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct DirectiveState {
     /// Info is the directive info.
-    ///
-    /// TODO result info
     #[prost(message, optional, tag="1")]
     pub info: ::core::option::Option<DirectiveInfo>,
+    /// Ident is the human-readable directive identifier.
+    /// Ex: DoSomething or DoSomething<param=foo>
+    #[prost(string, tag="2")]
+    pub ident: ::prost::alloc::string::String,
+    /// InstanceId is the id of the directive instance.
+    /// Unique among the directive instances of the directive controller.
+    #[prost(uint32, tag="3")]
+    pub instance_id: u32,
+}
+/// ValueInfo contains directive value information in protobuf form.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ValueInfo {
+    /// ValueId is the id of the value on the directive instance.
+    #[prost(uint32, tag="1")]
+    pub value_id: u32,
+    /// ValueType is the Go type of the value.
+    #[prost(string, tag="2")]
+    pub value_type: ::prost::alloc::string::String,
+    /// DebugVals contains the value debug values.
+    #[prost(message, repeated, tag="3")]
+    pub debug_vals: ::prost::alloc::vec::Vec<ProtoDebugValue>,
 }
 /// ProtoDebugValue is a debug value.
 #[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
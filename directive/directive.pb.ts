@@ -88,11 +88,23 @@ export interface DirectiveState {
   /**
    * Info is the directive info.
    *
-   * TODO result info
-   *
    * @generated from field: directive.DirectiveInfo info = 1;
    */
   info?: DirectiveInfo
+  /**
+   * Ident is the human-readable directive identifier.
+   * Ex: DoSomething or DoSomething<param=foo>
+   *
+   * @generated from field: string ident = 2;
+   */
+  ident?: string
+  /**
+   * InstanceId is the id of the directive instance.
+   * Unique among the directive instances of the directive controller.
+   *
+   * @generated from field: uint32 instance_id = 3;
+   */
+  instanceId?: number
 }
 
 // DirectiveState contains the message type declaration for DirectiveState.
@@ -100,6 +112,51 @@ export const DirectiveState: MessageType<DirectiveState> = createMessageType({
   typeName: 'directive.DirectiveState',
   fields: [
     { no: 1, name: 'info', kind: 'message', T: () => DirectiveInfo },
+    { no: 2, name: 'ident', kind: 'scalar', T: ScalarType.STRING },
+    { no: 3, name: 'instance_id', kind: 'scalar', T: ScalarType.UINT32 },
+  ] as readonly PartialFieldInfo[],
+  packedByDefault: true,
+})
+
+/**
+ * ValueInfo contains directive value information in protobuf form.
+ *
+ * @generated from message directive.ValueInfo
+ */
+export interface ValueInfo {
+  /**
+   * ValueId is the id of the value on the directive instance.
+   *
+   * @generated from field: uint32 value_id = 1;
+   */
+  valueId?: number
+  /**
+   * ValueType is the Go type of the value.
+   *
+   * @generated from field: string value_type = 2;
+   */
+  valueType?: string
+  /**
+   * DebugVals contains the value debug values.
+   *
+   * @generated from field: repeated directive.ProtoDebugValue debug_vals = 3;
+   */
+  debugVals?: ProtoDebugValue[]
+}
+
+// ValueInfo contains the message type declaration for ValueInfo.
+export const ValueInfo: MessageType<ValueInfo> = createMessageType({
+  typeName: 'directive.ValueInfo',
+  fields: [
+    { no: 1, name: 'value_id', kind: 'scalar', T: ScalarType.UINT32 },
+    { no: 2, name: 'value_type', kind: 'scalar', T: ScalarType.STRING },
+    {
+      no: 3,
+      name: 'debug_vals',
+      kind: 'message',
+      T: () => ProtoDebugValue,
+      repeated: true,
+    },
   ] as readonly PartialFieldInfo[],
   packedByDefault: true,
 })
@@ -0,0 +1,60 @@
+package directive
+
+// NetworkedWithTypeID is a Networked directive with a unique type identifier.
+//
+// The type identifier is used to look up the NetworkedType when the directive
+// is decoded on the other side of an IPC boundary.
+type NetworkedWithTypeID interface {
+	// Networked indicates this is a networked directive.
+	Networked
+
+	// GetNetworkedTypeID returns the unique type identifier.
+	// Ex: controllerbus/example/boilerplate
+	GetNetworkedTypeID() string
+}
+
+// NetworkedType constructs and encodes a single type of Networked directive.
+type NetworkedType interface {
+	// GetNetworkedTypeID returns the unique type identifier.
+	GetNetworkedTypeID() string
+	// NewNetworked constructs a new empty directive for decoding.
+	NewNetworked() Networked
+	// MarshalValue encodes a value resolved for the directive.
+	MarshalValue(val Value) ([]byte, error)
+	// UnmarshalValue decodes a value resolved for the directive.
+	UnmarshalValue(data []byte) (Value, error)
+}
+
+// NetworkedTypeSet is a set of NetworkedType keyed by type ID.
+type NetworkedTypeSet map[string]NetworkedType
+
+// NewNetworkedTypeSet constructs a new NetworkedTypeSet.
+//
+// Later types override earlier types with the same ID.
+func NewNetworkedTypeSet(types ...NetworkedType) NetworkedTypeSet {
+	s := make(NetworkedTypeSet, len(types))
+	for _, t := range types {
+		if t != nil {
+			s[t.GetNetworkedTypeID()] = t
+		}
+	}
+	return s
+}
+
+// GetNetworkedType returns the type with the given ID or nil if not found.
+func (s NetworkedTypeSet) GetNetworkedType(typeID string) NetworkedType {
+	if typeID == "" {
+		return nil
+	}
+	return s[typeID]
+}
+
+// GetNetworkedTypeForDirective returns the type for the directive.
+// Returns nil if the directive is not a NetworkedWithTypeID or not in the set.
+func (s NetworkedTypeSet) GetNetworkedTypeForDirective(dir Directive) NetworkedType {
+	nd, ok := dir.(NetworkedWithTypeID)
+	if !ok {
+		return nil
+	}
+	return s.GetNetworkedType(nd.GetNetworkedTypeID())
+}
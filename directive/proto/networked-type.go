@@ -0,0 +1,56 @@
+package directive_proto
+
+import (
+	"github.com/aperturerobotics/controllerbus/directive"
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+)
+
+// networkedType implements NetworkedType with protobuf directives and values.
+type networkedType[D directive.Networked, V protobuf_go_lite.Message] struct {
+	typeID string
+	newDir func() D
+	newVal func() V
+}
+
+// NewNetworkedType constructs a NetworkedType for a protobuf directive with
+// protobuf values.
+//
+// newDir and newVal construct empty instances for decoding.
+func NewNetworkedType[D directive.Networked, V protobuf_go_lite.Message](
+	typeID string,
+	newDir func() D,
+	newVal func() V,
+) directive.NetworkedType {
+	return &networkedType[D, V]{typeID: typeID, newDir: newDir, newVal: newVal}
+}
+
+// GetNetworkedTypeID returns the unique type identifier.
+func (t *networkedType[D, V]) GetNetworkedTypeID() string {
+	return t.typeID
+}
+
+// NewNetworked constructs a new empty directive for decoding.
+func (t *networkedType[D, V]) NewNetworked() directive.Networked {
+	return t.newDir()
+}
+
+// MarshalValue encodes a value resolved for the directive.
+func (t *networkedType[D, V]) MarshalValue(val directive.Value) ([]byte, error) {
+	pb, ok := val.(protobuf_go_lite.Message)
+	if !ok {
+		return nil, ErrNotProtobuf
+	}
+	return pb.MarshalVT()
+}
+
+// UnmarshalValue decodes a value resolved for the directive.
+func (t *networkedType[D, V]) UnmarshalValue(data []byte) (directive.Value, error) {
+	val := t.newVal()
+	if err := val.UnmarshalVT(data); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// _ is a type assertion
+var _ directive.NetworkedType = ((*networkedType[directive.Networked, protobuf_go_lite.Message])(nil))
@@ -9,6 +9,18 @@ import (
 	"github.com/aperturerobotics/controllerbus/example/boilerplate"
 )
 
+// NetworkedTypeID is the networked type identifier for Boilerplate.
+const NetworkedTypeID = "controllerbus/example/boilerplate/v1"
+
+// NetworkedType is the networked type for Boilerplate.
+//
+// Resolves values of type *BoilerplateResult.
+var NetworkedType = directive_proto.NewNetworkedType(
+	NetworkedTypeID,
+	func() *Boilerplate { return &Boilerplate{} },
+	func() *BoilerplateResult { return &BoilerplateResult{} },
+)
+
 // BoilerplateMessage returns the message to print.
 func (b *Boilerplate) BoilerplateMessage() string {
 	return b.GetMessageText()
@@ -19,6 +31,11 @@ func (b *Boilerplate) GetNetworkedCodec() directive.NetworkedCodec {
 	return directive_proto.GetProtobufCodec()
 }
 
+// GetNetworkedTypeID returns the unique type identifier.
+func (b *Boilerplate) GetNetworkedTypeID() string {
+	return NetworkedTypeID
+}
+
 // GetName returns the directive's type name.
 // This is not necessarily unique, and is primarily intended for display.
 func (b *Boilerplate) GetName() string {
@@ -76,7 +93,8 @@ func (b *Boilerplate) GetDebugVals() directive.DebugValues {
 }
 
 var (
-	_ boilerplate.Boilerplate      = ((*Boilerplate)(nil))
-	_ directive.Debuggable         = ((*Boilerplate)(nil))
-	_ directive.DirectiveWithEquiv = ((*Boilerplate)(nil))
+	_ boilerplate.Boilerplate       = ((*Boilerplate)(nil))
+	_ directive.Debuggable          = ((*Boilerplate)(nil))
+	_ directive.DirectiveWithEquiv  = ((*Boilerplate)(nil))
+	_ directive.NetworkedWithTypeID = ((*Boilerplate)(nil))
 )
@@ -0,0 +1,80 @@
+package plugin_subprocess
+
+import (
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// stdioAddr is the address of a stdio conn.
+type stdioAddr struct{}
+
+// Network returns the name of the network.
+func (stdioAddr) Network() string {
+	return "stdio"
+}
+
+// String returns the string form of the address.
+func (stdioAddr) String() string {
+	return "stdio"
+}
+
+// stdioConn implements net.Conn with a reader and writer pair.
+//
+// Deadlines are not supported and are ignored.
+type stdioConn struct {
+	io.Reader
+	io.Writer
+
+	closeOnce sync.Once
+	closers   []io.Closer
+}
+
+// newStdioConn constructs a new stdio conn.
+//
+// closers are closed when the conn is closed.
+func newStdioConn(rd io.Reader, wr io.Writer, closers ...io.Closer) *stdioConn {
+	return &stdioConn{Reader: rd, Writer: wr, closers: closers}
+}
+
+// Close closes the conn.
+func (c *stdioConn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		for _, cl := range c.closers {
+			if cerr := cl.Close(); cerr != nil && err == nil {
+				err = cerr
+			}
+		}
+	})
+	return err
+}
+
+// LocalAddr returns the local network address.
+func (c *stdioConn) LocalAddr() net.Addr {
+	return stdioAddr{}
+}
+
+// RemoteAddr returns the remote network address.
+func (c *stdioConn) RemoteAddr() net.Addr {
+	return stdioAddr{}
+}
+
+// SetDeadline is not supported and returns nil.
+func (c *stdioConn) SetDeadline(t time.Time) error {
+	return nil
+}
+
+// SetReadDeadline is not supported and returns nil.
+func (c *stdioConn) SetReadDeadline(t time.Time) error {
+	return nil
+}
+
+// SetWriteDeadline is not supported and returns nil.
+func (c *stdioConn) SetWriteDeadline(t time.Time) error {
+	return nil
+}
+
+// _ is a type assertion
+var _ net.Conn = ((*stdioConn)(nil))
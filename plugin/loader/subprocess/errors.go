@@ -0,0 +1,12 @@
+package plugin_subprocess
+
+import "errors"
+
+var (
+	// ErrNoJSONForm is returned if a remote config has no json form.
+	ErrNoJSONForm = errors.New("remote config was not decoded from json")
+	// ErrNoProtoForm is returned if a remote config has no protobuf form.
+	ErrNoProtoForm = errors.New("remote config was not decoded from protobuf")
+	// ErrPluginExited is returned if the plugin process exited.
+	ErrPluginExited = errors.New("plugin process exited")
+)
@@ -0,0 +1,13 @@
+// @generated
+// This file is @generated by prost-build.
+/// Config is configuration for the filesystem sub-process plugin loader.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct Config {
+    /// Dir is the directory to load from.
+    #[prost(string, tag="1")]
+    pub dir: ::prost::alloc::string::String,
+    /// Watch will watch the directory and hot-reload plugins.
+    #[prost(bool, tag="2")]
+    pub watch: bool,
+}
+// @@protoc_insertion_point(module)
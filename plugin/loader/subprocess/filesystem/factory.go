@@ -0,0 +1,57 @@
+//go:build !js && !wasm
+
+package plugin_subprocess_filesystem
+
+import (
+	"context"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/aperturerobotics/controllerbus/controller"
+)
+
+// Factory constructs a sub-process plugin loading from filesystem controller.
+type Factory struct {
+	// bus is the controller bus
+	bus bus.Bus
+}
+
+// NewFactory builds a sub-process plugin filesystem loader factory.
+func NewFactory(bus bus.Bus) *Factory {
+	return &Factory{bus: bus}
+}
+
+// GetConfigID returns the configuration ID for the controller.
+func (t *Factory) GetConfigID() string {
+	return ConfigID
+}
+
+// GetControllerID returns the unique ID for the controller.
+func (t *Factory) GetControllerID() string {
+	return ControllerID
+}
+
+// ConstructConfig constructs an instance of the controller configuration.
+func (t *Factory) ConstructConfig() config.Config {
+	return &Config{}
+}
+
+// Construct constructs the associated controller given configuration.
+func (t *Factory) Construct(
+	ctx context.Context,
+	conf config.Config,
+	opts controller.ConstructOpts,
+) (controller.Controller, error) {
+	le := opts.GetLogger()
+	cc := conf.(*Config)
+
+	return NewController(le, t.bus, cc)
+}
+
+// GetVersion returns the version of this controller.
+func (t *Factory) GetVersion() controller.Version {
+	return Version
+}
+
+// _ is a type assertion
+var _ controller.Factory = ((*Factory)(nil))
@@ -0,0 +1,355 @@
+//go:build !js && !wasm
+
+package plugin_subprocess_filesystem
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	cbus_plugin "github.com/aperturerobotics/controllerbus/plugin"
+	subprocess "github.com/aperturerobotics/controllerbus/plugin/loader/subprocess"
+	"github.com/aperturerobotics/fsnotify"
+	backoff "github.com/aperturerobotics/util/backoff/cbackoff"
+	debounce_fswatcher "github.com/aperturerobotics/util/debounce-fswatcher"
+	"github.com/sirupsen/logrus"
+)
+
+// debounceTime debounces re-sync requests
+var debounceTime = time.Second
+
+// reloadMaxInterval is the max time to wait before reloading a failed plugin.
+//
+// The backoff is reset if the plugin ran for longer than this.
+var reloadMaxInterval = time.Second * 10
+
+// newReloadBackoff constructs the backoff for reloading failed plugins.
+func newReloadBackoff() backoff.BackOff {
+	ebo := backoff.NewExponentialBackOff()
+	ebo.InitialInterval = time.Millisecond * 100
+	ebo.Multiplier = 1.8
+	ebo.MaxInterval = reloadMaxInterval
+	ebo.MaxElapsedTime = 0
+	return ebo
+}
+
+// Watcher watches a filesystem path to start and restart plugin binaries.
+type Watcher struct {
+	// le is the logger
+	le *logrus.Entry
+	// bus is the controller bus
+	bus bus.Bus
+	// wakeCh is signaled when a plugin fails
+	wakeCh chan struct{}
+	// mtx guards below fields
+	mtx sync.Mutex
+	// loadedPlugins is the set of loaded plugins
+	loadedPlugins map[string]*subprocess.LoadedPlugin
+	// failedPlugins contains the plugins waiting to be reloaded
+	failedPlugins map[string]*failedPlugin
+}
+
+// failedPlugin is a plugin which exited or failed to load.
+type failedPlugin struct {
+	// stat is the stat of the plugin binary which failed
+	stat *cbus_plugin.PluginStat
+	// bo is the backoff for reloading the plugin
+	bo backoff.BackOff
+	// retryAt is the time to reload the plugin
+	retryAt time.Time
+}
+
+// NewWatcher builds a new filesystem watcher.
+func NewWatcher(le *logrus.Entry, bus bus.Bus) *Watcher {
+	return &Watcher{
+		le:            le,
+		bus:           bus,
+		wakeCh:        make(chan struct{}, 1),
+		loadedPlugins: make(map[string]*subprocess.LoadedPlugin),
+		failedPlugins: make(map[string]*failedPlugin),
+	}
+}
+
+// UnloadPlugin unloads a loaded plugin by ID.
+func (w *Watcher) UnloadPlugin(id string) {
+	w.mtx.Lock()
+	plug, plugOk := w.loadedPlugins[id]
+	if !plugOk {
+		w.mtx.Unlock()
+		return
+	}
+	w.le.
+		WithField("plugin-path", id).
+		Info("unloading plugin")
+	delete(w.loadedPlugins, id)
+	w.mtx.Unlock()
+	plug.Close()
+}
+
+// LoadPlugin loads a plugin from a path.
+//
+// If the path was already loaded, no-op.
+func (w *Watcher) LoadPlugin(ctx context.Context, plugPath string) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return w.loadPluginLocked(ctx, plugPath)
+}
+
+// loadPluginLocked loads plugin if mtx is locked by caller.
+func (w *Watcher) loadPluginLocked(ctx context.Context, plugPath string) error {
+	_, plugOk := w.loadedPlugins[plugPath]
+	if plugOk {
+		return nil
+	}
+
+	w.le.
+		WithField("plugin-path", plugPath).
+		Info("loading plugin")
+	lp, err := subprocess.LoadPluginSubprocess(
+		ctx,
+		w.le.WithField("plugin-id", plugPath),
+		w.bus,
+		plugPath,
+	)
+	if err != nil {
+		w.markFailedLocked(plugPath, nil)
+		return err
+	}
+	w.loadedPlugins[plugPath] = lp
+	go w.waitPluginExit(ctx, plugPath, lp, time.Now())
+	w.le.
+		WithField("plugin-path", plugPath).
+		WithField("plugin-binary-id", lp.GetBinaryID()).
+		WithField("plugin-binary-version", lp.GetBinaryVersion()).
+		Info("successfully loaded plugin")
+	return nil
+}
+
+// waitPluginExit removes the plugin if it exits and wakes the watcher to
+// reload it with backoff.
+func (w *Watcher) waitPluginExit(ctx context.Context, plugPath string, lp *subprocess.LoadedPlugin, loadedAt time.Time) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-lp.Done():
+	}
+
+	w.mtx.Lock()
+	exited := w.loadedPlugins[plugPath] == lp
+	if exited {
+		delete(w.loadedPlugins, plugPath)
+		if time.Since(loadedAt) > reloadMaxInterval {
+			delete(w.failedPlugins, plugPath)
+		}
+		w.markFailedLocked(plugPath, &lp.PluginStat)
+	}
+	w.mtx.Unlock()
+	if !exited {
+		// unloaded by the watcher
+		return
+	}
+
+	w.le.
+		WithField("plugin-path", plugPath).
+		Warn("plugin exited, reloading with backoff")
+	select {
+	case w.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// markFailedLocked schedules reloading a plugin which failed with backoff.
+//
+// st is the stat of the failed binary, if known.
+func (w *Watcher) markFailedLocked(plugPath string, st *cbus_plugin.PluginStat) {
+	fp := w.failedPlugins[plugPath]
+	if fp == nil {
+		fp = &failedPlugin{bo: newReloadBackoff()}
+		w.failedPlugins[plugPath] = fp
+	}
+	if st == nil {
+		st, _ = cbus_plugin.NewPluginStat(plugPath)
+	}
+	fp.stat = st
+	fp.retryAt = time.Now().Add(fp.bo.NextBackOff())
+}
+
+// nextRetry returns a channel which fires when the next failed plugin should be
+// reloaded, or nil if there are none.
+func (w *Watcher) nextRetry() <-chan time.Time {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	var next time.Time
+	for plugPath, fp := range w.failedPlugins {
+		if _, loaded := w.loadedPlugins[plugPath]; loaded {
+			continue
+		}
+		if next.IsZero() || fp.retryAt.Before(next) {
+			next = fp.retryAt
+		}
+	}
+	if next.IsZero() {
+		return nil
+	}
+	return time.After(time.Until(next))
+}
+
+// SyncPlugins synchronizes the loaded plugins with all in a scan dir.
+//
+// If any plugin files were removed, unloads those plugins. Plugins which failed
+// are reloaded once their backoff expires or the plugin binary changes.
+func (w *Watcher) SyncPlugins(ctx context.Context, scanDir string) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	dirContents, err := os.ReadDir(scanDir)
+	if err != nil {
+		return err
+	}
+	foundNames := make(map[string]*cbus_plugin.PluginStat)
+	for _, df := range dirContents {
+		if df.IsDir() || !df.Type().IsRegular() {
+			continue
+		}
+		dfName := df.Name()
+		if !strings.HasSuffix(dfName, subprocess.PluginSuffix) {
+			continue
+		}
+		plugPath := path.Join(scanDir, dfName)
+		dfStat, err := cbus_plugin.NewPluginStat(plugPath)
+		if err != nil {
+			w.le.
+				WithError(err).
+				WithField("plugin-path", plugPath).
+				Warn("cannot stat plugin path")
+			continue
+		}
+		foundNames[plugPath] = dfStat
+	}
+	for loadedID, loadedInfo := range w.loadedPlugins {
+		foundPlugin, ok := foundNames[loadedID]
+		if !ok {
+			// plugin no longer exists.
+			loadedInfo.Close()
+			delete(w.loadedPlugins, loadedID)
+			w.le.Debugf(
+				"%s: unloading removed plugin, removed(%d){%s}",
+				loadedID,
+				loadedInfo.GetBinarySize(),
+				loadedInfo.GetModificationTime().String(),
+			)
+			continue
+		}
+
+		plugsEqual := foundPlugin.Equal(&loadedInfo.PluginStat)
+		if plugsEqual {
+			continue
+		}
+		w.le.Debugf(
+			"%s: reloading plugin, discovered(%d){%s} != loaded(%d){%s}",
+			loadedID,
+			foundPlugin.GetBinarySize(),
+			foundPlugin.GetModificationTime().String(),
+			loadedInfo.GetBinarySize(),
+			loadedInfo.GetModificationTime().String(),
+		)
+		loadedInfo.Close()
+		delete(w.loadedPlugins, loadedID)
+	}
+	now := time.Now()
+	for plugFile, fp := range w.failedPlugins {
+		foundPlugin, ok := foundNames[plugFile]
+		if !ok || fp.stat == nil || !foundPlugin.Equal(fp.stat) {
+			// plugin was removed or changed: reset the backoff.
+			delete(w.failedPlugins, plugFile)
+		}
+	}
+	for plugFile := range foundNames {
+		if fp := w.failedPlugins[plugFile]; fp != nil && now.Before(fp.retryAt) {
+			continue
+		}
+		if _, ok := w.loadedPlugins[plugFile]; !ok {
+			if err := w.loadPluginLocked(ctx, plugFile); err != nil {
+				w.le.
+					WithError(err).
+					Warn("unable to load plugin file")
+			}
+		}
+	}
+	return nil
+}
+
+// UnloadAll unloads all plugins.
+func (w *Watcher) UnloadAll() {
+	w.mtx.Lock()
+	for id, pg := range w.loadedPlugins {
+		pg.Close()
+		delete(w.loadedPlugins, id)
+	}
+	for id := range w.failedPlugins {
+		delete(w.failedPlugins, id)
+	}
+	w.mtx.Unlock()
+}
+
+// Execute executes the watcher and loads / runs plugins.
+func (w *Watcher) Execute(ctx context.Context, syncDir string, watch bool) error {
+	defer w.UnloadAll()
+	if err := w.SyncPlugins(ctx, syncDir); err != nil {
+		return err
+	}
+
+	// syncCh is signaled when the directory changes
+	syncCh := make(chan struct{}, 1)
+	errCh := make(chan error, 1)
+	if watch {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return err
+		}
+		defer watcher.Close()
+
+		if err := watcher.Add(syncDir); err != nil {
+			return err
+		}
+
+		// debounce in a separate routine to see changes made while syncing
+		go func() {
+			for {
+				happened, err := debounce_fswatcher.DebounceFSWatcherEvents(ctx, watcher, debounceTime, nil)
+				if err != nil {
+					errCh <- err
+					return
+				}
+				w.le.Debugf("re-syncing plugins after %d filesystem events", len(happened))
+				select {
+				case syncCh <- struct{}{}:
+				default:
+				}
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			return err
+		case <-w.wakeCh:
+			// a plugin failed: wait for the retry
+			continue
+		case <-syncCh:
+		case <-w.nextRetry():
+		}
+		if err := w.SyncPlugins(ctx, syncDir); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,219 @@
+//go:build !js && !wasm
+
+package plugin_subprocess_filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
+	cbus_plugin "github.com/aperturerobotics/controllerbus/plugin"
+	subprocess "github.com/aperturerobotics/controllerbus/plugin/loader/subprocess"
+	"github.com/sirupsen/logrus"
+)
+
+// testPluginEnv is set to run the test binary as a plugin.
+const testPluginEnv = "CONTROLLERBUS_TEST_FILESYSTEM_PLUGIN"
+
+// TestMain runs the test binary as a plugin if testPluginEnv is set.
+func TestMain(m *testing.M) {
+	if os.Getenv(testPluginEnv) != "" {
+		le := logrus.NewEntry(logrus.New())
+		plug := cbus_plugin.NewStaticPlugin("test-plugin", "v0.0.1", func(b bus.Bus) []controller.Factory {
+			return []controller.Factory{boilerplate_controller.NewFactory(b)}
+		})
+		if err := subprocess.ServePluginStdio(context.Background(), le, plug, boilerplate_v1.NetworkedType); err != nil {
+			le.WithError(err).Warn("plugin exited")
+		}
+		return
+	}
+	debounceTime = 50 * time.Millisecond
+	os.Exit(m.Run())
+}
+
+// TestWatcher tests loading and unloading plugin binaries in a directory.
+func TestWatcher(t *testing.T) {
+	t.Setenv(testPluginEnv, "1")
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	exePath, err := os.Executable()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	exeData, err := os.ReadFile(exePath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	dir := t.TempDir()
+	readmePath := filepath.Join(dir, "README.md")
+	if err := os.WriteFile(readmePath, []byte("not a plugin"), 0o644); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ctrl, err := NewController(le, b, &Config{Dir: dir, Watch: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	execCtx, execCtxCancel := context.WithCancel(ctx)
+	execDone := make(chan struct{})
+	go func() {
+		_ = b.ExecuteController(execCtx, ctrl)
+		close(execDone)
+	}()
+	defer func() {
+		execCtxCancel()
+		<-execDone
+	}()
+
+	// hasFactory checks if the plugin factory is available on the bus.
+	hasFactory := func() bool {
+		ctorVal, _, ctorRef, err := bus.ExecOneOff(
+			ctx,
+			b,
+			resolver.NewLoadConfigConstructorByID(boilerplate_controller.ConfigID),
+			bus.ReturnWhenIdle(),
+			nil,
+		)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if ctorRef != nil {
+			ctorRef.Release()
+		}
+		return ctorVal != nil
+	}
+	// waitFactory waits for the plugin factory to be available or removed.
+	//
+	// Events between two debounce calls are dropped, so README.md is touched
+	// on each retry to sync the directory again.
+	waitFactory := func(available bool) {
+		for hasFactory() != available {
+			select {
+			case <-ctx.Done():
+				t.Fatalf("expected factory available to be %v", available)
+			case <-time.After(100 * time.Millisecond):
+			}
+			now := time.Now()
+			if err := os.Chtimes(readmePath, now, now); err != nil {
+				t.Fatal(err.Error())
+			}
+		}
+	}
+
+	if hasFactory() {
+		t.Fatal("expected no factory before the plugin is added")
+	}
+
+	// a plugin binary added to the directory is started
+	plugPath := filepath.Join(dir, "test"+subprocess.PluginSuffix)
+	if err := os.WriteFile(plugPath, exeData, 0o755); err != nil {
+		t.Fatal(err.Error())
+	}
+	waitFactory(true)
+
+	// a removed plugin binary is stopped
+	if err := os.Remove(plugPath); err != nil {
+		t.Fatal(err.Error())
+	}
+	waitFactory(false)
+}
+
+// TestWatcherReload tests reloading a plugin after its process exits.
+func TestWatcherReload(t *testing.T) {
+	t.Setenv(testPluginEnv, "1")
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	exePath, err := os.Executable()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	exeData, err := os.ReadFile(exePath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	dir := t.TempDir()
+	plugPath := filepath.Join(dir, "test"+subprocess.PluginSuffix)
+	if err := os.WriteFile(plugPath, exeData, 0o755); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	w := NewWatcher(le, b)
+	execCtx, execCtxCancel := context.WithCancel(ctx)
+	execDone := make(chan struct{})
+	go func() {
+		_ = w.Execute(execCtx, dir, false)
+		close(execDone)
+	}()
+	defer func() {
+		execCtxCancel()
+		<-execDone
+	}()
+
+	// waitLoaded waits for a plugin other than prev to be loaded.
+	waitLoaded := func(prev *subprocess.LoadedPlugin) *subprocess.LoadedPlugin {
+		for {
+			w.mtx.Lock()
+			lp := w.loadedPlugins[plugPath]
+			w.mtx.Unlock()
+			if lp != nil && lp != prev {
+				return lp
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatal("expected plugin to be loaded")
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	lp := waitLoaded(nil)
+	for range 2 {
+		// killing the plugin process reloads the plugin
+		if err := lp.GetProcess().Kill(); err != nil {
+			t.Fatal(err.Error())
+		}
+		nlp := waitLoaded(lp)
+		if nlp.GetProcess().Pid == lp.GetProcess().Pid {
+			t.Fatal("expected a new plugin process")
+		}
+		lp = nlp
+	}
+
+	// the reloaded plugin provides the factory
+	ctorVal, _, ctorRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadConfigConstructorByID(boilerplate_controller.ConfigID),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	ctorRef.Release()
+	if ctorVal == nil {
+		t.Fatal("expected factory from the reloaded plugin")
+	}
+}
@@ -0,0 +1,112 @@
+package plugin_subprocess
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/directive"
+	cbus_plugin "github.com/aperturerobotics/controllerbus/plugin"
+)
+
+// PluginServer implements the SubprocessPlugin api inside the plugin process.
+type PluginServer struct {
+	plugin   cbus_plugin.Plugin
+	resolver cbus_plugin.PluginResolver
+	typeIDs  []string
+}
+
+// NewPluginServer constructs a new PluginServer.
+//
+// types are the networked directive types linked with the host bus.
+func NewPluginServer(
+	plugin cbus_plugin.Plugin,
+	resolver cbus_plugin.PluginResolver,
+	types ...directive.NetworkedType,
+) *PluginServer {
+	typeIDs := make([]string, 0, len(types))
+	for _, dirType := range types {
+		typeIDs = append(typeIDs, dirType.GetNetworkedTypeID())
+	}
+	slices.Sort(typeIDs)
+	return &PluginServer{plugin: plugin, resolver: resolver, typeIDs: slices.Compact(typeIDs)}
+}
+
+// GetPluginInfo returns information about the plugin binary.
+func (s *PluginServer) GetPluginInfo(
+	ctx context.Context,
+	req *GetPluginInfoRequest,
+) (*GetPluginInfoResponse, error) {
+	return &GetPluginInfoResponse{
+		BinaryId:         s.plugin.GetBinaryID(),
+		BinaryVersion:    s.plugin.GetBinaryVersion(),
+		DirectiveTypeIds: s.typeIDs,
+	}, nil
+}
+
+// GetFactoryInfo looks up the factory for a config ID.
+func (s *PluginServer) GetFactoryInfo(
+	ctx context.Context,
+	req *GetFactoryInfoRequest,
+) (*GetFactoryInfoResponse, error) {
+	ctor, err := s.resolver.GetConfigCtorByID(ctx, req.GetConfigId())
+	if err != nil || ctor == nil {
+		return &GetFactoryInfoResponse{}, err
+	}
+	factory, err := s.resolver.GetFactoryMatchingConfig(ctx, ctor.ConstructConfig())
+	if err != nil || factory == nil {
+		return &GetFactoryInfoResponse{}, err
+	}
+	info, err := NewFactoryInfo(factory)
+	if err != nil {
+		return nil, err
+	}
+	return &GetFactoryInfoResponse{
+		Found:        true,
+		Version:      info.GetVersion(),
+		ConfigSchema: info.GetConfigSchema(),
+	}, nil
+}
+
+// ListFactories lists the factories provided by the plugin.
+//
+// Returns an empty list if the resolver cannot list factories.
+func (s *PluginServer) ListFactories(
+	ctx context.Context,
+	req *ListFactoriesRequest,
+) (*ListFactoriesResponse, error) {
+	lister, ok := s.resolver.(controller.FactoryLister)
+	if !ok {
+		return &ListFactoriesResponse{}, nil
+	}
+	factories, err := lister.ListFactories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ListFactoriesResponse{Factories: make([]*FactoryInfo, 0, len(factories))}
+	for _, factory := range factories {
+		info, err := NewFactoryInfo(factory)
+		if err != nil {
+			return nil, err
+		}
+		resp.Factories = append(resp.Factories, info)
+	}
+	return resp, nil
+}
+
+// NewFactoryInfo builds the FactoryInfo for a factory.
+func NewFactoryInfo(factory controller.Factory) (*FactoryInfo, error) {
+	schema, err := config.GetConfigSchema(factory)
+	if err != nil {
+		return nil, err
+	}
+	return &FactoryInfo{
+		ConfigId:     factory.GetConfigID(),
+		Version:      factory.GetVersion().String(),
+		ConfigSchema: string(schema),
+	}, nil
+}
+
+// _ is a type assertion
+var _ SRPCSubprocessPluginServer = ((*PluginServer)(nil))
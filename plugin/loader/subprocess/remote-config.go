@@ -0,0 +1,111 @@
+package plugin_subprocess
+
+import (
+	"bytes"
+
+	"github.com/aperturerobotics/controllerbus/config"
+)
+
+// RemoteConfig is a config for a controller in a plugin sub-process.
+//
+// The config type is not known to the host, so the encoded config is stored
+// as-is and decoded by the plugin. Holds either the protobuf or json form.
+type RemoteConfig struct {
+	configID string
+	data     []byte
+	jsonData []byte
+}
+
+// NewRemoteConfig constructs a new empty RemoteConfig.
+func NewRemoteConfig(configID string) *RemoteConfig {
+	return &RemoteConfig{configID: configID}
+}
+
+// GetConfigID returns the config identifier.
+func (c *RemoteConfig) GetConfigID() string {
+	return c.configID
+}
+
+// GetConfigData returns the encoded config to send to the plugin.
+//
+// Returns the json form if set, otherwise the protobuf form.
+func (c *RemoteConfig) GetConfigData() []byte {
+	if len(c.jsonData) != 0 {
+		return c.jsonData
+	}
+	return c.data
+}
+
+// Validate validates the configuration.
+//
+// The config is validated by the plugin when the controller is constructed.
+func (c *RemoteConfig) Validate() error {
+	return nil
+}
+
+// EqualsConfig checks equality between two configs.
+func (c *RemoteConfig) EqualsConfig(c2 config.Config) bool {
+	oc, ok := c2.(*RemoteConfig)
+	if !ok {
+		return false
+	}
+	return c.configID == oc.configID &&
+		bytes.Equal(c.data, oc.data) &&
+		bytes.Equal(c.jsonData, oc.jsonData)
+}
+
+// MarshalJSON marshals the config to JSON.
+//
+// Returns ErrNoJSONForm if the config was not decoded from JSON.
+func (c *RemoteConfig) MarshalJSON() ([]byte, error) {
+	if len(c.jsonData) == 0 {
+		if len(c.data) != 0 {
+			return nil, ErrNoJSONForm
+		}
+		return []byte("{}"), nil
+	}
+	return bytes.Clone(c.jsonData), nil
+}
+
+// UnmarshalJSON unmarshals the config from JSON.
+func (c *RemoteConfig) UnmarshalJSON(data []byte) error {
+	c.data, c.jsonData = nil, bytes.Clone(data)
+	return nil
+}
+
+// SizeVT returns the size of the message when marshaled.
+func (c *RemoteConfig) SizeVT() int {
+	return len(c.data)
+}
+
+// MarshalToSizedBufferVT marshals to a buffer that already is SizeVT bytes long.
+func (c *RemoteConfig) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if len(c.jsonData) != 0 {
+		return 0, ErrNoProtoForm
+	}
+	return copy(dAtA[len(dAtA)-len(c.data):], c.data), nil
+}
+
+// MarshalVT marshals the config to protobuf.
+//
+// Returns ErrNoProtoForm if the config was decoded from JSON.
+func (c *RemoteConfig) MarshalVT() ([]byte, error) {
+	if len(c.jsonData) != 0 {
+		return nil, ErrNoProtoForm
+	}
+	return bytes.Clone(c.data), nil
+}
+
+// UnmarshalVT unmarshals the config from protobuf.
+func (c *RemoteConfig) UnmarshalVT(data []byte) error {
+	c.data, c.jsonData = bytes.Clone(data), nil
+	return nil
+}
+
+// Reset resets the config.
+func (c *RemoteConfig) Reset() {
+	c.data, c.jsonData = nil, nil
+}
+
+// _ is a type assertion
+var _ config.Config = ((*RemoteConfig)(nil))
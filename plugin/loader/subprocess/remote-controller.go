@@ -0,0 +1,121 @@
+package plugin_subprocess
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	"github.com/aperturerobotics/controllerbus/controller"
+	configset_proto "github.com/aperturerobotics/controllerbus/controller/configset/proto"
+	controller_exec "github.com/aperturerobotics/controllerbus/controller/exec"
+	"github.com/aperturerobotics/controllerbus/directive"
+	"github.com/sirupsen/logrus"
+)
+
+// remoteControllerConfigID is the configset id used for remote controllers.
+const remoteControllerConfigID = "controller"
+
+// RemoteController is a controller running in a plugin sub-process.
+//
+// Execute runs the controller on the plugin bus with ExecController.
+type RemoteController struct {
+	le         *logrus.Entry
+	client     bus_api.SRPCControllerBusServiceClient
+	configID   string
+	configData []byte
+
+	mtx  sync.Mutex
+	info *controller.Info
+}
+
+// NewRemoteController constructs a new RemoteController.
+//
+// info is returned by GetControllerInfo until the plugin reports the info of
+// the running controller.
+func NewRemoteController(
+	le *logrus.Entry,
+	client bus_api.SRPCControllerBusServiceClient,
+	info *controller.Info,
+	configID string,
+	configData []byte,
+) *RemoteController {
+	return &RemoteController{
+		le:         le,
+		client:     client,
+		info:       info,
+		configID:   configID,
+		configData: configData,
+	}
+}
+
+// Execute executes the controller goroutine.
+// Returning nil ends execution.
+// Returning an error triggers a retry with backoff.
+func (c *RemoteController) Execute(ctx context.Context) error {
+	strm, err := c.client.ExecController(ctx, &controller_exec.ExecControllerRequest{
+		ConfigSet: &configset_proto.ConfigSet{
+			Configs: map[string]*configset_proto.ControllerConfig{
+				remoteControllerConfigID: {
+					Id:     c.configID,
+					Config: c.configData,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer strm.Close()
+
+	for {
+		resp, err := strm.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		switch resp.GetStatus() {
+		case controller_exec.ControllerStatus_ControllerStatus_RUNNING,
+			controller_exec.ControllerStatus_ControllerStatus_READY:
+			if info := resp.GetControllerInfo(); info != nil {
+				c.mtx.Lock()
+				c.info = info.Clone()
+				c.mtx.Unlock()
+			}
+		case controller_exec.ControllerStatus_ControllerStatus_ERROR,
+			controller_exec.ControllerStatus_ControllerStatus_QUARANTINED:
+			return errors.New(resp.GetErrorInfo())
+		}
+	}
+}
+
+// HandleDirective asks if the handler can resolve the directive.
+//
+// Directives are forwarded to the plugin bus once per plugin by the bus link
+// started in ConnectPlugin instead of once per controller, which would attach
+// the same values for each running controller: this returns nil.
+func (c *RemoteController) HandleDirective(
+	ctx context.Context,
+	di directive.Instance,
+) ([]directive.Resolver, error) {
+	return nil, nil
+}
+
+// GetControllerInfo returns information about the controller.
+func (c *RemoteController) GetControllerInfo() *controller.Info {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.info.Clone()
+}
+
+// Close releases any resources used by the controller.
+// Error indicates any issue encountered releasing.
+func (c *RemoteController) Close() error {
+	return nil
+}
+
+// _ is a type assertion
+var _ controller.Controller = ((*RemoteController)(nil))
@@ -0,0 +1,83 @@
+package plugin_subprocess
+
+import (
+	"context"
+
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/aperturerobotics/controllerbus/controller"
+)
+
+// RemoteFactory constructs controllers running in a plugin sub-process.
+type RemoteFactory struct {
+	client   bus_api.SRPCControllerBusServiceClient
+	configID string
+	version  controller.Version
+	schema   []byte
+}
+
+// NewRemoteFactory constructs a new RemoteFactory.
+func NewRemoteFactory(
+	client bus_api.SRPCControllerBusServiceClient,
+	configID string,
+	version controller.Version,
+	schema []byte,
+) *RemoteFactory {
+	return &RemoteFactory{
+		client:   client,
+		configID: configID,
+		version:  version,
+		schema:   schema,
+	}
+}
+
+// GetConfigID returns the configuration ID for the controller.
+func (f *RemoteFactory) GetConfigID() string {
+	return f.configID
+}
+
+// ConstructConfig constructs an instance of the controller configuration.
+func (f *RemoteFactory) ConstructConfig() config.Config {
+	return NewRemoteConfig(f.configID)
+}
+
+// GetConfigSchema returns the JSON schema of the config provided by the plugin.
+func (f *RemoteFactory) GetConfigSchema() ([]byte, error) {
+	return f.schema, nil
+}
+
+// Construct constructs the associated controller given configuration.
+//
+// conf is usually a RemoteConfig, other config types are encoded to protobuf.
+func (f *RemoteFactory) Construct(
+	ctx context.Context,
+	conf config.Config,
+	opts controller.ConstructOpts,
+) (controller.Controller, error) {
+	var configData []byte
+	if rc, ok := conf.(*RemoteConfig); ok {
+		configData = rc.GetConfigData()
+	} else {
+		var err error
+		configData, err = conf.MarshalVT()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the controller id is not known until the controller is running
+	info := controller.NewInfo(f.configID, f.version, "plugin sub-process controller")
+	return NewRemoteController(opts.GetLogger(), f.client, info, f.configID, configData), nil
+}
+
+// GetVersion returns the version of this controller.
+func (f *RemoteFactory) GetVersion() controller.Version {
+	return f.version
+}
+
+// _ is a type assertion
+var (
+	_ controller.Factory           = ((*RemoteFactory)(nil))
+	_ config.Constructor           = ((*RemoteFactory)(nil))
+	_ config.ConstructorWithSchema = ((*RemoteFactory)(nil))
+)
@@ -0,0 +1,157 @@
+package plugin_subprocess
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/pkg/errors"
+)
+
+// Version is the resolver version.
+var Version = controller.MustParseVersion("0.0.1")
+
+// RemoteResolver resolves factories from a plugin sub-process.
+type RemoteResolver struct {
+	id           string
+	pluginClient SRPCSubprocessPluginClient
+	busClient    bus_api.SRPCControllerBusServiceClient
+
+	mtx       sync.Mutex
+	factories map[string]*RemoteFactory
+}
+
+// NewRemoteResolver constructs a new RemoteResolver.
+func NewRemoteResolver(
+	pluginBinaryID string,
+	pluginBinaryVersion string,
+	pluginClient SRPCSubprocessPluginClient,
+	busClient bus_api.SRPCControllerBusServiceClient,
+) *RemoteResolver {
+	id := strings.Join([]string{
+		"controllerbus",
+		"subprocess",
+		"plugin",
+		pluginBinaryID,
+		pluginBinaryVersion,
+		"remote-resolver",
+	}, "/")
+	return &RemoteResolver{
+		id:           id,
+		pluginClient: pluginClient,
+		busClient:    busClient,
+		factories:    make(map[string]*RemoteFactory),
+	}
+}
+
+// GetResolverID returns the resolver identifier.
+func (r *RemoteResolver) GetResolverID() string {
+	return r.id
+}
+
+// GetResolverVersion returns the resolver version.
+func (r *RemoteResolver) GetResolverVersion() controller.Version {
+	return Version
+}
+
+// GetConfigCtorByID returns a config constructor matching the ID.
+// If none found, return nil, nil
+func (r *RemoteResolver) GetConfigCtorByID(
+	ctx context.Context, id string,
+) (config.Constructor, error) {
+	factory, err := r.lookupFactory(ctx, id)
+	if err != nil || factory == nil {
+		return nil, err
+	}
+	return factory, nil
+}
+
+// GetFactoryMatchingConfig returns the factory that matches the config.
+// If no factory is found, return nil.
+// If an unexpected error occurs, return it.
+func (r *RemoteResolver) GetFactoryMatchingConfig(
+	ctx context.Context, c config.Config,
+) (controller.Factory, error) {
+	factory, err := r.lookupFactory(ctx, c.GetConfigID())
+	if err != nil || factory == nil {
+		return nil, err
+	}
+	return factory, nil
+}
+
+// lookupFactory looks up the factory for a config id with the plugin.
+// Returns nil, nil if not found.
+func (r *RemoteResolver) lookupFactory(ctx context.Context, configID string) (*RemoteFactory, error) {
+	if configID == "" {
+		return nil, nil
+	}
+
+	r.mtx.Lock()
+	factory := r.factories[configID]
+	r.mtx.Unlock()
+	if factory != nil {
+		return factory, nil
+	}
+
+	resp, err := r.pluginClient.GetFactoryInfo(ctx, &GetFactoryInfoRequest{ConfigId: configID})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.GetFound() {
+		return nil, nil
+	}
+	return r.addFactory(&FactoryInfo{
+		ConfigId:     configID,
+		Version:      resp.GetVersion(),
+		ConfigSchema: resp.GetConfigSchema(),
+	})
+}
+
+// ListFactories lists the factories provided by the plugin.
+func (r *RemoteResolver) ListFactories(ctx context.Context) ([]controller.Factory, error) {
+	resp, err := r.pluginClient.ListFactories(ctx, &ListFactoriesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	factories := make([]controller.Factory, 0, len(resp.GetFactories()))
+	for _, info := range resp.GetFactories() {
+		factory, err := r.addFactory(info)
+		if err != nil {
+			return nil, err
+		}
+		factories = append(factories, factory)
+	}
+	return factories, nil
+}
+
+// addFactory adds a factory from the plugin factory info.
+// Returns the existing factory if one was already added for the config id.
+func (r *RemoteResolver) addFactory(info *FactoryInfo) (*RemoteFactory, error) {
+	configID := info.GetConfigId()
+	version, err := controller.ParseVersion(info.GetVersion())
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse factory version for %s", configID)
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if existing := r.factories[configID]; existing != nil {
+		return existing, nil
+	}
+	var schema []byte
+	if s := info.GetConfigSchema(); s != "" {
+		schema = []byte(s)
+	}
+	factory := NewRemoteFactory(r.busClient, configID, version, schema)
+	r.factories[configID] = factory
+	return factory, nil
+}
+
+// _ is a type assertion
+var (
+	_ controller.FactoryResolver = ((*RemoteResolver)(nil))
+	_ controller.FactoryLister   = ((*RemoteResolver)(nil))
+)
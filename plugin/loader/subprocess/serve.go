@@ -0,0 +1,128 @@
+package plugin_subprocess
+
+import (
+	"context"
+	"net"
+	"os"
+
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	configset_controller "github.com/aperturerobotics/controllerbus/controller/configset/controller"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/directive"
+	cbus_plugin "github.com/aperturerobotics/controllerbus/plugin"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// NewPluginMux constructs a bus for the plugin and an rpc mux exposing it.
+//
+// The mux serves the SubprocessPlugin api and the ControllerBusService for the
+// plugin bus. types are the networked directive types linked with the host bus:
+// the host executes its directives of these types on the plugin bus, and
+// serves the plugin directives of these types. Call the returned function to
+// unload the plugin.
+func NewPluginMux(
+	ctx context.Context,
+	le *logrus.Entry,
+	plug cbus_plugin.Plugin,
+	types ...directive.NetworkedType,
+) (srpc.Mux, func(), error) {
+	// #nosec G118 -- cancel func is called by the returned release func.
+	subCtx, subCtxCancel := context.WithCancel(ctx)
+	b, _, err := core.NewCoreBus(subCtx, le)
+	if err != nil {
+		subCtxCancel()
+		return nil, nil, err
+	}
+
+	// ConfigSet controller, used by ExecController.
+	_, csRef, err := b.AddDirective(
+		resolver.NewLoadControllerWithConfig(&configset_controller.Config{}),
+		nil,
+	)
+	if err != nil {
+		subCtxCancel()
+		return nil, nil, errors.Wrap(err, "construct configset controller")
+	}
+
+	pluginResolver, err := plug.NewPluginResolver(subCtx, b)
+	if err != nil {
+		csRef.Release()
+		subCtxCancel()
+		return nil, nil, err
+	}
+	resolverController := resolver.NewController(le, b, pluginResolver)
+	relResolver, err := b.AddController(subCtx, resolverController, nil)
+	if err != nil {
+		csRef.Release()
+		subCtxCancel()
+		pluginResolver.PrePluginUnload()
+		plug.PrePluginUnload()
+		return nil, nil, err
+	}
+	release := func() {
+		csRef.Release()
+		subCtxCancel()
+		relResolver()
+		pluginResolver.PrePluginUnload()
+		plug.PrePluginUnload()
+	}
+
+	mux := srpc.NewMux()
+	api := bus_api.NewAPI(b, &bus_api.Config{
+		EnableExecController:  true,
+		EnableExecDirective:   true,
+		EnableServeDirectives: true,
+		NoServeExecDirectives: true,
+	}, types...)
+	if err := api.RegisterAsSRPCServer(mux); err != nil {
+		release()
+		return nil, nil, err
+	}
+	if err := SRPCRegisterSubprocessPlugin(mux, NewPluginServer(plug, pluginResolver, types...)); err != nil {
+		release()
+		return nil, nil, err
+	}
+	return mux, release, nil
+}
+
+// ServePlugin runs the plugin and serves it to the host over conn.
+//
+// Returns when the conn is closed or ctx is canceled.
+func ServePlugin(
+	ctx context.Context,
+	le *logrus.Entry,
+	plug cbus_plugin.Plugin,
+	conn net.Conn,
+	types ...directive.NetworkedType,
+) error {
+	mux, release, err := NewPluginMux(ctx, le, plug, types...)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	mc, err := srpc.NewMuxedConn(conn, false, nil)
+	if err != nil {
+		return err
+	}
+	defer mc.Close()
+
+	return srpc.NewServer(mux).AcceptMuxedConn(ctx, mc)
+}
+
+// ServePluginStdio runs the plugin and serves it to the host over stdin/stdout.
+//
+// Call this from the main function of the plugin binary. Logs must be written
+// to stderr as stdout is used for the rpc conn.
+func ServePluginStdio(
+	ctx context.Context,
+	le *logrus.Entry,
+	plug cbus_plugin.Plugin,
+	types ...directive.NetworkedType,
+) error {
+	conn := newStdioConn(os.Stdin, os.Stdout, os.Stdin, os.Stdout)
+	return ServePlugin(ctx, le, plug, conn, types...)
+}
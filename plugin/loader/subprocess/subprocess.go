@@ -0,0 +1,223 @@
+package plugin_subprocess
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"slices"
+	"sync"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/directive"
+	cbus_plugin "github.com/aperturerobotics/controllerbus/plugin"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/sirupsen/logrus"
+)
+
+// PluginSuffix is the plugin binary file suffix.
+const PluginSuffix = ".cbus"
+
+// LoadedPlugin contains a plugin running in a sub-process.
+type LoadedPlugin struct {
+	*RemoteResolver
+	cbus_plugin.PluginStat
+
+	binaryID      string
+	binaryVersion string
+	client        srpc.Client
+	// process is the plugin process, if started with LoadPluginSubprocess.
+	process *os.Process
+
+	closeOnce sync.Once
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	// release is called when the plugin is closed, if set.
+	release func()
+}
+
+// ConnectPlugin connects to a plugin with a rpc client.
+//
+// Adds a resolver for the plugin factories to the bus. The bus is linked with
+// the plugin bus with bus_api.LinkBus for the types also served by the plugin,
+// so controllers in the plugin resolve directives on the bus and the reverse.
+// Close the LoadedPlugin to remove the resolver and the link.
+func ConnectPlugin(
+	ctx context.Context,
+	le *logrus.Entry,
+	b bus.Bus,
+	client srpc.Client,
+	types ...directive.NetworkedType,
+) (*LoadedPlugin, error) {
+	pluginClient := NewSRPCSubprocessPluginClient(client)
+	info, err := pluginClient.GetPluginInfo(ctx, &GetPluginInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	busClient := bus_api.NewSRPCControllerBusServiceClient(client)
+	remoteResolver := NewRemoteResolver(
+		info.GetBinaryId(),
+		info.GetBinaryVersion(),
+		pluginClient,
+		busClient,
+	)
+	// #nosec G118 -- cancel func is stored on LoadedPlugin and called by Close.
+	subCtx, subCtxCancel := context.WithCancel(ctx)
+	go func() {
+		resolverController := resolver.NewController(le, b, remoteResolver)
+		err := b.ExecuteController(
+			subCtx,
+			resolverController,
+		)
+		if err != nil {
+			// typically not possible
+			if err != context.Canceled {
+				le.WithError(err).Warn("subprocess loader controller exited with error")
+			}
+			return
+		}
+		<-subCtx.Done()
+		b.RemoveController(resolverController)
+		resolverController.Close()
+	}()
+
+	var linkTypes []directive.NetworkedType
+	for _, dirType := range types {
+		if slices.Contains(info.GetDirectiveTypeIds(), dirType.GetNetworkedTypeID()) {
+			linkTypes = append(linkTypes, dirType)
+		}
+	}
+	if len(linkTypes) != 0 {
+		go func() {
+			err := bus_api.LinkBus(subCtx, busClient, b, linkTypes...)
+			if err != nil && subCtx.Err() == nil {
+				le.WithError(err).Warn("plugin bus link exited with error")
+			}
+		}()
+	}
+	return &LoadedPlugin{
+		RemoteResolver: remoteResolver,
+		binaryID:       info.GetBinaryId(),
+		binaryVersion:  info.GetBinaryVersion(),
+		client:         client,
+		ctx:            subCtx,
+		ctxCancel:      subCtxCancel,
+	}, nil
+}
+
+// LoadPluginSubprocess starts a plugin binary and connects to it over stdio.
+//
+// The plugin binary must call ServePluginStdio. The process is killed when
+// the LoadedPlugin is closed, and the plugin is closed if the process exits.
+// types are passed to ConnectPlugin.
+func LoadPluginSubprocess(
+	ctx context.Context,
+	le *logrus.Entry,
+	b bus.Bus,
+	pluginPath string,
+	types ...directive.NetworkedType,
+) (*LoadedPlugin, error) {
+	// Get info about the file.
+	pluginSt, err := cbus_plugin.NewPluginStat(pluginPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec G204 -- the plugin path is supplied by the controller config.
+	procCtx, procCtxCancel := context.WithCancel(ctx)
+	cmd := exec.CommandContext(procCtx, pluginPath)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		procCtxCancel()
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		procCtxCancel()
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		procCtxCancel()
+		return nil, err
+	}
+
+	conn := newStdioConn(stdout, stdin, stdin, stdout)
+	mc, err := srpc.NewMuxedConn(conn, true, nil)
+	if err != nil {
+		procCtxCancel()
+		_ = cmd.Wait()
+		return nil, err
+	}
+
+	lp, err := ConnectPlugin(procCtx, le, b, srpc.NewClientWithMuxedConn(mc), types...)
+	if err != nil {
+		procCtxCancel()
+		_ = mc.Close()
+		_ = cmd.Wait()
+		return nil, err
+	}
+	lp.PluginStat = *pluginSt
+	lp.process = cmd.Process
+	lp.release = func() {
+		procCtxCancel()
+		_ = mc.Close()
+	}
+
+	go func() {
+		err := cmd.Wait()
+		if procCtx.Err() == nil {
+			if err == nil {
+				err = ErrPluginExited
+			}
+			le.WithError(err).Warn("plugin process exited")
+		}
+		lp.Close()
+		_ = mc.Close()
+		procCtxCancel()
+	}()
+	return lp, nil
+}
+
+// GetBinaryID returns the plugin binary ID.
+func (l *LoadedPlugin) GetBinaryID() string {
+	return l.binaryID
+}
+
+// GetBinaryVersion returns the plugin binary version.
+func (l *LoadedPlugin) GetBinaryVersion() string {
+	return l.binaryVersion
+}
+
+// GetClient returns the rpc client for the plugin.
+//
+// The plugin serves the ControllerBusService for the plugin bus.
+func (l *LoadedPlugin) GetClient() srpc.Client {
+	return l.client
+}
+
+// GetProcess returns the plugin process.
+//
+// Returns nil if the plugin was connected with ConnectPlugin.
+func (l *LoadedPlugin) GetProcess() *os.Process {
+	return l.process
+}
+
+// Done returns a channel which is closed when the plugin is closed.
+//
+// Plugins started with LoadPluginSubprocess are closed when the process exits.
+func (l *LoadedPlugin) Done() <-chan struct{} {
+	return l.ctx.Done()
+}
+
+// Close closes the loaded plugin.
+func (l *LoadedPlugin) Close() {
+	l.closeOnce.Do(func() {
+		l.ctxCancel()
+		if l.release != nil {
+			l.release()
+		}
+	})
+}
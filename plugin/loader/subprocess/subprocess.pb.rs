@@ -0,0 +1,64 @@
+// @generated
+// This file is @generated by prost-build.
+/// GetPluginInfoRequest is the request type for GetPluginInfo.
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetPluginInfoRequest {
+}
+/// GetPluginInfoResponse is the response type for GetPluginInfo.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetPluginInfoResponse {
+    /// BinaryId is the plugin binary ID.
+    #[prost(string, tag="1")]
+    pub binary_id: ::prost::alloc::string::String,
+    /// BinaryVersion is the plugin binary version.
+    #[prost(string, tag="2")]
+    pub binary_version: ::prost::alloc::string::String,
+    /// DirectiveTypeIds are the networked directive types linked with the host.
+    #[prost(string, repeated, tag="3")]
+    pub directive_type_ids: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// GetFactoryInfoRequest is the request type for GetFactoryInfo.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetFactoryInfoRequest {
+    /// ConfigId is the config ID to look up a factory for.
+    #[prost(string, tag="1")]
+    pub config_id: ::prost::alloc::string::String,
+}
+/// GetFactoryInfoResponse is the response type for GetFactoryInfo.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct GetFactoryInfoResponse {
+    /// Found indicates the plugin has a factory for the config ID.
+    #[prost(bool, tag="1")]
+    pub found: bool,
+    /// Version is the version of the factory.
+    #[prost(string, tag="2")]
+    pub version: ::prost::alloc::string::String,
+    /// ConfigSchema is the JSON schema of the factory config.
+    #[prost(string, tag="3")]
+    pub config_schema: ::prost::alloc::string::String,
+}
+/// ListFactoriesRequest is the request type for ListFactories.
+#[derive(Clone, Copy, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct ListFactoriesRequest {
+}
+/// ListFactoriesResponse is the response type for ListFactories.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListFactoriesResponse {
+    /// Factories contains the factories provided by the plugin.
+    #[prost(message, repeated, tag="1")]
+    pub factories: ::prost::alloc::vec::Vec<FactoryInfo>,
+}
+/// FactoryInfo is information about a factory provided by the plugin.
+#[derive(Clone, PartialEq, Eq, Hash, ::prost::Message)]
+pub struct FactoryInfo {
+    /// ConfigId is the config ID of the factory.
+    #[prost(string, tag="1")]
+    pub config_id: ::prost::alloc::string::String,
+    /// Version is the version of the factory.
+    #[prost(string, tag="2")]
+    pub version: ::prost::alloc::string::String,
+    /// ConfigSchema is the JSON schema of the factory config.
+    #[prost(string, tag="3")]
+    pub config_schema: ::prost::alloc::string::String,
+}
+// @@protoc_insertion_point(module)
@@ -0,0 +1,211 @@
+// @generated by protoc-gen-es-lite unknown with parameter "target=ts,ts_nocheck=false"
+// @generated from file github.com/aperturerobotics/controllerbus/plugin/loader/subprocess/subprocess.proto (package plugin.subprocess, syntax proto3)
+/* eslint-disable */
+
+import type { MessageType, PartialFieldInfo } from '@aptre/protobuf-es-lite'
+import { createMessageType, ScalarType } from '@aptre/protobuf-es-lite'
+
+export const protobufPackage = 'plugin.subprocess'
+
+/**
+ * GetPluginInfoRequest is the request type for GetPluginInfo.
+ *
+ * @generated from message plugin.subprocess.GetPluginInfoRequest
+ */
+export interface GetPluginInfoRequest {}
+
+// GetPluginInfoRequest contains the message type declaration for GetPluginInfoRequest.
+export const GetPluginInfoRequest: MessageType<GetPluginInfoRequest> =
+  createMessageType({
+    typeName: 'plugin.subprocess.GetPluginInfoRequest',
+    fields: [] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * GetPluginInfoResponse is the response type for GetPluginInfo.
+ *
+ * @generated from message plugin.subprocess.GetPluginInfoResponse
+ */
+export interface GetPluginInfoResponse {
+  /**
+   * BinaryId is the plugin binary ID.
+   *
+   * @generated from field: string binary_id = 1;
+   */
+  binaryId?: string
+  /**
+   * BinaryVersion is the plugin binary version.
+   *
+   * @generated from field: string binary_version = 2;
+   */
+  binaryVersion?: string
+  /**
+   * DirectiveTypeIds are the networked directive types linked with the host.
+   *
+   * @generated from field: repeated string directive_type_ids = 3;
+   */
+  directiveTypeIds?: string[]
+}
+
+// GetPluginInfoResponse contains the message type declaration for GetPluginInfoResponse.
+export const GetPluginInfoResponse: MessageType<GetPluginInfoResponse> =
+  createMessageType({
+    typeName: 'plugin.subprocess.GetPluginInfoResponse',
+    fields: [
+      { no: 1, name: 'binary_id', kind: 'scalar', T: ScalarType.STRING },
+      { no: 2, name: 'binary_version', kind: 'scalar', T: ScalarType.STRING },
+      {
+        no: 3,
+        name: 'directive_type_ids',
+        kind: 'scalar',
+        T: ScalarType.STRING,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * GetFactoryInfoRequest is the request type for GetFactoryInfo.
+ *
+ * @generated from message plugin.subprocess.GetFactoryInfoRequest
+ */
+export interface GetFactoryInfoRequest {
+  /**
+   * ConfigId is the config ID to look up a factory for.
+   *
+   * @generated from field: string config_id = 1;
+   */
+  configId?: string
+}
+
+// GetFactoryInfoRequest contains the message type declaration for GetFactoryInfoRequest.
+export const GetFactoryInfoRequest: MessageType<GetFactoryInfoRequest> =
+  createMessageType({
+    typeName: 'plugin.subprocess.GetFactoryInfoRequest',
+    fields: [
+      { no: 1, name: 'config_id', kind: 'scalar', T: ScalarType.STRING },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * GetFactoryInfoResponse is the response type for GetFactoryInfo.
+ *
+ * @generated from message plugin.subprocess.GetFactoryInfoResponse
+ */
+export interface GetFactoryInfoResponse {
+  /**
+   * Found indicates the plugin has a factory for the config ID.
+   *
+   * @generated from field: bool found = 1;
+   */
+  found?: boolean
+  /**
+   * Version is the version of the factory.
+   *
+   * @generated from field: string version = 2;
+   */
+  version?: string
+  /**
+   * ConfigSchema is the JSON schema of the factory config.
+   *
+   * @generated from field: string config_schema = 3;
+   */
+  configSchema?: string
+}
+
+// GetFactoryInfoResponse contains the message type declaration for GetFactoryInfoResponse.
+export const GetFactoryInfoResponse: MessageType<GetFactoryInfoResponse> =
+  createMessageType({
+    typeName: 'plugin.subprocess.GetFactoryInfoResponse',
+    fields: [
+      { no: 1, name: 'found', kind: 'scalar', T: ScalarType.BOOL },
+      { no: 2, name: 'version', kind: 'scalar', T: ScalarType.STRING },
+      { no: 3, name: 'config_schema', kind: 'scalar', T: ScalarType.STRING },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * ListFactoriesRequest is the request type for ListFactories.
+ *
+ * @generated from message plugin.subprocess.ListFactoriesRequest
+ */
+export interface ListFactoriesRequest {}
+
+// ListFactoriesRequest contains the message type declaration for ListFactoriesRequest.
+export const ListFactoriesRequest: MessageType<ListFactoriesRequest> =
+  createMessageType({
+    typeName: 'plugin.subprocess.ListFactoriesRequest',
+    fields: [] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
+
+/**
+ * FactoryInfo is information about a factory provided by the plugin.
+ *
+ * @generated from message plugin.subprocess.FactoryInfo
+ */
+export interface FactoryInfo {
+  /**
+   * ConfigId is the config ID of the factory.
+   *
+   * @generated from field: string config_id = 1;
+   */
+  configId?: string
+  /**
+   * Version is the version of the factory.
+   *
+   * @generated from field: string version = 2;
+   */
+  version?: string
+  /**
+   * ConfigSchema is the JSON schema of the factory config.
+   *
+   * @generated from field: string config_schema = 3;
+   */
+  configSchema?: string
+}
+
+// FactoryInfo contains the message type declaration for FactoryInfo.
+export const FactoryInfo: MessageType<FactoryInfo> = createMessageType({
+  typeName: 'plugin.subprocess.FactoryInfo',
+  fields: [
+    { no: 1, name: 'config_id', kind: 'scalar', T: ScalarType.STRING },
+    { no: 2, name: 'version', kind: 'scalar', T: ScalarType.STRING },
+    { no: 3, name: 'config_schema', kind: 'scalar', T: ScalarType.STRING },
+  ] as readonly PartialFieldInfo[],
+  packedByDefault: true,
+})
+
+/**
+ * ListFactoriesResponse is the response type for ListFactories.
+ *
+ * @generated from message plugin.subprocess.ListFactoriesResponse
+ */
+export interface ListFactoriesResponse {
+  /**
+   * Factories contains the factories provided by the plugin.
+   *
+   * @generated from field: repeated plugin.subprocess.FactoryInfo factories = 1;
+   */
+  factories?: FactoryInfo[]
+}
+
+// ListFactoriesResponse contains the message type declaration for ListFactoriesResponse.
+export const ListFactoriesResponse: MessageType<ListFactoriesResponse> =
+  createMessageType({
+    typeName: 'plugin.subprocess.ListFactoriesResponse',
+    fields: [
+      {
+        no: 1,
+        name: 'factories',
+        kind: 'message',
+        T: () => FactoryInfo,
+        repeated: true,
+      },
+    ] as readonly PartialFieldInfo[],
+    packedByDefault: true,
+  })
@@ -0,0 +1,169 @@
+// @generated by protoc-gen-es-starpc none with parameter "target=ts,ts_nocheck=false"
+// @generated from file github.com/aperturerobotics/controllerbus/plugin/loader/subprocess/subprocess.proto (package plugin.subprocess, syntax proto3)
+/* eslint-disable */
+
+import {
+  GetFactoryInfoRequest,
+  GetFactoryInfoResponse,
+  GetPluginInfoRequest,
+  GetPluginInfoResponse,
+  ListFactoriesRequest,
+  ListFactoriesResponse,
+} from './subprocess.pb.js'
+import { MethodKind } from '@aptre/protobuf-es-lite'
+import { ProtoRpc } from 'starpc'
+
+/**
+ * SubprocessPlugin is the plugin api exposed by a plugin sub-process.
+ *
+ * The plugin also exposes the ControllerBusService for its bus.
+ *
+ * @generated from service plugin.subprocess.SubprocessPlugin
+ */
+export const SubprocessPluginDefinition = {
+  typeName: 'plugin.subprocess.SubprocessPlugin',
+  methods: {
+    /**
+     * GetPluginInfo returns information about the plugin binary.
+     *
+     * @generated from rpc plugin.subprocess.SubprocessPlugin.GetPluginInfo
+     */
+    GetPluginInfo: {
+      name: 'GetPluginInfo',
+      I: GetPluginInfoRequest,
+      O: GetPluginInfoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetFactoryInfo looks up the factory for a config ID.
+     *
+     * @generated from rpc plugin.subprocess.SubprocessPlugin.GetFactoryInfo
+     */
+    GetFactoryInfo: {
+      name: 'GetFactoryInfo',
+      I: GetFactoryInfoRequest,
+      O: GetFactoryInfoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListFactories lists the factories provided by the plugin.
+     *
+     * @generated from rpc plugin.subprocess.SubprocessPlugin.ListFactories
+     */
+    ListFactories: {
+      name: 'ListFactories',
+      I: ListFactoriesRequest,
+      O: ListFactoriesResponse,
+      kind: MethodKind.Unary,
+    },
+  },
+} as const
+
+/**
+ * SubprocessPlugin is the plugin api exposed by a plugin sub-process.
+ *
+ * The plugin also exposes the ControllerBusService for its bus.
+ *
+ * @generated from service plugin.subprocess.SubprocessPlugin
+ */
+export interface SubprocessPlugin {
+  /**
+   * GetPluginInfo returns information about the plugin binary.
+   *
+   * @generated from rpc plugin.subprocess.SubprocessPlugin.GetPluginInfo
+   */
+  GetPluginInfo(
+    request: GetPluginInfoRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetPluginInfoResponse>
+
+  /**
+   * GetFactoryInfo looks up the factory for a config ID.
+   *
+   * @generated from rpc plugin.subprocess.SubprocessPlugin.GetFactoryInfo
+   */
+  GetFactoryInfo(
+    request: GetFactoryInfoRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetFactoryInfoResponse>
+
+  /**
+   * ListFactories lists the factories provided by the plugin.
+   *
+   * @generated from rpc plugin.subprocess.SubprocessPlugin.ListFactories
+   */
+  ListFactories(
+    request: ListFactoriesRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<ListFactoriesResponse>
+}
+
+export const SubprocessPluginServiceName = SubprocessPluginDefinition.typeName
+
+export class SubprocessPluginClient implements SubprocessPlugin {
+  private readonly rpc: ProtoRpc
+  private readonly service: string
+  constructor(rpc: ProtoRpc, opts?: { service?: string }) {
+    this.service = opts?.service || SubprocessPluginServiceName
+    this.rpc = rpc
+    this.GetPluginInfo = this.GetPluginInfo.bind(this)
+    this.GetFactoryInfo = this.GetFactoryInfo.bind(this)
+    this.ListFactories = this.ListFactories.bind(this)
+  }
+  /**
+   * GetPluginInfo returns information about the plugin binary.
+   *
+   * @generated from rpc plugin.subprocess.SubprocessPlugin.GetPluginInfo
+   */
+  async GetPluginInfo(
+    request: GetPluginInfoRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetPluginInfoResponse> {
+    const requestMsg = GetPluginInfoRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      SubprocessPluginDefinition.methods.GetPluginInfo.name,
+      GetPluginInfoRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return GetPluginInfoResponse.fromBinary(result)
+  }
+
+  /**
+   * GetFactoryInfo looks up the factory for a config ID.
+   *
+   * @generated from rpc plugin.subprocess.SubprocessPlugin.GetFactoryInfo
+   */
+  async GetFactoryInfo(
+    request: GetFactoryInfoRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<GetFactoryInfoResponse> {
+    const requestMsg = GetFactoryInfoRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      SubprocessPluginDefinition.methods.GetFactoryInfo.name,
+      GetFactoryInfoRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return GetFactoryInfoResponse.fromBinary(result)
+  }
+
+  /**
+   * ListFactories lists the factories provided by the plugin.
+   *
+   * @generated from rpc plugin.subprocess.SubprocessPlugin.ListFactories
+   */
+  async ListFactories(
+    request: ListFactoriesRequest,
+    abortSignal?: AbortSignal,
+  ): Promise<ListFactoriesResponse> {
+    const requestMsg = ListFactoriesRequest.create(request)
+    const result = await this.rpc.request(
+      this.service,
+      SubprocessPluginDefinition.methods.ListFactories.name,
+      ListFactoriesRequest.toBinary(requestMsg),
+      abortSignal || undefined,
+    )
+    return ListFactoriesResponse.fromBinary(result)
+  }
+}
@@ -0,0 +1,211 @@
+package plugin_subprocess
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/controllerbus/bus"
+	bus_api "github.com/aperturerobotics/controllerbus/bus/api"
+	"github.com/aperturerobotics/controllerbus/config"
+	"github.com/aperturerobotics/controllerbus/controller"
+	"github.com/aperturerobotics/controllerbus/controller/resolver"
+	"github.com/aperturerobotics/controllerbus/core"
+	"github.com/aperturerobotics/controllerbus/example/boilerplate"
+	boilerplate_controller "github.com/aperturerobotics/controllerbus/example/boilerplate/controller"
+	boilerplate_v1 "github.com/aperturerobotics/controllerbus/example/boilerplate/v1"
+	cbus_plugin "github.com/aperturerobotics/controllerbus/plugin"
+	"github.com/aperturerobotics/starpc/srpc"
+	"github.com/sirupsen/logrus"
+)
+
+// testPluginEnv is set to run the test binary as a plugin.
+const testPluginEnv = "CONTROLLERBUS_TEST_SUBPROCESS_PLUGIN"
+
+// TestMain runs the test binary as a plugin if testPluginEnv is set.
+func TestMain(m *testing.M) {
+	if os.Getenv(testPluginEnv) != "" {
+		le := logrus.NewEntry(logrus.New())
+		if err := ServePluginStdio(context.Background(), le, newTestPlugin(), boilerplate_v1.NetworkedType); err != nil {
+			le.WithError(err).Warn("plugin exited")
+		}
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// newTestPlugin constructs the plugin with the boilerplate factory.
+func newTestPlugin() cbus_plugin.Plugin {
+	return cbus_plugin.NewStaticPlugin("test-plugin", "v0.0.1", func(b bus.Bus) []controller.Factory {
+		return []controller.Factory{boilerplate_controller.NewFactory(b)}
+	})
+}
+
+// TestSubprocessPlugin tests running a controller in a remote plugin.
+func TestSubprocessPlugin(t *testing.T) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+	defer ctxCancel()
+
+	log := logrus.New()
+	log.SetLevel(logrus.DebugLevel)
+	le := logrus.NewEntry(log)
+
+	mux, relPlugin, err := NewPluginMux(ctx, le, newTestPlugin(), boilerplate_v1.NetworkedType)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer relPlugin()
+	client := srpc.NewClient(srpc.NewServerPipe(srpc.NewServer(mux)))
+
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	lp, err := ConnectPlugin(ctx, le, b, client, boilerplate_v1.NetworkedType)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer lp.Close()
+	checkPlugin(ctx, t, le, b, lp)
+}
+
+// TestLoadPluginSubprocess tests running the test binary as a plugin process.
+func TestLoadPluginSubprocess(t *testing.T) {
+	t.Setenv(testPluginEnv, "1")
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer ctxCancel()
+
+	le := logrus.NewEntry(logrus.New())
+	b, _, err := core.NewCoreBus(ctx, le)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	exePath, err := os.Executable()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	lp, err := LoadPluginSubprocess(ctx, le, b, exePath, boilerplate_v1.NetworkedType)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer lp.Close()
+	if lp.GetBinarySize() == 0 {
+		t.Fatal("expected plugin stat to be set")
+	}
+	checkPlugin(ctx, t, le, b, lp)
+
+	// closing the plugin stops the process and removes the factories
+	lp.Close()
+	callCtx, callCtxCancel := context.WithTimeout(ctx, time.Second)
+	_, err = NewSRPCSubprocessPluginClient(lp.GetClient()).GetPluginInfo(callCtx, &GetPluginInfoRequest{})
+	callCtxCancel()
+	if err == nil {
+		t.Fatal("expected plugin rpc to fail after close")
+	}
+	for {
+		ctorVal, _, ctorRef, err := bus.ExecOneOff(
+			ctx,
+			b,
+			resolver.NewLoadConfigConstructorByID(boilerplate_controller.ConfigID),
+			bus.ReturnWhenIdle(),
+			nil,
+		)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if ctorRef != nil {
+			ctorRef.Release()
+		}
+		if ctorVal == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// checkPlugin checks resolving, listing and running the plugin factories.
+func checkPlugin(ctx context.Context, t *testing.T, le *logrus.Entry, b bus.Bus, lp *LoadedPlugin) {
+	if lp.GetBinaryID() != "test-plugin" {
+		t.Fatalf("unexpected binary id: %s", lp.GetBinaryID())
+	}
+
+	// resolve the config type from the plugin
+	ctorVal, _, ctorRef, err := bus.ExecOneOff(
+		ctx,
+		b,
+		resolver.NewLoadConfigConstructorByID(boilerplate_controller.ConfigID),
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	conf := ctorVal.GetValue().(config.Constructor).ConstructConfig()
+	ctorRef.Release()
+	if err := conf.UnmarshalJSON([]byte(`{"exampleField":"testing"}`)); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// list the factories provided by the plugin
+	factories, err := bus_api.ListBusFactories(ctx, b)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var foundFactory *bus_api.FactoryInfo
+	for _, info := range factories {
+		if info.GetConfigId() == boilerplate_controller.ConfigID {
+			foundFactory = info
+		}
+	}
+	if foundFactory == nil {
+		t.Fatal("expected plugin factory to be listed")
+	}
+	if !strings.Contains(foundFactory.GetConfigSchema(), `"exampleField"`) {
+		t.Fatalf("unexpected config schema: %s", foundFactory.GetConfigSchema())
+	}
+
+	// run the controller in the plugin
+	_, _, ctrlRef, err := bus.ExecOneOff(ctx, b, resolver.NewLoadControllerWithConfig(conf), nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ctrlRef.Release()
+
+	// the controller in the plugin resolves a directive on the host bus
+	res, _, resRef, err := bus.ExecOneOff(ctx, b, &boilerplate_v1.Boilerplate{
+		MessageText: "hello world",
+	}, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resRef.Release()
+	if plen := res.GetValue().(boilerplate.BoilerplateResult).GetPrintedLen(); plen != 55 {
+		t.Fatalf("expected length 55 got %d", plen)
+	}
+
+	// execute a directive against the plugin bus
+	cb, relCb, err := bus_api.NewClientBus(
+		ctx,
+		le,
+		bus_api.NewSRPCControllerBusServiceClient(lp.GetClient()),
+		boilerplate_v1.NetworkedType,
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer relCb()
+
+	res, _, resRef, err = bus.ExecOneOff(ctx, cb, &boilerplate_v1.Boilerplate{
+		MessageText: "hello world",
+	}, nil, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resRef.Release()
+	plen := res.GetValue().(boilerplate.BoilerplateResult).GetPrintedLen()
+	if plen != 55 {
+		t.Fatalf("expected length 55 got %d", plen)
+	}
+}
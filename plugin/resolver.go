@@ -75,6 +75,11 @@ func (r *Resolver) GetConfigCtorByID(
 	return r.staticResolver.GetConfigCtorByID(ctx, id)
 }
 
+// ListFactories returns the factories included in the plugin binary.
+func (r *Resolver) ListFactories(ctx context.Context) ([]controller.Factory, error) {
+	return r.staticResolver.ListFactories(ctx)
+}
+
 // GetFactoryMatchingConfig returns the factory that matches the config.
 // If no factory is found, return nil.
 // If an unexpected error occurs, return it.
@@ -105,4 +110,7 @@ func (r *Resolver) PrePluginUnload() {
 }
 
 // _ is a type assertion
-var _ PluginResolver = ((*Resolver)(nil))
+var (
+	_ PluginResolver           = ((*Resolver)(nil))
+	_ controller.FactoryLister = ((*Resolver)(nil))
+)